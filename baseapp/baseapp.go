@@ -75,6 +75,8 @@ type BaseApp struct {
 	DeliverState *state // for DeliverTx
 
 	AccountStoreCache sdk.AccountStoreCache
+	accountCdc        *codec.Codec   // the codec of the accounts in AccountStoreCache
+	paramSubspaces    ParamSubspaces // the param subspaces of the param overrides, may be nil
 	txMsgCache        *lru.Cache
 	Pool              *sdk.Pool
 
@@ -316,6 +318,7 @@ func (app *BaseApp) SetDeliverState(header abci.Header) {
 
 func (app *BaseApp) SetAccountStoreCache(cdc *codec.Codec, accountStore sdk.KVStore, cap int) {
 	app.AccountStoreCache = auth.NewAccountStoreCache(cdc, accountStore, cap)
+	app.accountCdc = cdc
 }
 
 //______________________________________________________________________________
@@ -421,6 +424,8 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			} else {
 				result = app.Simulate(txBytes, tx)
 			}
+		case "simulateBundle":
+			return handleQuerySimulateBundle(app, req)
 		case "version":
 			return abci.ResponseQuery{
 				Code:  uint32(sdk.ABCICodeOK),
@@ -437,7 +442,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			Value: value,
 		}
 	}
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be either simulate, simulateBundle or version, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -528,7 +533,9 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.DeliverState.Ctx, req)
+		ctx, journal := app.withBalanceJournal(app.DeliverState.Ctx)
+		res = app.beginBlocker(ctx, req)
+		res.Events = append(res.Events, app.collectBalanceJournal("", journal)...)
 	}
 
 	return
@@ -837,6 +844,10 @@ func (app *BaseApp) RunTx(mode sdk.RunTxMode, tx sdk.Tx, txHash string) (result
 
 	// run the ante handler
 	ctx = ctx.WithValue(TxHashKey, txHash)
+	var journal *sdk.BalanceJournal
+	if mode == sdk.RunTxModeDeliver || mode == sdk.RunTxModeDeliverAfterPre {
+		ctx, journal = app.withBalanceJournal(ctx)
+	}
 	if app.anteHandler != nil {
 		newCtx, result, abort := app.anteHandler(ctx, tx, mode)
 		if !newCtx.IsZero() {
@@ -871,6 +882,10 @@ func (app *BaseApp) RunTx(mode sdk.RunTxMode, tx sdk.Tx, txHash string) (result
 				// Should we add all msg here with no distinction ？
 				app.Pool.AddTx(tx, txHash)
 			}
+			if journal != nil {
+				result.Events = append(result.Events, journal.Events()...)
+				app.Pool.AddBalanceChanges(txHash, journal.Changes())
+			}
 		}
 		accountCache.Write()
 		msCache.Write()
@@ -931,12 +946,32 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.DeliverState.Ctx, req)
+		ctx, journal := app.withBalanceJournal(app.DeliverState.Ctx)
+		res = app.endBlocker(ctx, req)
+		res.Events = append(res.Events, app.collectBalanceJournal("", journal)...)
 	}
 
 	return
 }
 
+// withBalanceJournal returns a context recording the balance changes if the journal is collected
+func (app *BaseApp) withBalanceJournal(ctx sdk.Context) (sdk.Context, *sdk.BalanceJournal) {
+	if !app.collect.CollectBalanceJournal {
+		return ctx, nil
+	}
+	journal := &sdk.BalanceJournal{}
+	return sdk.WithBalanceJournal(ctx, journal), journal
+}
+
+// collectBalanceJournal adds the balance changes to the pool and returns them as events
+func (app *BaseApp) collectBalanceJournal(txHash string, journal *sdk.BalanceJournal) []abci.Event {
+	if journal == nil {
+		return nil
+	}
+	app.Pool.AddBalanceChanges(txHash, journal.Changes())
+	return journal.Events().ToABCIEvents()
+}
+
 // Implements ABCI
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.DeliverState.Ctx.BlockHeader()
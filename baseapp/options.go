@@ -14,19 +14,27 @@ import (
 
 // SetPruning sets a pruning option on the multistore associated with the app
 func SetPruning(pruning string) func(*BaseApp) {
-	var pruningEnum sdk.PruningStrategy
+	var pruningStrategy sdk.PruningStrategy
 	switch pruning {
 	case "nothing":
-		pruningEnum = sdk.PruneNothing
+		pruningStrategy = sdk.PruneNothing
 	case "everything":
-		pruningEnum = sdk.PruneEverything
+		pruningStrategy = sdk.PruneEverything
 	case "syncable":
-		pruningEnum = sdk.PruneSyncable
+		pruningStrategy = sdk.PruneSyncable
 	default:
 		panic(fmt.Sprintf("invalid pruning strategy: %s", pruning))
 	}
+	return SetPruningStrategy(pruningStrategy)
+}
+
+// SetPruningStrategy sets a custom pruning strategy on the multistore associated with the app
+func SetPruningStrategy(pruningStrategy sdk.PruningStrategy) func(*BaseApp) {
+	if err := pruningStrategy.Validate(); err != nil {
+		panic(err)
+	}
 	return func(bap *BaseApp) {
-		bap.cms.SetPruning(pruningEnum)
+		bap.cms.SetPruning(pruningStrategy)
 	}
 }
 
@@ -79,6 +87,13 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+func (app *BaseApp) SetParamSubspaces(ps ParamSubspaces) {
+	if app.sealed {
+		panic("SetParamSubspaces() on sealed BaseApp")
+	}
+	app.paramSubspaces = ps
+}
+
 func (app *BaseApp) SetPreChecker(pc sdk.PreChecker) {
 	if app.sealed {
 		panic("SetPreChecker() on sealed BaseApp")
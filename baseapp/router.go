@@ -1,7 +1,9 @@
 package baseapp
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -65,3 +67,34 @@ func (rtr *router) Route(path string) (h sdk.Handler) {
 	}
 	return nil
 }
+
+// DispatchMsgs executes the msgs with the handlers of the router on behalf of a module, e.g. the msgs
+// granted to a grantee or approved by the members of a multisig account. It stops at the first failed msg
+// and returns its result.
+func DispatchMsgs(ctx sdk.Context, router Router, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+	var tags sdk.Tags
+	var events sdk.Events
+	logs := make([]string, 0, len(msgs))
+	for i, msg := range msgs {
+		handler := router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msg.Route()).Result()
+		}
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+		data = append(data, res.Data...)
+		tags = append(tags, res.Tags...)
+		tags = append(tags, sdk.MakeTag("action", []byte(msg.Type())))
+		events = append(events, res.Events...)
+		logs = append(logs, fmt.Sprintf("Msg %d: %s", i, res.Log))
+	}
+	return sdk.Result{
+		Data:   data,
+		Log:    strings.Join(logs, "\n"),
+		Tags:   tags,
+		Events: events,
+	}
+}
@@ -0,0 +1,298 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// MaxSimulateBundleTxs is the max number of txs simulated by one /app/simulateBundle query
+const MaxSimulateBundleTxs = 32
+
+// SimulateBundleRequest is the data of the /app/simulateBundle query. The txs are encoded the same way as
+// the /app/simulate query, the StdTxs without signatures are simulated with the account numbers and the
+// sequences of their signers at the time they run, so the txs of different senders need no signing.
+type SimulateBundleRequest struct {
+	Txs       [][]byte       `json:"txs"`
+	Overrides StateOverrides `json:"overrides"`
+}
+
+// StateOverrides are applied before the bundle runs
+type StateOverrides struct {
+	Accounts []AccountOverride `json:"accounts,omitempty"`
+	Params   []ParamOverride   `json:"params,omitempty"`
+	Stores   []StoreOverride   `json:"stores,omitempty"`
+}
+
+// AccountOverride replaces the coins of an existing account. The account is replaced first when the JSON of
+// the account is given, it is decoded with the account codec of the app and can be a new account.
+type AccountOverride struct {
+	Address sdk.AccAddress  `json:"address"`
+	Account json.RawMessage `json:"account,omitempty"`
+	Coins   sdk.Coins       `json:"coins,omitempty"`
+}
+
+// ParamOverride sets a param of the named subspace, the JSON value is decoded with the registered type of the param
+type ParamOverride struct {
+	Subspace string          `json:"subspace"`
+	Key      string          `json:"key"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// ParamSubspaces provides the param subspaces of the app to the param overrides, it is the params keeper
+type ParamSubspaces interface {
+	GetSubspace(name string) (params.Subspace, bool)
+}
+
+// StoreOverride sets the raw value of a key in the named store, a nil value deletes the key. The value must be
+// encoded by the module codec, the accounts and the params should be overridden with the typed overrides.
+type StoreOverride struct {
+	Store string `json:"store"`
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// SimulateBundleResult is the result of the /app/simulateBundle query, the diff of the accounts and the stores
+// only covers the txs which succeeded
+type SimulateBundleResult struct {
+	Results  []SimulateTxResult `json:"results"`
+	Accounts []AccountDiff      `json:"accounts"`
+	Diff     []store.KVDiff     `json:"diff"`
+}
+
+// SimulateTxResult is the result of a tx in the bundle
+type SimulateTxResult struct {
+	TxHash string     `json:"tx_hash"`
+	Result sdk.Result `json:"result"`
+	Fee    sdk.Fee    `json:"fee"`
+}
+
+// AccountDiff is the account after the bundle runs, the account is null if it was deleted
+type AccountDiff struct {
+	Address sdk.AccAddress  `json:"address"`
+	Account json.RawMessage `json:"account"`
+}
+
+func handleQuerySimulateBundle(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	var bundle SimulateBundleRequest
+	if err := codec.Cdc.UnmarshalJSON(req.Data, &bundle); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid simulate bundle request: %v", err)).QueryResult()
+	}
+	result, sdkErr := app.SimulateBundle(bundle)
+	if sdkErr != nil {
+		return sdkErr.QueryResult()
+	}
+	value, err := codec.Cdc.MarshalJSON(result)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).QueryResult()
+	}
+	return abci.ResponseQuery{
+		Code:  uint32(sdk.ABCICodeOK),
+		Value: value,
+	}
+}
+
+// SimulateBundle runs the txs in order on one cache of the check state with the overrides applied, nothing is
+// committed. The failed txs are reported in the results and don't stop the bundle.
+func (app *BaseApp) SimulateBundle(bundle SimulateBundleRequest) (SimulateBundleResult, sdk.Error) {
+	if len(bundle.Txs) == 0 {
+		return SimulateBundleResult{}, sdk.ErrUnknownRequest("no txs to simulate")
+	}
+	if len(bundle.Txs) > MaxSimulateBundleTxs {
+		return SimulateBundleResult{}, sdk.ErrUnknownRequest(
+			fmt.Sprintf("too many txs to simulate, max %d, got %d", MaxSimulateBundleTxs, len(bundle.Txs)))
+	}
+	txs := make([]sdk.Tx, len(bundle.Txs))
+	for i, txBytes := range bundle.Txs {
+		tx, err := app.TxDecoder(txBytes)
+		if err != nil {
+			return SimulateBundleResult{}, err
+		}
+		txs[i] = tx
+	}
+
+	ms, ok := app.CheckState.CacheMultiStore().(store.DiffMultiStore)
+	if !ok {
+		return SimulateBundleResult{}, sdk.ErrInternal("the multistore can not report the diff")
+	}
+	accountCache := app.CheckState.AccountCache.Cache()
+	ctx := app.CheckState.Ctx.WithMultiStore(ms).WithAccountCache(accountCache).WithRunTxMode(sdk.RunTxModeSimulate)
+
+	if err := app.applyStateOverrides(ctx, ms, bundle.Overrides); err != nil {
+		return SimulateBundleResult{}, err
+	}
+
+	results := make([]SimulateTxResult, len(txs))
+	for i, tx := range txs {
+		txHash := cmn.HexBytes(tmhash.Sum(bundle.Txs[i])).String()
+		tx = fillSimulateSignatures(ctx, tx)
+		results[i] = SimulateTxResult{
+			TxHash: txHash,
+			Result: app.runBundleTx(ctx, tx, txHash),
+		}
+		if fee, err := fees.CalculateTxFee(tx.GetMsgs()); err == nil {
+			results[i].Fee = fee
+		}
+	}
+
+	accounts, err := app.accountDiff(accountCache)
+	if err != nil {
+		return SimulateBundleResult{}, err
+	}
+	return SimulateBundleResult{
+		Results:  results,
+		Accounts: accounts,
+		Diff:     ms.Diff(),
+	}, nil
+}
+
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, ms store.DiffMultiStore, overrides StateOverrides) sdk.Error {
+	for _, override := range overrides.Accounts {
+		acc := ctx.AccountCache().GetAccount(override.Address)
+		if len(override.Account) != 0 {
+			if app.accountCdc == nil {
+				return sdk.ErrInternal("the accounts can not be decoded")
+			}
+			acc = nil
+			if err := app.accountCdc.UnmarshalJSON(override.Account, &acc); err != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("invalid account of %s: %v", override.Address, err))
+			}
+			if acc == nil || !acc.GetAddress().Equals(override.Address) {
+				return sdk.ErrInvalidAddress(fmt.Sprintf("the account does not match the address %s", override.Address))
+			}
+		}
+		if acc == nil {
+			return sdk.ErrUnknownAddress(override.Address.String())
+		}
+		if override.Coins != nil {
+			if !override.Coins.IsValid() {
+				return sdk.ErrInvalidCoins(override.Coins.String())
+			}
+			if err := acc.SetCoins(override.Coins); err != nil {
+				return sdk.ErrInternal(err.Error())
+			}
+		}
+		ctx.AccountCache().SetAccount(override.Address, acc)
+	}
+	for _, override := range overrides.Params {
+		if app.paramSubspaces == nil {
+			return sdk.ErrInternal("the params can not be overridden")
+		}
+		subspace, ok := app.paramSubspaces.GetSubspace(override.Subspace)
+		if !ok {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unknown param subspace %s", override.Subspace))
+		}
+		if err := subspace.SetRaw(ctx, []byte(override.Key), override.Value); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid param %s/%s: %v", override.Subspace, override.Key, err))
+		}
+	}
+	for _, override := range overrides.Stores {
+		kvStore := ms.GetKVStoreByName(override.Store)
+		if kvStore == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unknown store %s", override.Store))
+		}
+		if len(override.Key) == 0 {
+			return sdk.ErrUnknownRequest("the key of the store override is empty")
+		}
+		if override.Value == nil {
+			kvStore.Delete(override.Key)
+		} else {
+			kvStore.Set(override.Key, override.Value)
+		}
+	}
+	return nil
+}
+
+// the StdTxs without signatures are signed by their signers with the current account numbers and sequences,
+// the signatures are not verified in simulation
+func fillSimulateSignatures(ctx sdk.Context, tx sdk.Tx) sdk.Tx {
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok || len(stdTx.Signatures) != 0 {
+		return tx
+	}
+	signers := stdTx.GetSigners()
+	sigs := make([]auth.StdSignature, len(signers))
+	for i, signer := range signers {
+		if acc := ctx.AccountCache().GetAccount(signer); acc != nil {
+			sigs[i] = auth.StdSignature{
+				PubKey:        acc.GetPubKey(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      acc.GetSequence(),
+			}
+		}
+	}
+	stdTx.Signatures = sigs
+	return stdTx
+}
+
+// runBundleTx runs the tx like RunTx in simulate mode, and writes the changes of the succeeded tx into the
+// bundle context so that the following txs see them
+func (app *BaseApp) runBundleTx(bundleCtx sdk.Context, tx sdk.Tx, txHash string) (result sdk.Result) {
+	msCache := bundleCtx.MultiStore().CacheMultiStore()
+	accountCache := bundleCtx.AccountCache().Cache()
+	ctx := bundleCtx.WithTx(tx).WithMultiStore(msCache).WithAccountCache(accountCache)
+
+	defer func() {
+		if r := recover(); r != nil {
+			log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
+			result = sdk.ErrInternal(log).Result()
+		}
+	}()
+
+	var msgs = tx.GetMsgs()
+	if err := validateBasicTxMsgs(msgs); err != nil {
+		return err.Result()
+	}
+
+	ctx = ctx.WithValue(TxHashKey, txHash)
+	if app.anteHandler != nil {
+		newCtx, result, abort := app.anteHandler(ctx, tx, sdk.RunTxModeSimulate)
+		if !newCtx.IsZero() {
+			ctx = newCtx
+		}
+
+		if abort {
+			return result
+		}
+	}
+
+	var txSrc int64
+	if stdTx, ok := tx.(auth.StdTx); ok {
+		txSrc = stdTx.GetSource()
+	}
+	result = app.runMsgs(ctx.WithValue(TxSourceKey, txSrc), msgs, sdk.RunTxModeSimulate)
+
+	if result.IsOK() {
+		accountCache.Write()
+		msCache.Write()
+	}
+	return
+}
+
+func (app *BaseApp) accountDiff(accountCache sdk.AccountCache) ([]AccountDiff, sdk.Error) {
+	addrs, accs := auth.DirtyAccounts(accountCache)
+	diff := make([]AccountDiff, len(addrs))
+	for i := range addrs {
+		diff[i] = AccountDiff{Address: addrs[i], Account: json.RawMessage("null")}
+		if accs[i] == nil || app.accountCdc == nil {
+			continue
+		}
+		bz, err := app.accountCdc.MarshalJSON(accs[i])
+		if err != nil {
+			return nil, sdk.ErrInternal(err.Error())
+		}
+		diff[i].Account = bz
+	}
+	return diff, nil
+}
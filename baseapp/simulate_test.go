@@ -0,0 +1,197 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestSimulateBundle(t *testing.T) {
+	counterKey := []byte("counter-key")
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, mode sdk.RunTxMode) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			require.Equal(t, sdk.RunTxModeSimulate, mode)
+			return ctx, sdk.Result{}, false
+		})
+	}
+	// the counter of the msg must match the stored one
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			store := ctx.KVStore(capKey1)
+			stored := getIntFromStore(store, counterKey)
+			if stored != msg.(*msgCounter).Counter {
+				return sdk.ErrInternal("unexpected counter").Result()
+			}
+			setIntOnStore(store, counterKey, stored+1)
+			return sdk.Result{Log: "counted"}
+		})
+	}
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	accCdc := codec.New()
+	auth.RegisterBaseAccount(accCdc)
+	app.SetAccountStoreCache(accCdc, app.GetCommitMultiStore().GetKVStore(capKey2), 10)
+	addr := sdk.AccAddress([]byte("simulate-bundle-addr"))
+	acc := auth.NewBaseAccountWithAddress(addr)
+	app.AccountStoreCache.SetAccount(addr, &acc)
+	app.InitChain(abci.RequestInitChain{})
+	app.SetCheckState(abci.Header{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	encode := func(counters ...int64) [][]byte {
+		txs := make([][]byte, len(counters))
+		for i, counter := range counters {
+			txs[i] = cdc.MustMarshalBinaryLengthPrefixed(newTxCounter(counter, counter))
+		}
+		return txs
+	}
+	query := func(bundle SimulateBundleRequest) (SimulateBundleResult, abci.ResponseQuery) {
+		res := app.Query(abci.RequestQuery{Path: "/app/simulateBundle", Data: codec.Cdc.MustMarshalJSON(bundle)})
+		var result SimulateBundleResult
+		if res.IsOK() {
+			codec.Cdc.MustUnmarshalJSON(res.Value, &result)
+		}
+		return result, res
+	}
+
+	// the txs see the changes of the previous ones, the failed tx doesn't stop the bundle
+	result, res := query(SimulateBundleRequest{Txs: encode(0, 1, 5, 2)})
+	require.True(t, res.IsOK(), res.Log)
+	require.Len(t, result.Results, 4)
+	require.True(t, result.Results[0].Result.IsOK())
+	require.True(t, result.Results[1].Result.IsOK())
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInternal), result.Results[2].Result.Code)
+	require.True(t, result.Results[3].Result.IsOK())
+	require.Len(t, result.Diff, 1)
+	require.Equal(t, capKey1.Name(), result.Diff[0].Store)
+	require.Equal(t, counterKey, result.Diff[0].Key)
+	counter, _ := binary.Varint(result.Diff[0].Value)
+	require.Equal(t, int64(3), counter)
+	require.Empty(t, result.Accounts)
+
+	// nothing is committed to the check state
+	require.Equal(t, int64(0), getIntFromStore(app.CheckState.Ctx.KVStore(capKey1), counterKey))
+
+	// the overrides apply before the bundle runs
+	counterBz := make([]byte, 8)
+	counterBz = counterBz[:binary.PutVarint(counterBz, 10)]
+	overrides := StateOverrides{
+		Accounts: []AccountOverride{{Address: addr, Coins: sdk.Coins{sdk.NewCoin("foo", 100)}}},
+		Stores:   []StoreOverride{{Store: capKey1.Name(), Key: counterKey, Value: counterBz}},
+	}
+	result, res = query(SimulateBundleRequest{Txs: encode(10), Overrides: overrides})
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, result.Results[0].Result.IsOK())
+	require.Len(t, result.Accounts, 1)
+	require.Equal(t, addr, result.Accounts[0].Address)
+	var changed auth.BaseAccount
+	require.NoError(t, accCdc.UnmarshalJSON(result.Accounts[0].Account, &changed))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("foo", 100)}, changed.GetCoins())
+	require.Equal(t, int64(0), getIntFromStore(app.CheckState.Ctx.KVStore(capKey1), counterKey))
+	require.Empty(t, app.CheckState.AccountCache.GetAccount(addr).GetCoins())
+
+	// the invalid requests
+	_, res = query(SimulateBundleRequest{})
+	require.False(t, res.IsOK())
+	_, res = query(SimulateBundleRequest{Txs: encode(make([]int64, MaxSimulateBundleTxs+1)...)})
+	require.False(t, res.IsOK())
+	_, res = query(SimulateBundleRequest{Txs: encode(0), Overrides: StateOverrides{Stores: []StoreOverride{{Store: "unknown", Key: counterKey}}}})
+	require.False(t, res.IsOK())
+	unknown := sdk.AccAddress([]byte("simulate-bundle-none"))
+	_, res = query(SimulateBundleRequest{Txs: encode(0), Overrides: StateOverrides{Accounts: []AccountOverride{{Address: unknown}}}})
+	require.False(t, res.IsOK())
+}
+
+func TestSimulateBundleTypedOverrides(t *testing.T) {
+	paramsKey, paramsTKey := sdk.NewKVStoreKey("params"), sdk.NewTransientStoreKey("transient_params")
+	paramsKeeper := params.NewKeeper(codec.New(), paramsKey, paramsTKey)
+	maxCounterKey := []byte("maxcounter")
+	space := paramsKeeper.Subspace("counter").WithTypeTable(params.NewTypeTable(maxCounterKey, int64(0)))
+
+	paramsOpt := func(bapp *BaseApp) {
+		bapp.MountStoresIAVL(paramsKey)
+		bapp.MountStoresTransient(paramsTKey)
+		bapp.SetParamSubspaces(paramsKeeper)
+	}
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, mode sdk.RunTxMode) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return ctx, sdk.Result{}, false
+		})
+	}
+	// the counter of the msg can not exceed the param
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			var maxCounter int64
+			space.GetIfExists(ctx, maxCounterKey, &maxCounter)
+			if msg.(*msgCounter).Counter > maxCounter {
+				return sdk.ErrInternal("counter is too large").Result()
+			}
+			return sdk.Result{}
+		})
+	}
+	app := setupBaseApp(t, paramsOpt, anteOpt, routerOpt)
+
+	accCdc := codec.New()
+	auth.RegisterBaseAccount(accCdc)
+	app.SetAccountStoreCache(accCdc, app.GetCommitMultiStore().GetKVStore(capKey2), 10)
+	app.InitChain(abci.RequestInitChain{})
+	app.SetCheckState(abci.Header{})
+
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	txs := [][]byte{cdc.MustMarshalBinaryLengthPrefixed(newTxCounter(5, 5))}
+	query := func(overrides StateOverrides) (SimulateBundleResult, abci.ResponseQuery) {
+		bundle := SimulateBundleRequest{Txs: txs, Overrides: overrides}
+		res := app.Query(abci.RequestQuery{Path: "/app/simulateBundle", Data: codec.Cdc.MustMarshalJSON(bundle)})
+		var result SimulateBundleResult
+		if res.IsOK() {
+			codec.Cdc.MustUnmarshalJSON(res.Value, &result)
+		}
+		return result, res
+	}
+
+	result, res := query(StateOverrides{})
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, result.Results[0].Result.IsOK())
+
+	// the params are decoded with their registered types, the new accounts are decoded with the account codec
+	addr := sdk.AccAddress([]byte("simulate-typed-addr"))
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.SetCoins(sdk.Coins{sdk.NewCoin("foo", 100)})
+	overrides := StateOverrides{
+		Accounts: []AccountOverride{{Address: addr, Account: accCdc.MustMarshalJSON(sdk.Account(&acc))}},
+		Params:   []ParamOverride{{Subspace: "counter", Key: string(maxCounterKey), Value: []byte(`"10"`)}},
+	}
+	result, res = query(overrides)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, result.Results[0].Result.IsOK(), result.Results[0].Result.Log)
+	require.Len(t, result.Accounts, 1)
+	var created auth.BaseAccount
+	require.NoError(t, accCdc.UnmarshalJSON(result.Accounts[0].Account, &created))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("foo", 100)}, created.GetCoins())
+	require.Nil(t, app.CheckState.AccountCache.GetAccount(addr))
+	require.False(t, space.Has(app.CheckState.Ctx, maxCounterKey))
+
+	// the overrides which can not be decoded are rejected before the bundle runs
+	invalids := []StateOverrides{
+		{Params: []ParamOverride{{Subspace: "counter", Key: string(maxCounterKey), Value: []byte(`"ten"`)}}},
+		{Params: []ParamOverride{{Subspace: "counter", Key: "unknown", Value: []byte(`"10"`)}}},
+		{Params: []ParamOverride{{Subspace: "unknown", Key: string(maxCounterKey), Value: []byte(`"10"`)}}},
+		{Accounts: []AccountOverride{{Address: addr, Account: []byte(`{"type":"unknown"}`)}}},
+		{Accounts: []AccountOverride{{Address: sdk.AccAddress([]byte("simulate-typed-none")), Account: overrides.Accounts[0].Account}}},
+	}
+	for _, invalid := range invalids {
+		_, res = query(invalid)
+		require.False(t, res.IsOK())
+	}
+}
@@ -7,26 +7,31 @@ import (
 
 // nolint
 const (
-	FlagUseLedger      = "ledger"
-	FlagUseTss         = "tss"
-	FlagChainID        = "chain-id"
-	FlagNode           = "node"
-	FlagHeight         = "height"
-	FlagTrustNode      = "trust-node"
-	FlagFrom           = "from"
-	FlagName           = "name"
-	FlagAccountNumber  = "account-number"
-	FlagSequence       = "sequence"
-	FlagMemo           = "memo"
-	FlagSource         = "source"
-	FlagAsync          = "async"
-	FlagJson           = "json"
-	FlagPrintResponse  = "print-response"
-	FlagDryRun         = "dry-run"
-	FlagDry            = "dry"
-	FlagOffline        = "offline"
-	FlagGenerateOnly   = "generate-only"
-	FlagIndentResponse = "indent"
+	FlagUseLedger        = "ledger"
+	FlagUseTss           = "tss"
+	FlagChainID          = "chain-id"
+	FlagNode             = "node"
+	FlagHeight           = "height"
+	FlagTrustNode        = "trust-node"
+	FlagFrom             = "from"
+	FlagName             = "name"
+	FlagAccountNumber    = "account-number"
+	FlagSequence         = "sequence"
+	FlagMemo             = "memo"
+	FlagSource           = "source"
+	FlagFeePayer         = "fee-payer"
+	FlagTimeoutHeight    = "timeout-height"
+	FlagTimeoutTimestamp = "timeout-timestamp"
+	FlagUnordered        = "unordered"
+	FlagFeeDenom         = "fee-denom"
+	FlagAsync            = "async"
+	FlagJson             = "json"
+	FlagPrintResponse    = "print-response"
+	FlagDryRun           = "dry-run"
+	FlagDry              = "dry"
+	FlagOffline          = "offline"
+	FlagGenerateOnly     = "generate-only"
+	FlagIndentResponse   = "indent"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -41,6 +46,7 @@ func GetCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagIndentResponse, false, "Add indent to JSON response")
 		c.Flags().Bool(FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
+		c.Flags().String(FlagFeeDenom, "", "Denom the fees are paid in by the fee payer or the first signer, it must be an accepted fee denom, the native token if empty")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Int64(FlagHeight, 0, "block height to query, omit to get most recent provable block")
@@ -61,6 +67,10 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().Int64(FlagSource, 0, "Source of tx")
+		c.Flags().String(FlagFeePayer, "", "Address of the account paying the fees through its fee grant")
+		c.Flags().Int64(FlagTimeoutHeight, 0, "The last block height at which the tx can be included, no timeout if 0")
+		c.Flags().Int64(FlagTimeoutTimestamp, 0, "The last block time(unix seconds) at which the tx can be included, no timeout if 0")
+		c.Flags().Bool(FlagUnordered, false, "Send an unordered tx whose sequence is a nonce, a random nonce is used if the sequence is not set")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
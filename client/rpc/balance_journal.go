@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BalanceJournalCommand returns the balance changes made in the block at the given height, the node has to
+// collect the balance journal
+func BalanceJournalCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-journal [height]",
+		Short: "Get the balance changes per account and denom made by the txs and the block at given height",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  printBalanceJournal,
+	}
+	cmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
+	return cmd
+}
+
+// getBalanceJournal reads the balance changes from the block results, the changes of BeginBlock and EndBlock
+// come first and last with an empty tx hash
+func getBalanceJournal(cliCtx context.CLIContext, height *int64) ([]sdk.TxBalanceChanges, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	block, err := node.Block(height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := node.BlockResults(&block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results.DeliverTx) != len(block.Block.Txs) {
+		return nil, fmt.Errorf("got %d tx results for %d txs", len(results.Results.DeliverTx), len(block.Block.Txs))
+	}
+
+	journal := make([]sdk.TxBalanceChanges, 0, len(block.Block.Txs)+2)
+	appendChanges := func(txHash string, events []abci.Event) error {
+		changes, err := sdk.BalanceChangesFromEvents(events)
+		if err != nil {
+			return err
+		}
+		if len(changes) != 0 {
+			journal = append(journal, sdk.TxBalanceChanges{TxHash: txHash, Changes: changes})
+		}
+		return nil
+	}
+	if results.Results.BeginBlock != nil {
+		if err := appendChanges("", results.Results.BeginBlock.Events); err != nil {
+			return nil, err
+		}
+	}
+	for i, res := range results.Results.DeliverTx {
+		txHash := cmn.HexBytes(tmhash.Sum(block.Block.Txs[i])).String()
+		if err := appendChanges(txHash, res.Events); err != nil {
+			return nil, err
+		}
+	}
+	if results.Results.EndBlock != nil {
+		if err := appendChanges("", results.Results.EndBlock.Events); err != nil {
+			return nil, err
+		}
+	}
+	return journal, nil
+}
+
+func printBalanceJournal(cmd *cobra.Command, args []string) error {
+	var height *int64
+	if len(args) > 0 {
+		h, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		if h > 0 {
+			height = &h
+		}
+	}
+
+	cliCtx := context.NewCLIContext()
+	journal, err := getBalanceJournal(cliCtx, height)
+	if err != nil {
+		return err
+	}
+	output, err := cdc.MarshalJSONIndent(journal, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// REST handler to get the balance changes of a block
+func BalanceJournalRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := strconv.ParseInt(mux.Vars(r)["height"], 10, 64)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "couldn't parse block height, assumed format is '/blocks/{height}/balance_journal'")
+			return
+		}
+		journal, err := getBalanceJournal(cliCtx, &height)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, journal, cliCtx.Indent)
+	}
+}
@@ -85,7 +85,7 @@ func WriteGenerateStdTxResponse(w http.ResponseWriter, txBldr authtxb.TxBuilder,
 		return
 	}
 
-	output, err := txBldr.Codec.MarshalJSON(auth.NewStdTx(stdMsg.Msgs, nil, stdMsg.Memo, stdMsg.Source, stdMsg.Data))
+	output, err := txBldr.Codec.MarshalJSON(auth.NewStdTx(stdMsg.Msgs, nil, stdMsg.Memo, stdMsg.Source, stdMsg.Data).WithOptions(stdMsg.Options()))
 	if err != nil {
 		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -163,7 +163,9 @@ func SignStdTx(txBldr authtxb.TxBuilder, cliCtx context.CLIContext, name string,
 		txBldr = txBldr.WithAccountNumber(accNum)
 	}
 
-	if !offline && txBldr.Sequence == 0 {
+	if stdTx.Unordered && txBldr.Sequence == 0 {
+		txBldr = txBldr.WithSequence(unorderedNonce())
+	} else if !offline && txBldr.Sequence == 0 {
 		accSeq, err := cliCtx.GetAccountSequence(addr)
 		if err != nil {
 			return signedStdTx, err
@@ -208,7 +210,9 @@ func prepareTxBuilder(txBldr authtxb.TxBuilder, cliCtx context.CLIContext) (auth
 
 	// TODO: (ref #1903) Allow for user supplied account sequence without
 	// automatically doing a manual lookup.
-	if txBldr.Sequence == 0 && !viper.GetBool(client.FlagOffline) {
+	if txBldr.Unordered && txBldr.Sequence == 0 {
+		txBldr = txBldr.WithSequence(unorderedNonce())
+	} else if txBldr.Sequence == 0 && !viper.GetBool(client.FlagOffline) {
 		accSeq, err := cliCtx.GetAccountSequence(from)
 		if err != nil {
 			return txBldr, err
@@ -233,7 +237,12 @@ func buildUnsignedStdTxOffline(txBldr authtxb.TxBuilder, msgs []sdk.Msg) (stdTx
 	if err != nil {
 		return
 	}
-	return auth.NewStdTx(stdSignMsg.Msgs, nil, stdSignMsg.Memo, stdSignMsg.Source, nil), nil
+	return auth.NewStdTx(stdSignMsg.Msgs, nil, stdSignMsg.Memo, stdSignMsg.Source, nil).WithOptions(stdSignMsg.Options()), nil
+}
+
+// unorderedNonce returns a random nonce for an unordered tx
+func unorderedNonce() int64 {
+	return cmn.RandInt63()
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
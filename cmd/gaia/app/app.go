@@ -17,12 +17,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/multisig"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/schedule"
 	"github.com/cosmos/cosmos-sdk/x/sidechain"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -50,6 +54,7 @@ type GaiaApp struct {
 	// keys to access the substores
 	keyMain          *sdk.KVStoreKey
 	keyAccount       *sdk.KVStoreKey
+	keyBank          *sdk.KVStoreKey
 	keyStake         *sdk.KVStoreKey
 	keyStakeReward   *sdk.KVStoreKey
 	tkeyStake        *sdk.TransientStoreKey
@@ -63,11 +68,16 @@ type GaiaApp struct {
 	tkeyParams       *sdk.TransientStoreKey
 	keyIbc           *sdk.KVStoreKey
 	keySide          *sdk.KVStoreKey
+	keyFeeGrant      *sdk.KVStoreKey
+	keyAuthz         *sdk.KVStoreKey
+	keySchedule      *sdk.KVStoreKey
+	keyMultisig      *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountKeeper       auth.AccountKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	bankKeeper          bank.Keeper
+	denomOwners         bank.DenomOwnerRegistry
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
 	mintKeeper          mint.Keeper
@@ -75,6 +85,10 @@ type GaiaApp struct {
 	govKeeper           gov.Keeper
 	paramsKeeper        params.Keeper
 	ibcKeeper           ibc.Keeper
+	feeGrantKeeper      feegrant.Keeper
+	authzKeeper         authz.Keeper
+	scheduleKeeper      schedule.Keeper
+	multisigKeeper      multisig.Keeper
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
@@ -89,6 +103,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		cdc:              cdc,
 		keyMain:          sdk.NewKVStoreKey("main"),
 		keyAccount:       sdk.NewKVStoreKey("acc"),
+		keyBank:          sdk.NewKVStoreKey("bank"),
 		keyStake:         sdk.NewKVStoreKey("stake"),
 		keyStakeReward:   sdk.NewKVStoreKey("stake_reward"),
 		tkeyStake:        sdk.NewTransientStoreKey("transient_stake"),
@@ -102,6 +117,10 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		tkeyParams:       sdk.NewTransientStoreKey("transient_params"),
 		keyIbc:           sdk.NewKVStoreKey("ibc"),
 		keySide:          sdk.NewKVStoreKey("sc"),
+		keyFeeGrant:      sdk.NewKVStoreKey(feegrant.StoreKey),
+		keyAuthz:         sdk.NewKVStoreKey(authz.StoreKey),
+		keySchedule:      sdk.NewKVStoreKey(schedule.StoreKey),
+		keyMultisig:      sdk.NewKVStoreKey(multisig.StoreKey),
 	}
 
 	// define the accountKeeper
@@ -112,7 +131,9 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	)
 
 	// add handlers
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper)
+	app.denomOwners = bank.NewDenomOwnerRegistry(app.keyBank)
+	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper).
+		WithTransferHooks(bank.NewTransferHooks(app.keyBank, app.denomOwners))
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(
 		app.cdc,
 		app.keyFeeCollection,
@@ -155,6 +176,12 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		app.RegisterCodespace(gov.DefaultCodespace),
 		app.Pool,
 	)
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, app.keyFeeGrant)
+	app.authzKeeper = authz.NewKeeper(app.cdc, app.keyAuthz, app.Router())
+	app.scheduleKeeper = schedule.NewKeeper(app.cdc, app.keySchedule, app.bankKeeper)
+	app.multisigKeeper = multisig.NewKeeper(app.cdc, app.keyMultisig, app.Router())
+
+	app.stakeKeeper.SetFeeInsurance(app.slashingKeeper)
 
 	// register the staking hooks
 	app.stakeKeeper = app.stakeKeeper.WithHooks(
@@ -166,18 +193,29 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		AddRoute("stake", stake.NewStakeHandler(app.stakeKeeper)).
 		AddRoute("distr", distr.NewHandler(app.distrKeeper)).
 		AddRoute("slashing", slashing.NewSlashingHandler(app.slashingKeeper)).
-		AddRoute("gov", gov.NewHandler(app.govKeeper))
+		AddRoute("gov", gov.NewHandler(app.govKeeper)).
+		AddRoute(feegrant.RouteFeeGrant, feegrant.NewHandler(app.feeGrantKeeper)).
+		AddRoute(authz.RouteAuthz, authz.NewHandler(app.authzKeeper)).
+		AddRoute(schedule.RouteSchedule, schedule.NewHandler(app.scheduleKeeper)).
+		AddRoute(multisig.RouteMultisig, multisig.NewHandler(app.multisigKeeper))
 
 	app.QueryRouter().
 		AddRoute("gov", gov.NewQuerier(app.govKeeper)).
-		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc))
+		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc)).
+		AddRoute(feegrant.StoreKey, feegrant.NewQuerier(app.feeGrantKeeper, app.cdc)).
+		AddRoute(authz.StoreKey, authz.NewQuerier(app.authzKeeper, app.cdc)).
+		AddRoute(schedule.StoreKey, schedule.NewQuerier(app.scheduleKeeper, app.cdc)).
+		AddRoute(multisig.StoreKey, multisig.NewQuerier(app.multisigKeeper, app.cdc))
 
 	// initialize BaseApp
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyStake, app.keyStakeReward, app.keyMint, app.keyDistr,
-		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams, app.keyIbc)
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyStake, app.keyStakeReward, app.keyMint, app.keyDistr,
+		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams, app.keyIbc, app.keyFeeGrant, app.keyAuthz,
+		app.keySchedule, app.keyMultisig)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, auth.WithFeeGrantKeeper(app.feeGrantKeeper),
+		auth.WithFeeCollectionKeeper(app.feeCollectionKeeper)))
+	app.SetParamSubspaces(app.paramsKeeper)
 	app.MountStoresTransient(app.tkeyParams, app.tkeyStake, app.tkeyDistr)
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -193,6 +231,7 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	if err != nil {
 		cmn.Exit(err.Error())
 	}
+	app.govKeeper.ApplyUpgradePlans(app.NewContext(sdk.RunTxModeCheck, abci.Header{}))
 
 	return app
 }
@@ -205,6 +244,10 @@ func MakeCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
+	feegrant.RegisterCodec(cdc)
+	authz.RegisterCodec(cdc)
+	schedule.RegisterCodec(cdc)
+	multisig.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -213,6 +256,7 @@ func MakeCodec() *codec.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	gov.BeginBlocker(ctx, app.govKeeper)
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
 	// distribute rewards from previous block
@@ -233,6 +277,7 @@ func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 	gov.EndBlocker(ctx, app.govKeeper)
 	validatorUpdates, _ := stake.EndBlocker(ctx, app.stakeKeeper)
 	ibc.EndBlocker(ctx, app.ibcKeeper)
+	schedule.EndBlocker(ctx, app.scheduleKeeper)
 
 	// Add these new validators to the addr -> pubkey map.
 	app.slashingKeeper.AddValidators(ctx, validatorUpdates)
@@ -262,6 +307,8 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
 
+	bank.InitGenesis(ctx, app.denomOwners, genesisState.BankData)
+
 	// load the initial stake information
 	validators, err := stake.InitGenesis(ctx, app.stakeKeeper, genesisState.StakeData)
 	if err != nil {
@@ -335,6 +382,7 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 		gov.WriteGenesis(ctx, app.govKeeper),
 		slashing.GenesisState{}, // TODO create write methods
 	)
+	genState.BankData = bank.ExportGenesis(ctx, app.denomOwners)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, err
@@ -44,6 +44,7 @@ func NewMockGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppO
 		cdc:            cdc,
 		keyMain:        sdk.NewKVStoreKey("main"),
 		keyAccount:     sdk.NewKVStoreKey("acc"),
+		keyBank:        sdk.NewKVStoreKey("bank"),
 		keyStake:       sdk.NewKVStoreKey("stake"),
 		keyStakeReward: sdk.NewKVStoreKey("stake_reward"),
 		tkeyStake:      sdk.NewTransientStoreKey("transient_stake"),
@@ -68,7 +69,9 @@ func NewMockGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppO
 	)
 
 	// add handlers
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper)
+	app.denomOwners = bank.NewDenomOwnerRegistry(app.keyBank)
+	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper).
+		WithTransferHooks(bank.NewTransferHooks(app.keyBank, app.denomOwners))
 	app.paramsKeeper = params.NewKeeper(
 		app.cdc,
 		app.keyParams, app.tkeyParams,
@@ -126,7 +129,7 @@ func NewMockGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppO
 		AddRoute("stake", stake.NewQuerier(app.stakeKeeper, app.cdc))
 
 	// initialize BaseApp
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyStake, app.keyMint, app.keyDistr,
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyStake, app.keyMint, app.keyDistr,
 		app.keySlashing, app.keyGov, app.keyParams)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -17,9 +17,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authzcmd "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	distrcmd "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	feegrantcmd "github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govcmd "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	multisigcmd "github.com/cosmos/cosmos-sdk/x/multisig/client/cli"
+	schedulecmd "github.com/cosmos/cosmos-sdk/x/schedule/client/cli"
 	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	stakecmd "github.com/cosmos/cosmos-sdk/x/stake/client/cli"
 )
@@ -59,6 +63,7 @@ func main() {
 	}
 	queryCmd.AddCommand(
 		rpc.BlockCommand(),
+		rpc.BalanceJournalCommand(),
 		rpc.ValidatorCommand(),
 	)
 	tx.AddCommands(queryCmd, cdc)
@@ -114,6 +119,10 @@ func main() {
 			slashingcmd.GetCmdUnjail(cdc),
 			govcmd.GetCmdVote(cdc),
 		)...)
+	feegrantcmd.AddCommands(txCmd, cdc)
+	schedulecmd.AddCommands(txCmd, cdc)
+	multisigcmd.AddCommands(txCmd, cdc)
+	authzcmd.AddCommands(txCmd, cdc)
 	rootCmd.AddCommand(
 		queryCmd,
 		txCmd,
@@ -7,7 +7,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/cli"
@@ -35,6 +34,8 @@ func main() {
 	rootCmd.AddCommand(gaiaInit.GenTxCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, exportAppStateAndTMValidators)
+	rootCmd.AddCommand(server.SnapshotCmd(ctx, newApp))
+	rootCmd.AddCommand(server.PruneCmd(ctx, newApp))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
@@ -46,8 +47,12 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
+	pruning, err := server.GetPruningStrategy()
+	if err != nil {
+		panic(err)
+	}
 	return app.NewGaiaApp(logger, db, traceStore,
-		baseapp.SetPruning(viper.GetString("pruning")),
+		baseapp.SetPruningStrategy(pruning),
 	)
 }
 
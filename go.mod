@@ -30,7 +30,10 @@ require (
 	golang.org/x/crypto v0.7.0
 )
 
-require github.com/btcsuite/btcd/btcec/v2 v2.3.2
+require (
+	github.com/btcsuite/btcd/btcec/v2 v2.3.2
+	github.com/golang/snappy v0.0.4
+)
 
 require (
 	contrib.go.opencensus.io/exporter/jaeger v0.2.1 // indirect
@@ -59,7 +62,6 @@ require (
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e // indirect
 	github.com/golang/protobuf v1.5.3 // indirect
-	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
 	github.com/google/gofuzz v1.2.0 // indirect
 	github.com/google/uuid v1.3.0 // indirect
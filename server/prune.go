@@ -0,0 +1,103 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagPruningKeepRecent = "pruning-keep-recent"
+	flagPruningKeepEvery  = "pruning-keep-every"
+	flagPruningInterval   = "pruning-interval"
+)
+
+// AddPruningFlags adds the flags read by GetPruningStrategy
+func AddPruningFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything, custom")
+	cmd.Flags().Int64(flagPruningKeepRecent, 0, "Number of recent versions to keep with the custom pruning strategy")
+	cmd.Flags().Int64(flagPruningKeepEvery, 0, "Keep every n-th version forever with the custom pruning strategy, 0 keeps none")
+	cmd.Flags().Int64(flagPruningInterval, 0, fmt.Sprintf("Number of commits between two prunings, 0 or 1 prunes on "+
+		"every commit (default %d with the custom pruning strategy, 1 with the others)", sdk.DefaultPruningInterval))
+}
+
+// GetPruningStrategy returns the pruning strategy of the flags, the keep-recent and keep-every flags only apply to
+// the custom strategy while the interval applies to all of them. The syncable strategy is used if no flag is set.
+func GetPruningStrategy() (sdk.PruningStrategy, error) {
+	var strategy sdk.PruningStrategy
+	switch pruning := viper.GetString(flagPruning); pruning {
+	case "nothing":
+		return sdk.PruneNothing, nil
+	case "everything":
+		strategy = sdk.PruneEverything
+	case "syncable", "":
+		strategy = sdk.PruneSyncable
+	case "custom":
+		strategy = sdk.NewPruningStrategy(viper.GetInt64(flagPruningKeepRecent), viper.GetInt64(flagPruningKeepEvery),
+			sdk.DefaultPruningInterval)
+	default:
+		return strategy, errors.Errorf("invalid pruning strategy: %s", pruning)
+	}
+	if viper.IsSet(flagPruningInterval) {
+		strategy.Interval = viper.GetInt64(flagPruningInterval)
+	}
+	return strategy, strategy.Validate()
+}
+
+// PruneCmd deletes the versions the pruning strategy does not keep from the app db at once and compacts it
+func PruneCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete the old versions of the app state offline and compact the db",
+		Long: `Delete all the versions of the app state the pruning strategy does not keep, e.g. after changing the
+strategy of an existing node. The node must be stopped while pruning.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strategy, err := GetPruningStrategy()
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(viper.GetString("home"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, ok := appCreator(ctx.Logger, db, nil).(interface {
+				GetCommitMultiStore() sdk.CommitMultiStore
+			})
+			if !ok {
+				return errors.New("the app does not expose its commit multi store")
+			}
+			cms := app.GetCommitMultiStore()
+			for key, commitStore := range cms.GetCommitKVStores() {
+				iavlStore, ok := commitStore.(*store.IavlStore)
+				if !ok {
+					continue
+				}
+				deleted := iavlStore.PruneVersions(strategy)
+				ctx.Logger.Info("pruned store", "store", key.Name(), "versions", deleted)
+			}
+
+			if levelDB, ok := db.(*dbm.GoLevelDB); ok {
+				ctx.Logger.Info("compacting the db")
+				if err := levelDB.DB().CompactRange(util.Range{}); err != nil {
+					return err
+				}
+			}
+
+			fmt.Printf("pruned the app state at version %d with strategy %+v\n", cms.LastCommitID().Version, strategy)
+			return nil
+		},
+	}
+	AddPruningFlags(cmd)
+	return cmd
+}
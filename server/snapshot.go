@@ -0,0 +1,218 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagSnapshotOutput  = "output"
+	flagSnapshotAppHash = "app-hash"
+)
+
+// SnapshotCmd moves the snapshots taken for the state sync between nodes without peers
+func SnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export, verify and import state sync snapshots offline",
+	}
+	cmd.AddCommand(
+		exportSnapshotCmd(ctx),
+		verifySnapshotCmd(ctx, appCreator),
+		importSnapshotCmd(ctx, appCreator),
+	)
+	return cmd
+}
+
+func exportSnapshotCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [height]",
+		Short: "Export the snapshot at the height to an archive, the latest snapshot is exported by default",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var height int64
+			if len(args) == 1 {
+				var err error
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil || height <= 0 {
+					return fmt.Errorf("invalid height %s", args[0])
+				}
+			}
+
+			output := viper.GetString(flagSnapshotOutput)
+			file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+			if err != nil {
+				return err
+			}
+			manifest, err := store.ExportSnapshotArchive(ctx.Config.DBDir(), height, file)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(output)
+				return err
+			}
+
+			fmt.Printf("exported the snapshot at height %d with %d chunks to %s\n", manifest.Height,
+				len(manifest.StateHashes)+len(manifest.AppStateHashes)+len(manifest.BlockHashes), output)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSnapshotOutput, "snapshot.tar.gz", "the archive file to create")
+	return cmd
+}
+
+func verifySnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [archive]",
+		Short: "Restore the archive in memory and check it against the trusted app hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustedHash, err := trustedAppHash()
+			if err != nil {
+				return err
+			}
+
+			db := dbm.NewMemDB()
+			defer db.Close()
+			restored, err := restoreSnapshotArchive(ctx.Logger, appCreator, db, args[0], trustedHash, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("the snapshot at height %d matches app hash %X\n", restored.Height, trustedHash)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSnapshotAppHash, "", "the trusted app hash in hex after the block of the snapshot")
+	return cmd
+}
+
+func importSnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [archive]",
+		Short: "Bootstrap a fresh node home from the archive without peers",
+		Long: `Restore the app state, the tendermint state and the last block of the archive into a home
+that has just been initialized, the node starts from the height of the snapshot afterwards. The archive
+is also kept as a local snapshot so the node can serve the state sync to its peers.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustedHash, err := trustedAppHash()
+			if err != nil {
+				return err
+			}
+
+			home := viper.GetString("home")
+			emptyState, err := isEmptyState(home)
+			if err != nil {
+				return err
+			}
+			if !emptyState {
+				return errors.Errorf("the data of %s is not empty, run unsafe-reset-all first", home)
+			}
+
+			archive, err := store.OpenSnapshotArchive(args[0])
+			if err != nil {
+				return err
+			}
+			writer := abci.SnapshotWriter{Height: archive.Manifest.Height, DbDir: ctx.Config.DBDir()}
+			if err := writer.WriteManifest(archive.CompressedManifest); err != nil {
+				archive.Close()
+				return err
+			}
+			archive.Close()
+
+			db, err := openDB(home)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			restored, err := restoreSnapshotArchive(ctx.Logger, appCreator, db, args[0], trustedHash, writer.Write)
+			if err != nil {
+				writer.Delete()
+				return errors.Errorf("%v, run unsafe-reset-all before importing again", err)
+			}
+			if err := writer.Finalize(); err != nil {
+				return err
+			}
+
+			backend := dbm.DBBackendType(ctx.Config.DBBackend)
+			stateDB := dbm.NewDB("state", backend, ctx.Config.DBDir())
+			sm.SaveState(stateDB, restored.State)
+			stateDB.Close()
+
+			blockStoreDB := dbm.NewDB("blockstore", backend, ctx.Config.DBDir())
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+			blockStore.SetHeight(restored.Height - 1)
+			blockStore.SaveBlock(restored.Block, restored.Block.MakePartSet(tmtypes.BlockPartSizeBytes), restored.SeenCommit)
+			blockStoreDB.Close()
+
+			fmt.Printf("imported the snapshot at height %d with app hash %X\n", restored.Height, trustedHash)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSnapshotAppHash, "", "the trusted app hash in hex after the block of the snapshot")
+	return cmd
+}
+
+func trustedAppHash() ([]byte, error) {
+	appHash, err := hex.DecodeString(viper.GetString(flagSnapshotAppHash))
+	if err != nil || len(appHash) == 0 {
+		return nil, errors.Errorf("--%s should be the trusted app hash in hex", flagSnapshotAppHash)
+	}
+	return appHash, nil
+}
+
+// restoreSnapshotArchive writes the app state of the archive into the db through the multi store of the app,
+// and checks the restored stores against the app hash in the tendermint state and the trusted one
+func restoreSnapshotArchive(logger log.Logger, appCreator AppCreator, db dbm.DB, path string, trustedHash []byte,
+	cb func(hash abci.SHA256Sum, compressed []byte) error) (*store.RestoredSnapshot, error) {
+	app, ok := appCreator(logger, db, nil).(interface {
+		GetCommitMultiStore() sdk.CommitMultiStore
+	})
+	if !ok {
+		return nil, errors.New("the app does not expose its commit multi store")
+	}
+	cms := app.GetCommitMultiStore()
+
+	archive, err := store.OpenSnapshotArchive(path)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	helper := store.NewStateSyncHelper(logger, db, cms, codec.New())
+	restored, err := store.RestoreSnapshotArchive(archive, helper, cb)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(restored.State.AppHash, trustedHash) {
+		return nil, errors.Errorf("the app hash %X in the archive does not match the trusted %X",
+			restored.State.AppHash, trustedHash)
+	}
+
+	appHash, err := store.VerifyRestoredStores(cms)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(appHash, trustedHash) {
+		return nil, errors.Errorf("the restored app hash %X does not match the trusted %X", appHash, trustedHash)
+	}
+	return restored, nil
+}
@@ -159,6 +159,14 @@ func (ci *cacheKVStore) iterator(start, end []byte, ascending bool) Iterator {
 	return newCacheMergeIterator(parent, cache, ascending)
 }
 
+// Diff returns the pending writes sorted by the keys, the values of the deleted keys are nil.
+func (ci *cacheKVStore) Diff() []cmn.KVPair {
+	ci.mtx.Lock()
+	defer ci.mtx.Unlock()
+
+	return ci.dirtyItems(true)
+}
+
 // Constructs a slice of dirty items, to use w/ memIterator.
 func (ci *cacheKVStore) dirtyItems(ascending bool) []cmn.KVPair {
 	items := make([]cmn.KVPair, 0, len(ci.cache))
@@ -2,6 +2,9 @@ package store
 
 import (
 	"io"
+	"sort"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +24,25 @@ type cacheMultiStore struct {
 }
 
 var _ CacheMultiStore = cacheMultiStore{}
+var _ DiffMultiStore = cacheMultiStore{}
+
+// DiffMultiStore is a CacheMultiStore which reports the writes pending on its stores.
+type DiffMultiStore interface {
+	CacheMultiStore
+
+	// GetKVStoreByName returns the store with the key name, or nil if there is no such store
+	GetKVStoreByName(name string) KVStore
+
+	// Diff returns the pending writes sorted by the store names and the keys
+	Diff() []KVDiff
+}
+
+// KVDiff is a pending write of a cached store, the value of a deleted key is nil.
+type KVDiff struct {
+	Store string `json:"store"`
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
 
 func newCacheMultiStoreFromRMS(rms *rootMultiStore) cacheMultiStore {
 	cms := cacheMultiStore{
@@ -132,3 +154,36 @@ func (cms cacheMultiStore) GetStore(key StoreKey) Store {
 func (cms cacheMultiStore) GetKVStore(key StoreKey) KVStore {
 	return cms.stores[key].(KVStore)
 }
+
+// Implements DiffMultiStore.
+func (cms cacheMultiStore) GetKVStoreByName(name string) KVStore {
+	for key, store := range cms.stores {
+		if key.Name() == name {
+			return store.(KVStore)
+		}
+	}
+	return nil
+}
+
+// Implements DiffMultiStore.
+func (cms cacheMultiStore) Diff() []KVDiff {
+	names := make([]string, 0, len(cms.stores))
+	storesByName := make(map[string]CacheWrap, len(cms.stores))
+	for key, store := range cms.stores {
+		names = append(names, key.Name())
+		storesByName[key.Name()] = store
+	}
+	sort.Strings(names)
+
+	diff := make([]KVDiff, 0)
+	for _, name := range names {
+		store, ok := storesByName[name].(interface{ Diff() []cmn.KVPair })
+		if !ok {
+			continue
+		}
+		for _, item := range store.Diff() {
+			diff = append(diff, KVDiff{Store: name, Key: item.Key, Value: item.Value})
+		}
+	}
+	return diff
+}
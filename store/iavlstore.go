@@ -27,6 +27,7 @@ func LoadIAVLStore(db dbm.DB, id CommitID, pruning sdk.PruningStrategy) (CommitS
 		return nil, err
 	}
 	iavl := newIAVLStore(tree, int64(0), int64(0))
+	iavl.db = db
 	iavl.SetPruning(pruning)
 	return iavl, nil
 }
@@ -55,6 +56,25 @@ type IavlStore struct {
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
 
+	// The number of commits between two prunings.
+	// A value of 0 or 1 means the old versions are queued for deletion in every Commit.
+	pruneInterval int64
+
+	// The released versions waiting for the next pruning in ascending order.
+	pendingVersions []int64
+
+	// The versions queued for the background pruner in ascending order.
+	pruneQueue []int64
+	pruner     *pruner
+
+	// mtx guards the tree versions and the queues shared with the pruner, pruned is signaled after
+	// every deletion of the pruner.
+	mtx    sync.Mutex
+	pruned *sync.Cond
+
+	// The db of the tree, the versions left on disk are read from it when the pruning is set.
+	db dbm.DB
+
 	diff map[string]struct{}
 }
 
@@ -67,6 +87,7 @@ func newIAVLStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *Ia
 		storeEvery: storeEvery,
 		diff:       nil,
 	}
+	st.pruned = sync.NewCond(&st.mtx)
 	return st
 }
 
@@ -79,11 +100,16 @@ func (st *IavlStore) GetTree() *iavl.MutableTree {
 }
 
 func (st *IavlStore) SetVersion(version int64) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
 	st.Tree.SetVersion(version)
 }
 
 // Implements Committer.
 func (st *IavlStore) Commit() CommitID {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
 	// Save a new version.
 	hash, version, err := st.Tree.SaveVersion()
 	if err != nil {
@@ -96,12 +122,17 @@ func (st *IavlStore) Commit() CommitID {
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
 		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.Tree.DeleteVersion(toRelease)
-			if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
+			st.pendingVersions = append(st.pendingVersions, toRelease)
 		}
 	}
+	if len(st.pendingVersions) > 0 && (st.pruneInterval <= 1 || version%st.pruneInterval == 0) {
+		st.pruneQueue = append(st.pruneQueue, st.pendingVersions...)
+		st.pendingVersions = nil
+		if st.pruner == nil {
+			st.pruner = startPruner(st)
+		}
+		st.pruner.notify()
+	}
 
 	return CommitID{
 		Version: version,
@@ -109,6 +140,77 @@ func (st *IavlStore) Commit() CommitID {
 	}
 }
 
+// pruneOldestVersion deletes the oldest queued version, it returns false if the queue is empty
+func (st *IavlStore) pruneOldestVersion() bool {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	if len(st.pruneQueue) == 0 {
+		return false
+	}
+	st.deleteVersion(st.pruneQueue[0])
+	st.pruneQueue = st.pruneQueue[1:]
+	st.pruned.Broadcast()
+	return true
+}
+
+// waitPruned blocks until the pruner deleted all the queued versions
+func (st *IavlStore) waitPruned() {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	for len(st.pruneQueue) > 0 {
+		st.pruned.Wait()
+	}
+}
+
+// StopPruning stops the background pruner of the store and waits for the deletion in progress. The versions
+// left in the queue are released again by SetPruning when the store is loaded next time.
+func (st *IavlStore) StopPruning() {
+	st.mtx.Lock()
+	p := st.pruner
+	st.pruner = nil
+	st.mtx.Unlock()
+	if p != nil {
+		p.halt()
+	}
+}
+
+func (st *IavlStore) deleteVersion(version int64) {
+	err := st.Tree.DeleteVersion(version)
+	if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
+		panic(err)
+	}
+}
+
+// versions on disk before the latest one the strategy does not keep
+func (st *IavlStore) releasedVersions(strategy sdk.PruningStrategy) []int64 {
+	if st.db == nil {
+		return nil
+	}
+	latest := st.Tree.Version()
+	var released []int64
+	for _, version := range availableVersions(st.db) {
+		if version < latest && !strategy.ShouldKeep(version, latest) {
+			released = append(released, version)
+		}
+	}
+	return released
+}
+
+// PruneVersions deletes all the versions the strategy does not keep at once and returns the number of
+// deleted versions, it is used to compact the stores offline
+func (st *IavlStore) PruneVersions(strategy sdk.PruningStrategy) int64 {
+	st.StopPruning()
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	released := st.releasedVersions(strategy)
+	for _, version := range released {
+		st.deleteVersion(version)
+	}
+	st.pendingVersions = nil
+	st.pruneQueue = nil
+	return int64(len(released))
+}
+
 // Implements Committer.
 func (st *IavlStore) LastCommitID() CommitID {
 	return CommitID{
@@ -118,21 +220,24 @@ func (st *IavlStore) LastCommitID() CommitID {
 }
 
 // Implements Committer.
+// The versions left on disk by a previous run that the strategy does not keep are released again,
+// as the released versions waiting for a pruning are only kept in memory.
 func (st *IavlStore) SetPruning(pruning sdk.PruningStrategy) {
-	switch pruning {
-	case sdk.PruneEverything:
-		st.numRecent = 0
-		st.storeEvery = 0
-	case sdk.PruneNothing:
-		st.storeEvery = 1
-	case sdk.PruneSyncable:
-		st.numRecent = 100000 // fork github.com/cosmos/cosmos-sdk/blob/9a16e2675f392b083dd1074ff92ff1f9fbda750d/store/types/pruning.go#L34
-		st.storeEvery = 100000
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+	st.numRecent = pruning.KeepRecent
+	st.storeEvery = pruning.KeepEvery
+	st.pruneInterval = pruning.Interval
+	if st.db != nil {
+		st.pendingVersions = st.releasedVersions(pruning)
+		st.pruneQueue = nil
 	}
 }
 
 // VersionExists returns whether or not a given version is stored.
 func (st *IavlStore) VersionExists(version int64) bool {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
 	return st.Tree.VersionExists(version)
 }
 
@@ -232,6 +337,9 @@ func (st *IavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return sdk.ErrTxDecode(msg).QueryResult()
 	}
 
+	// the versions are read while the pruner may delete the old ones
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
 	tree := st.Tree
 
 	// store the height we chose in the response, with 0 being changed to the
@@ -242,7 +350,7 @@ func (st *IavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	case "/store", "/key": // Get by key
 		key := req.Data // Data holds the key bytes
 		res.Key = key
-		if !st.VersionExists(res.Height) {
+		if !tree.VersionExists(res.Height) {
 			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
 			break
 		}
@@ -273,7 +381,7 @@ func (st *IavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	case "/ics23-key":
 		key := req.Data // Data holds the key bytes
 		res.Key = key
-		if !st.VersionExists(res.Height) {
+		if !tree.VersionExists(res.Height) {
 			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
 			break
 		}
@@ -268,6 +268,7 @@ func nextVersion(iavl *IavlStore) {
 	value := []byte(fmt.Sprintf("Value for tree: %d", iavl.LastCommitID().Version))
 	iavl.Set(key, value)
 	iavl.Commit()
+	iavl.waitPruned()
 }
 
 func TestIAVLDefaultPruning(t *testing.T) {
@@ -487,3 +488,100 @@ func BenchmarkIAVLIteratorNext(b *testing.B) {
 		}
 	}
 }
+
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := newIAVLStore(tree, numRecent, storeEvery)
+	iavlStore.SetPruning(sdk.NewPruningStrategy(2, 0, 5))
+
+	for i := 0; i < 4; i++ {
+		nextVersion(iavlStore)
+	}
+	// the released versions wait for the next interval
+	for ver := int64(1); ver <= 4; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "version %d should not be pruned before the interval", ver)
+	}
+
+	for i := 0; i < 6; i++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(1); ver <= 7; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "version %d should be pruned", ver)
+	}
+	for ver := int64(8); ver <= 10; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "recent version %d should be kept", ver)
+	}
+}
+
+func TestIAVLBackgroundPruning(t *testing.T) {
+	defer func(limit int) { pruneRateLimit = limit }(pruneRateLimit)
+	pruneRateLimit = 2
+
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := newIAVLStore(tree, numRecent, storeEvery)
+	iavlStore.SetPruning(sdk.NewPruningStrategy(1, 0, 6))
+	for i := 0; i < 6; i++ {
+		iavlStore.Set([]byte{byte(i)}, []byte{byte(i)})
+		iavlStore.Commit()
+	}
+
+	// Commit only queues the released versions, the pruner deletes them one by one
+	iavlStore.mtx.Lock()
+	queued := len(iavlStore.pruneQueue)
+	iavlStore.mtx.Unlock()
+	require.True(t, queued >= 3, "versions should be queued for the pruner, %d left", queued)
+
+	iavlStore.waitPruned()
+	for ver := int64(1); ver <= 4; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "version %d should be pruned", ver)
+	}
+	require.True(t, iavlStore.VersionExists(5))
+	require.True(t, iavlStore.VersionExists(6))
+	iavlStore.StopPruning()
+}
+
+func TestIAVLPruningAfterReload(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := LoadIAVLStore(db, CommitID{}, sdk.NewPruningStrategy(2, 0, 10))
+	require.NoError(t, err)
+	iavlStore := store.(*IavlStore)
+	for i := 0; i < 8; i++ {
+		nextVersion(iavlStore)
+	}
+	// versions 1 to 5 are released but pending when the node stops
+	for ver := int64(1); ver <= 8; ver++ {
+		require.True(t, iavlStore.VersionExists(ver))
+	}
+
+	store, err = LoadIAVLStore(db, iavlStore.LastCommitID(), sdk.NewPruningStrategy(2, 0, 10))
+	require.NoError(t, err)
+	iavlStore = store.(*IavlStore)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, iavlStore.pendingVersions)
+	nextVersion(iavlStore)
+	nextVersion(iavlStore)
+	for ver := int64(1); ver <= 7; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "version %d should be pruned", ver)
+	}
+	for ver := int64(8); ver <= 10; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "recent version %d should be kept", ver)
+	}
+}
+
+func TestIAVLPruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := LoadIAVLStore(db, CommitID{}, sdk.PruneNothing)
+	require.NoError(t, err)
+	iavlStore := store.(*IavlStore)
+	for i := 0; i < 20; i++ {
+		nextVersion(iavlStore)
+	}
+
+	deleted := iavlStore.PruneVersions(sdk.NewPruningStrategy(3, 5, 0))
+	require.Equal(t, int64(13), deleted)
+	for ver := int64(1); ver <= 20; ver++ {
+		kept := ver >= 17 || ver%5 == 0
+		require.Equal(t, kept, iavlStore.VersionExists(ver), "version %d", ver)
+	}
+}
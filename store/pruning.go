@@ -0,0 +1,80 @@
+package store
+
+import (
+	"time"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// pruneRateLimit is the max number of versions of a store deleted per second in the background
+var pruneRateLimit = 200
+
+// the roots of the iavl versions are saved under r<version>, see rootKeyFormat of iavl
+var iavlRootKeyFormat = iavl.NewKeyFormat('r', 8)
+
+// availableVersions returns the versions of the iavl tree saved in the db in ascending order
+func availableVersions(db dbm.DB) []int64 {
+	var versions []int64
+	iter := dbm.IteratePrefix(db, []byte(iavlRootKeyFormat.Prefix()))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var version int64
+		iavlRootKeyFormat.Scan(iter.Key(), &version)
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+// pruner deletes the versions queued by Commit of a store in the background. The deletions are rate limited
+// so they spread over the following blocks instead of adding to the latency of Commit, a Commit waits for
+// at most one deletion.
+type pruner struct {
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+func startPruner(st *IavlStore) *pruner {
+	p := &pruner{
+		wake: make(chan struct{}, 1),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go p.run(st)
+	return p
+}
+
+func (p *pruner) notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *pruner) run(st *IavlStore) {
+	defer close(p.done)
+	limiter := time.NewTicker(time.Second / time.Duration(pruneRateLimit))
+	defer limiter.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-p.wake:
+		}
+		for st.pruneOldestVersion() {
+			select {
+			case <-p.stop:
+				return
+			case <-limiter.C:
+			}
+		}
+	}
+}
+
+// halt stops the pruner and waits for the deletion in progress
+func (p *pruner) halt() {
+	close(p.stop)
+	<-p.done
+}
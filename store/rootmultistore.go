@@ -42,6 +42,7 @@ var _ Queryable = (*rootMultiStore)(nil)
 func NewCommitMultiStore(db dbm.DB) *rootMultiStore {
 	return &rootMultiStore{
 		db:           db,
+		pruning:      sdk.PruneSyncable,
 		storesParams: make(map[StoreKey]storeParams),
 		stores:       make(map[StoreKey]CommitStore),
 		keysByName:   make(map[string]StoreKey),
@@ -108,6 +109,13 @@ func (rs *rootMultiStore) LoadLatestVersion() error {
 
 // Implements CommitMultiStore.
 func (rs *rootMultiStore) LoadVersion(ver int64) error {
+	// the pruners of the loaded stores delete versions in the same db, the versions they did not delete are
+	// released again by the new stores
+	for _, store := range rs.stores {
+		if iavlStore, ok := store.(*IavlStore); ok {
+			iavlStore.StopPruning()
+		}
+	}
 
 	// Special logic for version 0
 	if ver == 0 {
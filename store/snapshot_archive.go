@@ -0,0 +1,286 @@
+package store
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/golang/snappy"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/snapshot"
+	sm "github.com/tendermint/tendermint/state"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The snapshot archive is a gzipped tar of a finalized snapshot taken by the StateSyncHelper, so it can be
+// moved to a node without the p2p state sync. The first entry is the MANIFEST, followed by the chunks named
+// by the hex of their hashes in the order of the manifest: the tendermint state, the app state and the block.
+// The chunks are kept in the snappy compressed form the state sync reactor stores and transfers.
+const snapshotArchiveManifest = "MANIFEST"
+
+var snapshotCdc = codec.New()
+
+func init() {
+	snapshot.RegisterSnapshotMessages(snapshotCdc)
+	tmtypes.RegisterBlockAmino(snapshotCdc)
+}
+
+func snapshotChunkHashes(manifest *abci.Manifest) []abci.SHA256Sum {
+	hashes := make([]abci.SHA256Sum, 0, len(manifest.StateHashes)+len(manifest.AppStateHashes)+len(manifest.BlockHashes))
+	hashes = append(hashes, manifest.StateHashes...)
+	hashes = append(hashes, manifest.AppStateHashes...)
+	return append(hashes, manifest.BlockHashes...)
+}
+
+// ExportSnapshotArchive writes the finalized snapshot at the height in the tendermint db dir to the archive,
+// the latest finalized snapshot is exported if the height is 0
+func ExportSnapshotArchive(dbDir string, height int64, w io.Writer) (*abci.Manifest, error) {
+	reader := abci.SnapshotReader{DbDir: dbDir}
+	if height == 0 {
+		if height = reader.InitSnapshotHeight(); height == 0 {
+			return nil, fmt.Errorf("no finalized snapshot in %s", dbDir)
+		}
+	}
+	reader.Height = height
+
+	_, compressedManifest, err := reader.LoadManifest(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the manifest of the snapshot at height %d: %v", height, err)
+	}
+	manifest, err := decodeSnapshotManifest(compressedManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	if err := writeArchiveEntry(tw, snapshotArchiveManifest, compressedManifest); err != nil {
+		return nil, err
+	}
+	for _, hash := range snapshotChunkHashes(manifest) {
+		chunk, err := reader.Load(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk %x: %v", hash, err)
+		}
+		if sha256.Sum256(chunk) != hash {
+			return nil, fmt.Errorf("chunk %x is corrupted on disk", hash)
+		}
+		if err := writeArchiveEntry(tw, fmt.Sprintf("%x", hash), chunk); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, gw.Close()
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Unix(0, 0),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func decodeSnapshotManifest(compressed []byte) (*abci.Manifest, error) {
+	decompressed, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the manifest: %v", err)
+	}
+	var manifest abci.Manifest
+	if err := snapshotCdc.UnmarshalBinaryBare(decompressed, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode the manifest: %v", err)
+	}
+	return &manifest, nil
+}
+
+// SnapshotArchiveReader reads the chunks of a snapshot archive in order, the hash of every chunk is checked
+// against the manifest
+type SnapshotArchiveReader struct {
+	Manifest           *abci.Manifest
+	CompressedManifest []byte
+
+	file   *os.File
+	gr     *gzip.Reader
+	tr     *tar.Reader
+	hashes []abci.SHA256Sum
+	next   int
+}
+
+// OpenSnapshotArchive opens the archive and reads its manifest
+func OpenSnapshotArchive(path string) (*SnapshotArchiveReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &SnapshotArchiveReader{file: file}
+	if r.gr, err = gzip.NewReader(file); err != nil {
+		file.Close()
+		return nil, err
+	}
+	r.tr = tar.NewReader(r.gr)
+
+	name, compressed, err := r.readEntry()
+	if err == nil && name != snapshotArchiveManifest {
+		err = fmt.Errorf("the first entry of the archive should be %s, got %s", snapshotArchiveManifest, name)
+	}
+	if err == nil {
+		r.Manifest, err = decodeSnapshotManifest(compressed)
+	}
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	r.CompressedManifest = compressed
+	r.hashes = snapshotChunkHashes(r.Manifest)
+	return r, nil
+}
+
+func (r *SnapshotArchiveReader) readEntry() (string, []byte, error) {
+	header, err := r.tr.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := io.ReadAll(r.tr)
+	return header.Name, data, err
+}
+
+// Next returns the next chunk in its compressed and decoded forms, io.EOF is returned after the last chunk
+func (r *SnapshotArchiveReader) Next() (hash abci.SHA256Sum, compressed []byte, chunk abci.SnapshotChunk, err error) {
+	if r.next == len(r.hashes) {
+		return hash, nil, nil, io.EOF
+	}
+	hash = r.hashes[r.next]
+	name, compressed, err := r.readEntry()
+	if err == io.EOF {
+		return hash, nil, nil, fmt.Errorf("the archive misses chunk %x", hash)
+	} else if err != nil {
+		return hash, nil, nil, err
+	}
+	if name != fmt.Sprintf("%x", hash) || sha256.Sum256(compressed) != hash {
+		return hash, nil, nil, fmt.Errorf("entry %s of the archive does not match chunk %x of the manifest", name, hash)
+	}
+	decompressed, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return hash, nil, nil, fmt.Errorf("failed to decompress chunk %x: %v", hash, err)
+	}
+	if err := snapshotCdc.UnmarshalBinaryBare(decompressed, &chunk); err != nil {
+		return hash, nil, nil, fmt.Errorf("failed to decode chunk %x: %v", hash, err)
+	}
+	r.next++
+	return hash, compressed, chunk, nil
+}
+
+func (r *SnapshotArchiveReader) Close() error {
+	if r.gr != nil {
+		r.gr.Close()
+	}
+	return r.file.Close()
+}
+
+// RestoredSnapshot is the tendermint part of a restored snapshot, the app state is written by the StateSyncHelper
+type RestoredSnapshot struct {
+	Height     int64
+	State      sm.State
+	Block      *tmtypes.Block
+	SeenCommit *tmtypes.Commit
+}
+
+// RestoreSnapshotArchive writes the app state of the archive into the db of the helper the same way the
+// state sync reactor does, the tendermint state and block are returned to the caller. The callback is
+// called with every chunk read, e.g. to keep a copy of the snapshot.
+func RestoreSnapshotArchive(r *SnapshotArchiveReader, helper *StateSyncHelper,
+	cb func(hash abci.SHA256Sum, compressed []byte) error) (*RestoredSnapshot, error) {
+	if err := helper.StartRecovery(r.Manifest); err != nil {
+		return nil, err
+	}
+
+	restored := &RestoredSnapshot{Height: r.Manifest.Height}
+	var hasState bool
+	for {
+		hash, compressed, chunk, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch chunk := chunk.(type) {
+		case *abci.StateChunk:
+			if err := snapshotCdc.UnmarshalBinaryBare(chunk.Statepart, &restored.State); err != nil {
+				return nil, fmt.Errorf("failed to decode the tendermint state: %v", err)
+			}
+			hasState = true
+		case *abci.AppStateChunk:
+			if err := helper.WriteRecoveryChunk(hash, chunk, false); err != nil {
+				return nil, err
+			}
+		case *abci.BlockChunk:
+			restored.Block, restored.SeenCommit = new(tmtypes.Block), new(tmtypes.Commit)
+			if err := snapshotCdc.UnmarshalBinaryBare(chunk.Block, restored.Block); err != nil {
+				return nil, fmt.Errorf("failed to decode the block: %v", err)
+			}
+			if err := snapshotCdc.UnmarshalBinaryBare(chunk.SeenCommit, restored.SeenCommit); err != nil {
+				return nil, fmt.Errorf("failed to decode the seen commit: %v", err)
+			}
+		default:
+			return nil, fmt.Errorf("unknown type of chunk %x", hash)
+		}
+		if cb != nil {
+			if err := cb(hash, compressed); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !hasState || restored.Block == nil {
+		return nil, fmt.Errorf("the archive misses the tendermint state or the block")
+	}
+	if restored.State.LastBlockHeight != restored.Height || restored.Block.Height != restored.Height {
+		return nil, fmt.Errorf("the tendermint state at %d or the block at %d does not match the snapshot height %d",
+			restored.State.LastBlockHeight, restored.Block.Height, restored.Height)
+	}
+	if err := helper.WriteRecoveryChunk(abci.SHA256Sum{}, nil, true); err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// VerifyRestoredStores loads the restored version of the multi store and reads every node of its iavl
+// stores. The nodes are saved under the hashes of their content, so reaching all of them from the roots
+// proves the stores match the app hash. The app hash of the restored version is returned.
+func VerifyRestoredStores(cms sdk.CommitMultiStore) (appHash []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the restored stores are incomplete: %v", r)
+		}
+	}()
+
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+	for key, store := range cms.GetCommitKVStores() {
+		iavlStore, ok := store.(*IavlStore)
+		if !ok || !sdk.ShouldCommitStore(key.Name()) {
+			continue
+		}
+		iavlStore.Tree.IterateRange(nil, nil, true, func(_, _ []byte) bool {
+			return false
+		})
+	}
+	return cms.LastCommitID().Hash, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func writeTestSnapshot(t *testing.T, dbDir string, height int64, chunks ...abci.SnapshotChunk) {
+	writer := abci.SnapshotWriter{Height: height, DbDir: dbDir}
+	manifest := abci.Manifest{Version: 0, Height: height}
+	for _, chunk := range chunks {
+		compressed := snappy.Encode(nil, snapshotCdc.MustMarshalBinaryBare(chunk))
+		hash := sha256.Sum256(compressed)
+		require.NoError(t, writer.Write(hash, compressed))
+		switch chunk.(type) {
+		case *abci.StateChunk:
+			manifest.StateHashes = append(manifest.StateHashes, hash)
+		case *abci.BlockChunk:
+			manifest.BlockHashes = append(manifest.BlockHashes, hash)
+		}
+	}
+	require.NoError(t, writer.WriteManifest(snappy.Encode(nil, snapshotCdc.MustMarshalBinaryBare(manifest))))
+	require.NoError(t, writer.Finalize())
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	dir, err := os.MkdirTemp("", "snapshot_archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	stateChunk := &abci.StateChunk{Statepart: []byte("state")}
+	blockChunk := &abci.BlockChunk{Block: []byte("block"), SeenCommit: []byte("commit")}
+	writeTestSnapshot(t, dir, 10, stateChunk, blockChunk)
+
+	// the latest snapshot is exported without a height
+	var buf bytes.Buffer
+	manifest, err := ExportSnapshotArchive(dir, 0, &buf)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), manifest.Height)
+	_, err = ExportSnapshotArchive(dir, 11, io.Discard)
+	require.Error(t, err)
+
+	path := filepath.Join(dir, "snapshot.tar.gz")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	archive, err := OpenSnapshotArchive(path)
+	require.NoError(t, err)
+	require.Equal(t, manifest, archive.Manifest)
+
+	hash, _, chunk, err := archive.Next()
+	require.NoError(t, err)
+	require.Equal(t, manifest.StateHashes[0], hash)
+	require.Equal(t, stateChunk, chunk)
+	hash, _, chunk, err = archive.Next()
+	require.NoError(t, err)
+	require.Equal(t, manifest.BlockHashes[0], hash)
+	require.Equal(t, blockChunk, chunk)
+	_, _, _, err = archive.Next()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, archive.Close())
+
+	// a chunk that does not match the manifest is rejected
+	corruptedDir := filepath.Join(dir, "corrupted")
+	writeTestSnapshot(t, corruptedDir, 10, stateChunk, blockChunk)
+	chunkPaths, err := filepath.Glob(filepath.Join(corruptedDir, "*", "10", "*", fmt.Sprintf("%x", manifest.BlockHashes[0])))
+	require.NoError(t, err)
+	require.Len(t, chunkPaths, 1)
+	require.NoError(t, os.WriteFile(chunkPaths[0], []byte("corrupted"), 0600))
+	_, err = ExportSnapshotArchive(corruptedDir, 10, io.Discard)
+	require.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// the reasons of the balance changes
+const (
+	BalanceChangeTransfer = "transfer"
+	BalanceChangeFee      = "fee"
+	BalanceChangeReward   = "reward"
+	BalanceChangeSlash    = "slash"
+	BalanceChangePeg      = "peg"
+	BalanceChangeStake    = "stake"
+
+	// the type of the events which carry the balance changes in the tx and block results
+	EventTypeBalanceChange = "balance_change"
+)
+
+type balanceJournalKey struct{}
+type balanceChangeReasonKey struct{}
+
+// BalanceChange is the change of the balance of a denom held by an account
+type BalanceChange struct {
+	Address AccAddress `json:"address"`
+	Denom   string     `json:"denom"`
+	Before  int64      `json:"before"`
+	After   int64      `json:"after"`
+	Reason  string     `json:"reason"`
+}
+
+// TxBalanceChanges are the balance changes made by a tx, the tx hash is empty for the changes made in
+// BeginBlock and EndBlock
+type TxBalanceChanges struct {
+	TxHash  string          `json:"tx_hash"`
+	Changes []BalanceChange `json:"changes"`
+}
+
+// BalanceJournal records the balance changes made in a context, it is only collected for the nodes
+// which enable CollectConfig.CollectBalanceJournal and it is not part of the state.
+type BalanceJournal struct {
+	changes []BalanceChange
+}
+
+// Record adds the change of every denom whose amount differs between the coins before and after
+func (j *BalanceJournal) Record(addr AccAddress, before, after Coins, reason string) {
+	for _, coin := range before {
+		if amount := after.AmountOf(coin.Denom); amount != coin.Amount {
+			j.changes = append(j.changes, BalanceChange{addr, coin.Denom, coin.Amount, amount, reason})
+		}
+	}
+	for _, coin := range after {
+		if coin.Amount != 0 && before.AmountOf(coin.Denom) == 0 {
+			j.changes = append(j.changes, BalanceChange{addr, coin.Denom, 0, coin.Amount, reason})
+		}
+	}
+}
+
+func (j *BalanceJournal) Changes() []BalanceChange {
+	return j.changes
+}
+
+// Events returns the changes as events of EventTypeBalanceChange
+func (j *BalanceJournal) Events() Events {
+	events := make(Events, 0, len(j.changes))
+	for _, change := range j.changes {
+		events = append(events, NewEvent(EventTypeBalanceChange,
+			NewAttribute("address", change.Address.String()),
+			NewAttribute("denom", change.Denom),
+			NewAttribute("before", strconv.FormatInt(change.Before, 10)),
+			NewAttribute("after", strconv.FormatInt(change.After, 10)),
+			NewAttribute("reason", change.Reason),
+		))
+	}
+	return events
+}
+
+// BalanceChangesFromEvents parses the balance changes from the events of a tx or block result
+func BalanceChangesFromEvents(events []abci.Event) ([]BalanceChange, error) {
+	var changes []BalanceChange
+	for _, event := range events {
+		if event.Type != EventTypeBalanceChange {
+			continue
+		}
+		var change BalanceChange
+		for _, attr := range event.Attributes {
+			var err error
+			switch string(attr.Key) {
+			case "address":
+				change.Address, err = AccAddressFromBech32(string(attr.Value))
+			case "denom":
+				change.Denom = string(attr.Value)
+			case "before":
+				change.Before, err = strconv.ParseInt(string(attr.Value), 10, 64)
+			case "after":
+				change.After, err = strconv.ParseInt(string(attr.Value), 10, 64)
+			case "reason":
+				change.Reason = string(attr.Value)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s of the balance change: %v", attr.Key, err)
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// WithBalanceJournal returns a context which records the balance changes into the journal
+func WithBalanceJournal(ctx Context, journal *BalanceJournal) Context {
+	return ctx.WithValue(balanceJournalKey{}, journal)
+}
+
+// GetBalanceJournal returns the journal of the context, it is nil if the balance changes are not recorded
+func GetBalanceJournal(ctx Context) *BalanceJournal {
+	journal, _ := ctx.Value(balanceJournalKey{}).(*BalanceJournal)
+	return journal
+}
+
+// WithBalanceChangeReason returns a context whose balance changes are recorded with the reason
+func WithBalanceChangeReason(ctx Context, reason string) Context {
+	return ctx.WithValue(balanceChangeReasonKey{}, reason)
+}
+
+// GetBalanceChangeReason returns the reason set in the context, or the default one if none is set
+func GetBalanceChangeReason(ctx Context, defaultReason string) string {
+	if reason, ok := ctx.Value(balanceChangeReasonKey{}).(string); ok {
+		return reason
+	}
+	return defaultReason
+}
+
+// RecordBalanceChange records the change of the coins of the account into the journal of the context
+// with the reason of the context, nothing is recorded if the context has no journal
+func RecordBalanceChange(ctx Context, addr AccAddress, before, after Coins, defaultReason string) {
+	if journal := GetBalanceJournal(ctx); journal != nil {
+		journal.Record(addr, before, after, GetBalanceChangeReason(ctx, defaultReason))
+	}
+}
+
+// the balance changes of the block, kept in the Pool
+type blockBalanceJournal struct {
+	mtx sync.Mutex
+	txs []TxBalanceChanges
+}
+
+func (j *blockBalanceJournal) add(txHash string, changes []BalanceChange) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.txs = append(j.txs, TxBalanceChanges{TxHash: txHash, Changes: changes})
+}
+
+func (j *blockBalanceJournal) get() []TxBalanceChanges {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	return append([]TxBalanceChanges{}, j.txs...)
+}
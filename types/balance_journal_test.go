@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestBalanceJournalRecord(t *testing.T) {
+	addr := AccAddress([]byte("addr1"))
+	journal := &BalanceJournal{}
+	journal.Record(addr, Coins{NewCoin("ABC", 10), NewCoin("BNB", 100)}, Coins{NewCoin("BNB", 90), NewCoin("XYZ", 5)}, BalanceChangeTransfer)
+	journal.Record(addr, Coins{NewCoin("BNB", 90)}, Coins{NewCoin("BNB", 90)}, BalanceChangeFee)
+
+	expected := []BalanceChange{
+		{addr, "ABC", 10, 0, BalanceChangeTransfer},
+		{addr, "BNB", 100, 90, BalanceChangeTransfer},
+		{addr, "XYZ", 0, 5, BalanceChangeTransfer},
+	}
+	require.Equal(t, expected, journal.Changes())
+
+	changes, err := BalanceChangesFromEvents(journal.Events().ToABCIEvents())
+	require.NoError(t, err)
+	require.Equal(t, expected, changes)
+}
+
+func TestBalanceChangeReason(t *testing.T) {
+	ctx := NewContext(nil, abci.Header{}, RunTxModeDeliver, log.NewNopLogger())
+	addr := AccAddress([]byte("addr1"))
+
+	// nothing is recorded without a journal
+	RecordBalanceChange(ctx, addr, Coins{}, Coins{NewCoin("BNB", 1)}, BalanceChangeTransfer)
+
+	journal := &BalanceJournal{}
+	ctx = WithBalanceJournal(ctx, journal)
+	RecordBalanceChange(ctx, addr, Coins{}, Coins{NewCoin("BNB", 1)}, BalanceChangeTransfer)
+	RecordBalanceChange(WithBalanceChangeReason(ctx, BalanceChangeReward), addr, Coins{NewCoin("BNB", 1)}, Coins{NewCoin("BNB", 2)}, BalanceChangeTransfer)
+	require.Equal(t, []BalanceChange{
+		{addr, "BNB", 0, 1, BalanceChangeTransfer},
+		{addr, "BNB", 1, 2, BalanceChangeReward},
+	}, journal.Changes())
+}
@@ -108,4 +108,5 @@ func (config *Config) GetBech32ConsensusPubPrefix() string {
 type CollectConfig struct {
 	CollectAccountBalance bool
 	CollectTxs            bool
+	CollectBalanceJournal bool // record the balance changes per tx and block, see BalanceJournal
 }
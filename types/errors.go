@@ -59,6 +59,7 @@ const (
 	CodeMsgNotSupported     CodeType = 14
 	CodeInvalidAccountFlags CodeType = 15
 	CodeInvalidTxMemo       CodeType = 16
+	CodeTxTimeout           CodeType = 17
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -109,6 +110,8 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "account flags is invalid"
 	case CodeInvalidTxMemo:
 		return "transaction memo is invalid"
+	case CodeTxTimeout:
+		return "transaction timed out"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -164,6 +167,9 @@ func ErrInvalidAccountFlags(msg string) Error {
 func ErrInvalidTxMemo(msg string) Error {
 	return newErrorWithRootCodespace(CodeInvalidTxMemo, msg)
 }
+func ErrTxTimeout(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTimeout, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
@@ -0,0 +1,60 @@
+package fees
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// the multiplier applied on top of the calculated fees, it is kept in sync with the store by the param hub
+var Congestion congestion = newCongestion()
+
+type congestion struct {
+	multiplier types.Dec
+}
+
+func newCongestion() congestion {
+	return congestion{
+		multiplier: types.OneDec(),
+	}
+}
+
+func (c congestion) Multiplier() types.Dec {
+	return c.multiplier
+}
+
+func (c *congestion) SetMultiplier(multiplier types.Dec) {
+	c.multiplier = multiplier
+}
+
+func (c *congestion) Reset() {
+	c.multiplier = types.OneDec()
+}
+
+// Apply scales the tokens of the fee by the multiplier, free fees are left untouched
+func (c congestion) Apply(fee types.Fee) types.Fee {
+	if fee.Type == types.FeeFree || fee.IsEmpty() || c.multiplier.Equal(types.OneDec()) {
+		return fee
+	}
+	tokens := make(types.Coins, 0, len(fee.Tokens))
+	for _, token := range fee.Tokens {
+		tokens = append(tokens, types.NewCoin(token.Denom, c.scale(token.Amount)))
+	}
+	return types.NewFee(tokens, fee.Type)
+}
+
+// Wrap returns a calculator scaling the fees of the calculator by the multiplier at the time they are calculated
+func (c *congestion) Wrap(calculator FeeCalculator) FeeCalculator {
+	return func(msg types.Msg) types.Fee {
+		return c.Apply(calculator(msg))
+	}
+}
+
+func (c congestion) scale(amount int64) int64 {
+	scaled := new(big.Int).Mul(big.NewInt(amount), big.NewInt(c.multiplier.RawInt()))
+	scaled.Quo(scaled, big.NewInt(types.OneDec().RawInt()))
+	if !scaled.IsInt64() || scaled.Int64() > types.TokenMaxTotalSupply {
+		return types.TokenMaxTotalSupply
+	}
+	return scaled.Int64()
+}
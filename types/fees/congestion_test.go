@@ -0,0 +1,49 @@
+package fees
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCongestionApply(t *testing.T) {
+	defer Congestion.Reset()
+	fee := types.NewFee(types.Coins{types.NewCoin(types.NativeTokenSymbol, 1000)}, types.FeeForProposer)
+
+	// the default multiplier keeps the fee
+	require.Equal(t, fee, Congestion.Apply(fee))
+
+	Congestion.SetMultiplier(types.NewDecWithPrec(15, 1))
+	require.Equal(t, types.NewFee(types.Coins{types.NewCoin(types.NativeTokenSymbol, 1500)}, types.FeeForProposer), Congestion.Apply(fee))
+
+	// the free fees are not scaled
+	free := types.NewFee(types.Coins{}, types.FeeFree)
+	require.Equal(t, free, Congestion.Apply(free))
+
+	// the scaled fee is capped at the max total supply
+	huge := types.NewFee(types.Coins{types.NewCoin(types.NativeTokenSymbol, types.TokenMaxTotalSupply)}, types.FeeForAll)
+	require.Equal(t, types.TokenMaxTotalSupply, Congestion.Apply(huge).Tokens.AmountOf(types.NativeTokenSymbol))
+
+	Congestion.Reset()
+	require.Equal(t, types.OneDec(), Congestion.Multiplier())
+}
+
+func TestCongestionWrap(t *testing.T) {
+	defer Congestion.Reset()
+	defer UnsetAllCalculators()
+	_, addr := privAndAddr()
+	msg := types.NewTestMsg(addr)
+	RegisterCalculator(msg.Type(), Congestion.Wrap(FixedFeeCalculator(1000, types.FeeForProposer)))
+
+	// the multiplier is read when the fee is calculated, not when the calculator is registered
+	Congestion.SetMultiplier(types.NewDecWithPrec(2, 0))
+	require.Equal(t, types.Coins{types.NewCoin(types.NativeTokenSymbol, 2000)}, GetCalculator(msg.Type())(msg).Tokens)
+	fee, err := CalculateTxFee([]types.Msg{msg, msg})
+	require.Nil(t, err)
+	require.Equal(t, types.Coins{types.NewCoin(types.NativeTokenSymbol, 4000)}, fee.Tokens)
+
+	Congestion.Reset()
+	require.Equal(t, types.Coins{types.NewCoin(types.NativeTokenSymbol, 1000)}, GetCalculator(msg.Type())(msg).Tokens)
+}
@@ -0,0 +1,74 @@
+package fees
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// the accepted fee denoms and their rates, it is kept in sync with the fee params by the param hub
+var Denoms feeDenoms = newFeeDenoms()
+
+type feeDenoms struct {
+	rates map[string]types.Dec // denom -> the amount of the denom for one native token
+}
+
+func newFeeDenoms() feeDenoms {
+	return feeDenoms{
+		rates: map[string]types.Dec{},
+	}
+}
+
+func (d *feeDenoms) SetRate(denom string, rate types.Dec) {
+	d.rates[denom] = rate
+}
+
+func (d feeDenoms) GetRate(denom string) (types.Dec, bool) {
+	rate, ok := d.rates[denom]
+	return rate, ok
+}
+
+func (d *feeDenoms) Clear() {
+	d.rates = map[string]types.Dec{}
+}
+
+// IsAccepted returns true if the fees can be paid in the denom, the native token is always accepted
+func (d feeDenoms) IsAccepted(denom string) bool {
+	if denom == "" || denom == types.NativeTokenSymbol {
+		return true
+	}
+	_, ok := d.rates[denom]
+	return ok
+}
+
+// Convert converts the native tokens of the fee to the denom with its rate, rounding up. The fee is returned as
+// it is when the denom is empty or the native token.
+func (d feeDenoms) Convert(fee types.Fee, denom string) (types.Fee, types.Error) {
+	if denom == "" || denom == types.NativeTokenSymbol || fee.Type == types.FeeFree || fee.IsEmpty() {
+		return fee, nil
+	}
+	rate, ok := d.rates[denom]
+	if !ok {
+		return fee, types.ErrInvalidCoins(fmt.Sprintf("fees can not be paid in %s", denom))
+	}
+	tokens := types.Coins{}
+	for _, token := range fee.Tokens {
+		if token.Denom == types.NativeTokenSymbol {
+			token = types.NewCoin(denom, convertAmount(token.Amount, rate))
+		}
+		tokens = tokens.Plus(types.Coins{token})
+	}
+	return types.NewFee(tokens, fee.Type), nil
+}
+
+func convertAmount(amount int64, rate types.Dec) int64 {
+	precision := big.NewInt(types.OneDec().RawInt())
+	converted := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rate.RawInt()))
+	converted.Add(converted, new(big.Int).Sub(precision, big.NewInt(1)))
+	converted.Quo(converted, precision)
+	if !converted.IsInt64() || converted.Int64() > types.TokenMaxTotalSupply {
+		return types.TokenMaxTotalSupply
+	}
+	return converted.Int64()
+}
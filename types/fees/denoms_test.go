@@ -0,0 +1,40 @@
+package fees
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeDenomsConvert(t *testing.T) {
+	defer Denoms.Clear()
+	Denoms.SetRate("USD", types.NewDecWithPrec(15, 1))
+	fee := types.NewFee(types.Coins{types.NewCoin(types.NativeTokenSymbol, 1001)}, types.FeeForProposer)
+
+	require.True(t, Denoms.IsAccepted(""))
+	require.True(t, Denoms.IsAccepted(types.NativeTokenSymbol))
+	require.True(t, Denoms.IsAccepted("USD"))
+	require.False(t, Denoms.IsAccepted("EUR"))
+
+	// the native token is kept
+	converted, err := Denoms.Convert(fee, "")
+	require.Nil(t, err)
+	require.Equal(t, fee, converted)
+
+	// the converted amount is rounded up
+	converted, err = Denoms.Convert(fee, "USD")
+	require.Nil(t, err)
+	require.Equal(t, types.NewFee(types.Coins{types.NewCoin("USD", 1502)}, types.FeeForProposer), converted)
+
+	// the free fees are not converted
+	free := types.NewFee(types.Coins{}, types.FeeFree)
+	converted, err = Denoms.Convert(free, "EUR")
+	require.Nil(t, err)
+	require.Equal(t, free, converted)
+
+	_, err = Denoms.Convert(fee, "EUR")
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidCoins, err.Code())
+}
@@ -1,6 +1,8 @@
 package fees
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/types"
 	param "github.com/cosmos/cosmos-sdk/x/paramHub/types"
 )
@@ -23,6 +25,18 @@ func GetCalculator(msgType string) FeeCalculator {
 	return calculators[msgType]
 }
 
+// CalculateTxFee sums the fees of the msgs with the registered calculators
+func CalculateTxFee(msgs []types.Msg) (fee types.Fee, err types.Error) {
+	for _, msg := range msgs {
+		calculator := GetCalculator(msg.Type())
+		if calculator == nil {
+			return fee, types.ErrInternal(fmt.Sprintf("no fee calculator for msg type %s", msg.Type()))
+		}
+		fee.AddFee(calculator(msg))
+	}
+	return fee, nil
+}
+
 func UnsetAllCalculators() {
 	for key := range calculators {
 		delete(calculators, key)
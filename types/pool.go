@@ -12,6 +12,7 @@ import (
 type Pool struct {
 	accounts sync.Map // save tx/gov related addresses (string wrapped bytes) to be published
 	txs      sync.Map
+	balances *blockBalanceJournal
 }
 
 func (p *Pool) AddTx(tx Tx, txHash string) {
@@ -37,7 +38,27 @@ func (p Pool) TxRelatedAddrs() []string {
 	return addrs
 }
 
+// AddBalanceChanges adds the balance changes of a tx, or of BeginBlock and EndBlock if the tx hash is empty
+func (p *Pool) AddBalanceChanges(txHash string, changes []BalanceChange) {
+	if len(changes) == 0 {
+		return
+	}
+	if p.balances == nil {
+		p.balances = &blockBalanceJournal{}
+	}
+	p.balances.add(txHash, changes)
+}
+
+// BalanceChanges returns the balance changes of the block in the order they were made
+func (p *Pool) BalanceChanges() []TxBalanceChanges {
+	if p.balances == nil {
+		return nil
+	}
+	return p.balances.get()
+}
+
 func (p *Pool) Clear() {
 	p.accounts = sync.Map{}
 	p.txs = sync.Map{}
+	p.balances = nil
 }
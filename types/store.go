@@ -12,20 +12,56 @@ import (
 
 // NOTE: These are implemented in cosmos-sdk/store.
 
+// DefaultPruningInterval is the number of commits between two prunings of the custom strategy, the preset
+// strategies prune on every commit
+const DefaultPruningInterval = 10
+
 // PruningStrategy specfies how old states will be deleted over time
-type PruningStrategy uint8
+type PruningStrategy struct {
+	// KeepRecent is the number of recent versions kept besides the current one
+	KeepRecent int64
+	// KeepEvery is the distance between the versions kept forever as state sync waypoints,
+	// 1 keeps every version and 0 keeps no waypoint
+	KeepEvery int64
+	// Interval is the number of commits between two prunings, the old versions are
+	// deleted in every commit if it is 0 or 1
+	Interval int64
+}
 
-const (
-	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
-	PruneSyncable PruningStrategy = iota
+var (
+	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100000 + every 100000th)
+	PruneSyncable = NewPruningStrategy(100000, 100000, 1)
 
 	// PruneEverything means all saved states will be deleted, storing only the current state
-	PruneEverything PruningStrategy = iota
+	PruneEverything = NewPruningStrategy(0, 0, 1)
 
 	// PruneNothing means all historic states will be saved, nothing will be deleted
-	PruneNothing PruningStrategy = iota
+	PruneNothing = NewPruningStrategy(0, 1, 0)
 )
 
+func NewPruningStrategy(keepRecent, keepEvery, interval int64) PruningStrategy {
+	return PruningStrategy{
+		KeepRecent: keepRecent,
+		KeepEvery:  keepEvery,
+		Interval:   interval,
+	}
+}
+
+func (strategy PruningStrategy) Validate() error {
+	if strategy.KeepRecent < 0 || strategy.KeepEvery < 0 || strategy.Interval < 0 {
+		return fmt.Errorf("invalid pruning strategy %+v, values should not be negative", strategy)
+	}
+	return nil
+}
+
+// ShouldKeep returns whether the version is kept once the latest version is committed
+func (strategy PruningStrategy) ShouldKeep(version, latest int64) bool {
+	if latest-version <= strategy.KeepRecent {
+		return true
+	}
+	return strategy.KeepEvery != 0 && version%strategy.KeepEvery == 0
+}
+
 type Store interface { //nolint
 	GetStoreType() StoreType
 	CacheWrapper
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 )
 
 var UpgradeMgr = NewUpgradeManager(UpgradeConfig{})
@@ -25,6 +26,25 @@ const (
 	FixDoubleSignChainId        = "FixDoubleSignChainId"
 	BEP126                      = "BEP126" //https://github.com/binance-chain/BEPs/pull/126
 	BEP255                      = "BEP255" // https://github.com/bnb-chain/BEPs/pull/255
+	VestingAccount              = "VestingAccount"
+	SideChainLiveness           = "SideChainLiveness"
+	SlashInsurance              = "SlashInsurance"
+	ConfigurableRewardStrategy  = "ConfigurableRewardStrategy"
+	TypedProposalContent        = "TypedProposalContent"
+	SoftwareUpgradePlan         = "SoftwareUpgradePlan"
+	WeightedVote                = "WeightedVote"
+	GovTimelock                 = "GovTimelock"
+	GovProposalTypeParams       = "GovProposalTypeParams"
+	GovIndex                    = "GovIndex"
+	GovVotingProxy              = "GovVotingProxy"
+	FeeGrant                    = "FeeGrant"
+	Authz                       = "Authz"
+	UnorderedTx                 = "UnorderedTx"
+	CongestionFee               = "CongestionFee"
+	MultiAssetFee               = "MultiAssetFee"
+	TransferHooks               = "TransferHooks"
+	ScheduledSend               = "ScheduledSend"
+	MultisigAccount             = "MultisigAccount"
 
 	FirstSunsetFork  = "FirstSunsetFork"
 	SecondSunsetFork = "SecondSunsetFork"
@@ -35,6 +55,16 @@ var (
 	BCFusionStopGovThreshold int64 = 5_000_000_00000000 // 5M BNB
 )
 
+// the upgrades implemented by this binary, the node halts at the height of an upgrade plan it does not know
+var knownUpgrades = []string{
+	FixSignBytesOverflow, BEP9, BEP12, BEP3, BEP8, LaunchBscUpgrade, BEP82, FixFailAckPackage, BEP128, BEP153,
+	BEP159, BEP159Phase2, LimitConsAddrUpdateInterval, BEP171, BEP173, FixDoubleSignChainId, BEP126, BEP255,
+	VestingAccount, SideChainLiveness, SlashInsurance, ConfigurableRewardStrategy, TypedProposalContent,
+	SoftwareUpgradePlan, WeightedVote, GovTimelock, GovProposalTypeParams, GovIndex, GovVotingProxy, FeeGrant, Authz, UnorderedTx, CongestionFee, MultiAssetFee, TransferHooks,
+	ScheduledSend, MultisigAccount,
+	FirstSunsetFork, SecondSunsetFork, FinalSunsetFork,
+}
+
 var MainNetConfig = UpgradeConfig{
 	HeightMap: map[string]int64{},
 }
@@ -49,12 +79,28 @@ type UpgradeConfig struct {
 type UpgradeManager struct {
 	Config UpgradeConfig
 	Height int64
+
+	knownUpgrades map[string]bool
+
+	// the store keys, msg types and begin blockers registered for each upgrade, they follow the upgrade
+	// when it is rescheduled
+	upgradeStoreKeys map[string][]string
+	upgradeMsgTypes  map[string][]string
+	beginBlockers    []upgradeBeginBlocker
+}
+
+type upgradeBeginBlocker struct {
+	name         string
+	beginBlocker func(Context)
 }
 
 func NewUpgradeManager(config UpgradeConfig) *UpgradeManager {
-	return &UpgradeManager{
-		Config: config,
+	mgr := &UpgradeManager{
+		Config:        config,
+		knownUpgrades: make(map[string]bool, len(knownUpgrades)),
 	}
+	mgr.AddKnownUpgrades(knownUpgrades...)
+	return mgr
 }
 
 func (mgr *UpgradeManager) Reset() {
@@ -85,9 +131,10 @@ func (mgr *UpgradeManager) BeginBlocker(ctx Context) {
 }
 
 func (mgr *UpgradeManager) RegisterBeginBlocker(name string, beginBlocker func(Context)) {
-	height := mgr.GetUpgradeHeight(name)
-	if height == 0 {
-		panic(fmt.Errorf("no UpgradeHeight found for %s", name))
+	height := mgr.registrationHeight(name)
+	mgr.beginBlockers = append(mgr.beginBlockers, upgradeBeginBlocker{name, beginBlocker})
+	if height == math.MaxInt64 {
+		return
 	}
 
 	if mgr.Config.BeginBlockers == nil {
@@ -102,6 +149,44 @@ func (mgr *UpgradeManager) RegisterBeginBlocker(name string, beginBlocker func(C
 	}
 }
 
+// the height the registrations of an upgrade take effect at, a known upgrade without height waits for
+// a governance plan and its registrations never take effect until the upgrade is scheduled
+func (mgr *UpgradeManager) registrationHeight(name string) int64 {
+	height := mgr.GetUpgradeHeight(name)
+	if height != 0 {
+		return height
+	}
+	if !mgr.IsKnownUpgrade(name) {
+		panic(fmt.Errorf("no UpgradeHeight found for %s", name))
+	}
+	return math.MaxInt64
+}
+
+// SetUpgradeHeight schedules an upgrade at the height together with the store keys, msg types and begin
+// blockers registered for it, the height 0 cancels the upgrade
+func (mgr *UpgradeManager) SetUpgradeHeight(name string, height int64) {
+	mgr.AddUpgradeHeight(name, height)
+
+	registrationHeight := height
+	if height == 0 {
+		registrationHeight = math.MaxInt64
+	}
+	for _, storeKeyName := range mgr.upgradeStoreKeys[name] {
+		mgr.Config.StoreKeyMap[storeKeyName] = registrationHeight
+	}
+	for _, msgType := range mgr.upgradeMsgTypes[name] {
+		mgr.Config.MsgTypeMap[msgType] = registrationHeight
+	}
+
+	// rebuild the begin blockers in the order of registration
+	mgr.Config.BeginBlockers = make(map[int64][]func(ctx Context))
+	for _, registered := range mgr.beginBlockers {
+		if h := mgr.GetUpgradeHeight(registered.name); h != 0 {
+			mgr.Config.BeginBlockers[h] = append(mgr.Config.BeginBlockers[h], registered.beginBlocker)
+		}
+	}
+}
+
 func (mgr *UpgradeManager) AddUpgradeHeight(name string, height int64) {
 	if mgr.Config.HeightMap == nil {
 		mgr.Config.HeightMap = map[string]int64{}
@@ -110,6 +195,20 @@ func (mgr *UpgradeManager) AddUpgradeHeight(name string, height int64) {
 	mgr.Config.HeightMap[name] = height
 }
 
+// AddKnownUpgrades declares the upgrades implemented by the binary besides the built-in ones
+func (mgr *UpgradeManager) AddKnownUpgrades(names ...string) {
+	if mgr.knownUpgrades == nil {
+		mgr.knownUpgrades = make(map[string]bool, len(names))
+	}
+	for _, name := range names {
+		mgr.knownUpgrades[name] = true
+	}
+}
+
+func (mgr *UpgradeManager) IsKnownUpgrade(name string) bool {
+	return mgr.knownUpgrades[name]
+}
+
 func (mgr *UpgradeManager) GetUpgradeHeight(name string) int64 {
 	if mgr.Config.HeightMap == nil {
 		return 0
@@ -118,33 +217,35 @@ func (mgr *UpgradeManager) GetUpgradeHeight(name string) int64 {
 }
 
 func (mgr *UpgradeManager) RegisterStoreKeys(upgradeName string, storeKeyNames ...string) {
-	height := mgr.GetUpgradeHeight(upgradeName)
-	if height == 0 {
-		panic(fmt.Errorf("no UpgradeHeight found for %s", upgradeName))
-	}
+	height := mgr.registrationHeight(upgradeName)
 
 	if mgr.Config.StoreKeyMap == nil {
 		mgr.Config.StoreKeyMap = map[string]int64{}
 	}
+	if mgr.upgradeStoreKeys == nil {
+		mgr.upgradeStoreKeys = map[string][]string{}
+	}
 
 	for _, storeKeyName := range storeKeyNames {
 		mgr.Config.StoreKeyMap[storeKeyName] = height
 	}
+	mgr.upgradeStoreKeys[upgradeName] = append(mgr.upgradeStoreKeys[upgradeName], storeKeyNames...)
 }
 
 func (mgr *UpgradeManager) RegisterMsgTypes(upgradeName string, msgTypes ...string) {
-	height := mgr.GetUpgradeHeight(upgradeName)
-	if height == 0 {
-		panic(fmt.Errorf("no UpgradeHeight found for %s", upgradeName))
-	}
+	height := mgr.registrationHeight(upgradeName)
 
 	if mgr.Config.MsgTypeMap == nil {
 		mgr.Config.MsgTypeMap = map[string]int64{}
 	}
+	if mgr.upgradeMsgTypes == nil {
+		mgr.upgradeMsgTypes = map[string][]string{}
+	}
 
 	for _, msgType := range msgTypes {
 		mgr.Config.MsgTypeMap[msgType] = height
 	}
+	mgr.upgradeMsgTypes[upgradeName] = append(mgr.upgradeMsgTypes[upgradeName], msgTypes...)
 }
 
 func (mgr *UpgradeManager) GetStoreKeyHeight(storeKeyName string) int64 {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
@@ -14,11 +16,46 @@ const (
 	ed25519VerifyCost   = 59
 	secp256k1VerifyCost = 100
 	maxMemoCharacters   = 100
+
+	// the context key of the tx hash set by the baseapp
+	txHashKey = "txHash"
 )
 
+// FeeGrantKeeper consumes the fee allowances that granters give to grantees
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) sdk.Error
+}
+
+type anteOptions struct {
+	feeGrantKeeper      FeeGrantKeeper
+	feeCollectionKeeper *FeeCollectionKeeper
+}
+
+// AnteHandlerOption enables an optional feature of the AnteHandler
+type AnteHandlerOption func(*anteOptions)
+
+// WithFeeGrantKeeper lets the txs name a fee payer whose fee grant is consumed by the keeper
+func WithFeeGrantKeeper(keeper FeeGrantKeeper) AnteHandlerOption {
+	return func(opts *anteOptions) {
+		opts.feeGrantKeeper = keeper
+	}
+}
+
+// WithFeeCollectionKeeper lets the txs pay fees in the accepted fee denoms, the fees paid in
+// other tokens than the native token are collected by the keeper to be distributed
+func WithFeeCollectionKeeper(keeper FeeCollectionKeeper) AnteHandlerOption {
+	return func(opts *anteOptions) {
+		opts.feeCollectionKeeper = &keeper
+	}
+}
+
 // NewAnteHandler returns an AnteHandler that checks
 // and increments sequence numbers, checks signatures & account numbers
-func NewAnteHandler(am AccountKeeper) sdk.AnteHandler {
+func NewAnteHandler(am AccountKeeper, options ...AnteHandlerOption) sdk.AnteHandler {
+	var opts anteOptions
+	for _, option := range options {
+		option(&opts)
+	}
 	return func(
 		ctx sdk.Context, tx sdk.Tx, mode sdk.RunTxMode,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -46,11 +83,30 @@ func NewAnteHandler(am AccountKeeper) sdk.AnteHandler {
 		stdSigs := stdTx.GetSignatures() // When simulating, this would just be a 0-length slice.
 		signerAddrs := stdTx.GetSigners()
 
+		res = validateTimeout(ctx, stdTx)
+		if !res.IsOK() {
+			return newCtx, res, true
+		}
+
+		if len(stdTx.FeeDenom) != 0 {
+			res := validateFeeDenom(opts.feeCollectionKeeper, stdTx.FeeDenom)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+
+		if len(stdTx.FeePayer) != 0 {
+			res := validateFeePayer(opts.feeGrantKeeper, stdTx.FeePayer, signerAddrs)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+
 		signerAccs, res := getSignerAccs(newCtx, am, signerAddrs)
 		if !res.IsOK() {
 			return newCtx, res, true
 		}
-		res = validateAccNumAndSequence(ctx, signerAccs, stdSigs)
+		res = validateAccNumAndSequence(ctx, signerAccs, stdSigs, stdTx.Unordered)
 		if !res.IsOK() {
 			return newCtx, res, true
 		}
@@ -71,18 +127,41 @@ func NewAnteHandler(am AccountKeeper) sdk.AnteHandler {
 				signBytes = nil
 			}
 			signerAccs[i], res = processSig(newCtx, signerAccs[i],
-				stdSigs[i], signBytes, mode)
+				stdSigs[i], signBytes, mode, stdTx.Unordered)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
 
+			if stdTx.Unordered {
+				err := am.useUnorderedNonce(newCtx, signerAccs[i].GetAddress(), stdSigs[i].Sequence,
+					stdTx.TimeoutHeight, stdTx.TimeoutTimestamp)
+				if err != nil {
+					return newCtx, err.Result(), true
+				}
+			}
+
 			// Save the account.
 			am.SetAccount(newCtx, signerAccs[i])
 		}
 
+		if len(stdTx.FeePayer) == 0 && len(stdTx.FeeDenom) != 0 {
+			newCtx, res = processFeeDenom(newCtx, am, opts.feeCollectionKeeper, stdTx, signerAddrs[0], mode)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+			signerAccs[0] = am.GetAccount(newCtx, signerAddrs[0])
+		}
+
 		// cache the signer accounts in the context
 		newCtx = WithSigners(newCtx, signerAccs)
 
+		if len(stdTx.FeePayer) != 0 {
+			newCtx, res = processFeePayer(newCtx, am, opts, stdTx, signerAddrs[0], mode)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+
 		// TODO: tx tags (?)
 		return newCtx, sdk.Result{}, false // continue...
 	}
@@ -108,6 +187,17 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 			fmt.Sprintf("maximum number of characters is %d but received %d characters",
 				maxMemoCharacters, len(memo)))
 	}
+
+	if len(tx.FeePayer) != 0 && len(tx.FeePayer) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid fee payer address length %d", len(tx.FeePayer)))
+	}
+
+	if tx.TimeoutHeight < 0 || tx.TimeoutTimestamp < 0 {
+		return sdk.ErrTxTimeout("timeout can not be negative")
+	}
+	if tx.Unordered && tx.TimeoutHeight == 0 && tx.TimeoutTimestamp == 0 {
+		return sdk.ErrTxTimeout("unordered tx must set a timeout")
+	}
 	return nil
 }
 
@@ -122,7 +212,8 @@ func getSignerAccs(ctx sdk.Context, am AccountKeeper, addrs []sdk.AccAddress) (a
 	return
 }
 
-func validateAccNumAndSequence(ctx sdk.Context, accs []sdk.Account, sigs []StdSignature) sdk.Result {
+// the sequences of unordered txs are nonces which are checked by useUnorderedNonce
+func validateAccNumAndSequence(ctx sdk.Context, accs []sdk.Account, sigs []StdSignature, unordered bool) sdk.Result {
 	for i := 0; i < len(accs); i++ {
 		// On InitChain, make sure account number == 0
 		if ctx.BlockHeight() == 0 && sigs[i].AccountNumber != 0 {
@@ -137,6 +228,10 @@ func validateAccNumAndSequence(ctx sdk.Context, accs []sdk.Account, sigs []StdSi
 				fmt.Sprintf("Invalid account number. Got %d, expected %d", sigs[i].AccountNumber, accnum)).Result()
 		}
 
+		if unordered {
+			continue
+		}
+
 		// Check sequence number.
 		seq := accs[i].GetSequence()
 		if seq != sigs[i].Sequence {
@@ -147,10 +242,10 @@ func validateAccNumAndSequence(ctx sdk.Context, accs []sdk.Account, sigs []StdSi
 	return sdk.Result{}
 }
 
-// verify the signature and increment the sequence.
+// verify the signature and increment the sequence unless the tx is unordered.
 // if the account doesn't have a pubkey, set it.
 func processSig(ctx sdk.Context,
-	acc sdk.Account, sig StdSignature, signBytes []byte, mode sdk.RunTxMode) (updatedAcc sdk.Account, res sdk.Result) {
+	acc sdk.Account, sig StdSignature, signBytes []byte, mode sdk.RunTxMode, unordered bool) (updatedAcc sdk.Account, res sdk.Result) {
 	pubKey, res := processPubKey(acc, sig, mode == sdk.RunTxModeSimulate)
 	if !res.IsOK() {
 		return nil, res
@@ -163,6 +258,9 @@ func processSig(ctx sdk.Context,
 	if (mode == sdk.RunTxModeCheck || mode == sdk.RunTxModeDeliver) && !pubKey.VerifyBytes(signBytes, sig.Signature) {
 		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
 	}
+	if unordered {
+		return acc, res
+	}
 	// increment the sequence number
 	err = acc.SetSequence(acc.GetSequence() + 1)
 	if err != nil {
@@ -173,6 +271,39 @@ func processSig(ctx sdk.Context,
 	return acc, res
 }
 
+// deductFees deducts fees from the given account. The locked coins of vesting
+// accounts can not be used to pay fees.
+//
+// NOTE: the account is not persisted, the caller is responsible to save it.
+func deductFees(blockTime time.Time, acc sdk.Account, fee sdk.Fee) (sdk.Account, sdk.Result) {
+	coins := acc.GetCoins()
+	feeAmount := fee.Tokens
+
+	if !feeAmount.IsValid() {
+		return nil, sdk.ErrInsufficientFunds(fmt.Sprintf("invalid fee amount: %s", feeAmount)).Result()
+	}
+
+	// get the resulting coins deducting the fees
+	newCoins := coins.Minus(feeAmount)
+	if !newCoins.IsNotNegative() {
+		return nil, sdk.ErrInsufficientFunds(
+			fmt.Sprintf("insufficient funds to pay for fees; %s < %s", coins, feeAmount)).Result()
+	}
+
+	// validate the account has enough "spendable" coins as this will cover cases
+	// such as vesting accounts.
+	spendableCoins := SpendableCoins(acc, blockTime)
+	if !spendableCoins.IsGTE(feeAmount) {
+		return nil, sdk.ErrInsufficientFunds(
+			fmt.Sprintf("insufficient funds to pay for fees; %s < %s", spendableCoins, feeAmount)).Result()
+	}
+
+	if err := acc.SetCoins(newCoins); err != nil {
+		return nil, sdk.ErrInternal(err.Error()).Result()
+	}
+	return acc, sdk.Result{}
+}
+
 var dummySecp256k1Pubkey secp256k1.PubKeySecp256k1
 
 func init() {
@@ -205,12 +336,144 @@ func processPubKey(acc sdk.Account, sig StdSignature, simulate bool) (crypto.Pub
 	return pubKey, sdk.Result{}
 }
 
+// the fees can be paid in the accepted fee denoms after the upgrade, when the
+// non-native fees can be collected
+func validateFeeDenom(feeCollectionKeeper *FeeCollectionKeeper, feeDenom string) sdk.Result {
+	if !sdk.IsUpgrade(sdk.MultiAssetFee) || feeCollectionKeeper == nil {
+		return sdk.ErrUnauthorized("fee denom is not supported yet").Result()
+	}
+	if !fees.Denoms.IsAccepted(feeDenom) {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("fees can not be paid in %s", feeDenom)).Result()
+	}
+	return sdk.Result{}
+}
+
+// the txs with a timeout or unordered are accepted after the upgrade, and
+// the timed out txs are rejected
+func validateTimeout(ctx sdk.Context, stdTx StdTx) sdk.Result {
+	if stdTx.TimeoutHeight == 0 && stdTx.TimeoutTimestamp == 0 && !stdTx.Unordered {
+		return sdk.Result{}
+	}
+	if !sdk.IsUpgrade(sdk.UnorderedTx) {
+		return sdk.ErrUnauthorized("timeout and unordered txs are not supported").Result()
+	}
+	if isTimedOut(ctx, stdTx.TimeoutHeight, stdTx.TimeoutTimestamp) {
+		return sdk.ErrTxTimeout(fmt.Sprintf("tx timed out at height %d or timestamp %d",
+			stdTx.TimeoutHeight, stdTx.TimeoutTimestamp)).Result()
+	}
+	if stdTx.Unordered {
+		if stdTx.TimeoutHeight > ctx.BlockHeight()+MaxUnorderedTimeoutHeightDelta {
+			return sdk.ErrTxTimeout(fmt.Sprintf("timeout height of unordered tx can be at most %d blocks ahead",
+				MaxUnorderedTimeoutHeightDelta)).Result()
+		}
+		if stdTx.TimeoutTimestamp > ctx.BlockHeader().Time.Unix()+MaxUnorderedTimeoutDuration {
+			return sdk.ErrTxTimeout(fmt.Sprintf("timeout timestamp of unordered tx can be at most %d seconds ahead",
+				MaxUnorderedTimeoutDuration)).Result()
+		}
+	}
+	return sdk.Result{}
+}
+
+func isTimedOut(ctx sdk.Context, timeoutHeight, timeoutTimestamp int64) bool {
+	return (timeoutHeight > 0 && ctx.BlockHeight() > timeoutHeight) ||
+		(timeoutTimestamp > 0 && ctx.BlockHeader().Time.Unix() > timeoutTimestamp)
+}
+
+func validateFeePayer(feeGrantKeeper FeeGrantKeeper, feePayer sdk.AccAddress, signerAddrs []sdk.AccAddress) sdk.Result {
+	if !sdk.IsUpgrade(sdk.FeeGrant) || feeGrantKeeper == nil {
+		return sdk.ErrUnauthorized("fee payer is not supported").Result()
+	}
+	for _, signer := range signerAddrs {
+		if signer.Equals(feePayer) {
+			return sdk.ErrUnauthorized("fee payer can not be a signer of the tx").Result()
+		}
+	}
+	return sdk.Result{}
+}
+
+// charge the fees of the tx to the fee payer, the fee grant given by the
+// fee payer to the grantee is consumed.
+func processFeePayer(ctx sdk.Context, am AccountKeeper, opts anteOptions,
+	stdTx StdTx, grantee sdk.AccAddress, mode sdk.RunTxMode) (sdk.Context, sdk.Result) {
+	fee, err := calculateTxFee(stdTx)
+	if err != nil {
+		return ctx, err.Result()
+	}
+	if !fee.IsEmpty() {
+		err = opts.feeGrantKeeper.UseGrantedFees(ctx, stdTx.FeePayer, grantee, fee.Tokens, stdTx.Msgs)
+		if err != nil {
+			return ctx, err.Result()
+		}
+	}
+	return chargeFee(ctx, am, opts.feeCollectionKeeper, stdTx.FeePayer, fee, mode)
+}
+
+// charge the fees of the tx in the fee denom to the first signer, the fees of
+// the txs without a fee denom are charged in the native token by the fee handler
+func processFeeDenom(ctx sdk.Context, am AccountKeeper, feeCollectionKeeper *FeeCollectionKeeper,
+	stdTx StdTx, signer sdk.AccAddress, mode sdk.RunTxMode) (sdk.Context, sdk.Result) {
+	fee, err := calculateTxFee(stdTx)
+	if err != nil {
+		return ctx, err.Result()
+	}
+	return chargeFee(ctx, am, feeCollectionKeeper, signer, fee, mode)
+}
+
+// calculate the fee of the msgs and convert it to the fee denom of the tx
+func calculateTxFee(stdTx StdTx) (sdk.Fee, sdk.Error) {
+	fee, err := fees.CalculateTxFee(stdTx.Msgs)
+	if err != nil {
+		return fee, err
+	}
+	return fees.Denoms.Convert(fee, stdTx.FeeDenom)
+}
+
+// deduct the fee from the payer and mark the fees of the tx as paid. The native
+// tokens go to the fee pool of the block, and the tokens paid in the other fee
+// denoms are collected by the fee collection keeper to be distributed.
+func chargeFee(ctx sdk.Context, am AccountKeeper, feeCollectionKeeper *FeeCollectionKeeper,
+	payerAddr sdk.AccAddress, fee sdk.Fee, mode sdk.RunTxMode) (sdk.Context, sdk.Result) {
+	native := sdk.Coins{}
+	if !fee.IsEmpty() {
+		payer := am.GetAccount(ctx, payerAddr)
+		if payer == nil {
+			return ctx, sdk.ErrUnknownAddress(payerAddr.String()).Result()
+		}
+		before := payer.GetCoins()
+		payer, res := deductFees(ctx.BlockHeader().Time, payer, fee)
+		if !res.IsOK() {
+			return ctx, res
+		}
+		am.SetAccount(ctx, payer)
+		sdk.RecordBalanceChange(ctx, payerAddr, before, payer.GetCoins(), sdk.BalanceChangeFee)
+
+		collected := sdk.Coins{}
+		for _, token := range fee.Tokens {
+			if token.Denom == sdk.NativeTokenSymbol {
+				native = append(native, token)
+			} else {
+				collected = append(collected, token)
+			}
+		}
+		if len(collected) != 0 {
+			feeCollectionKeeper.AddCollectedFees(ctx, collected)
+		}
+	}
+
+	if mode == sdk.RunTxModeDeliver {
+		if txHash, ok := ctx.Value(txHashKey).(string); ok {
+			fees.Pool.AddFee(txHash, sdk.NewFee(native, fee.Type))
+		}
+	}
+	return WithFeePayer(ctx, payerAddr), sdk.Result{}
+}
+
 func getSignBytesList(chainID string, stdTx StdTx, stdSigs []StdSignature) (signatureBytesList [][]byte) {
 	signatureBytesList = make([][]byte, len(stdSigs))
 	for i := 0; i < len(stdSigs); i++ {
-		signatureBytesList[i] = StdSignBytes(chainID,
+		signatureBytesList[i] = StdSignBytesWithOptions(chainID,
 			stdSigs[i].AccountNumber, stdSigs[i].Sequence,
-			stdTx.Msgs, stdTx.Memo, stdTx.Source, stdTx.Data)
+			stdTx.Msgs, stdTx.Memo, stdTx.Source, stdTx.Data, stdTx.GetOptions())
 	}
 	return
 }
@@ -3,9 +3,11 @@ package auth
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
@@ -547,3 +549,243 @@ func TestProcessPubKey(t *testing.T) {
 		})
 	}
 }
+
+type mockFeeGrantKeeper struct {
+	allowance sdk.Coins
+}
+
+func (k *mockFeeGrantKeeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) sdk.Error {
+	if !k.allowance.IsGTE(fee) {
+		return sdk.ErrInsufficientFunds("fee allowance exceeded")
+	}
+	k.allowance = k.allowance.Minus(fee)
+	return nil
+}
+
+func newTestTxWithFeePayer(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, feePayer sdk.AccAddress) sdk.Tx {
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		signBytes := StdSignBytesWithOptions(ctx.ChainID(), accNums[i], seqs[i], msgs, "", 0, nil, StdTxOptions{FeePayer: feePayer})
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accNums[i], Sequence: seqs[i]}
+	}
+	return NewStdTx(msgs, sigs, "", 0, nil).WithFeePayer(feePayer)
+}
+
+func TestAnteHandlerFeePayer(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountKeeper(cdc, capKey, ProtoBaseAccount)
+	accountCache := getAccountCache(cdc, ms, capKey)
+	feeGrantKeeper := &mockFeeGrantKeeper{allowance: sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 150)}}
+	anteHandler := NewAnteHandler(mapper, WithFeeGrantKeeper(feeGrantKeeper))
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	ctx = ctx.WithBlockHeight(1).WithValue(txHashKey, "txHash")
+
+	msgType := newTestMsg().Type()
+	fees.RegisterCalculator(msgType, fees.FixedFeeCalculator(100, sdk.FeeForProposer))
+	defer fees.UnsetAllCalculators()
+	defer fees.Pool.Clear()
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	_, addr2 := privAndAddr()
+	coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 1000)}
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(coins)
+	mapper.SetAccount(ctx, acc2)
+
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	tx := newTestTxWithFeePayer(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}, addr2)
+
+	// the fee payer is not accepted before the upgrade
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.FeeGrant, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.FeeGrant, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	// the fee payer is signed by the signers
+	badTx := newTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}).(StdTx).WithFeePayer(addr2)
+	checkInvalidTx(t, anteHandler, ctx, badTx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	// the fee payer can not be a signer
+	selfPaidTx := newTestTxWithFeePayer(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}, addr1)
+	checkInvalidTx(t, anteHandler, ctx, selfPaidTx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	newCtx, result, abort := anteHandler(ctx, tx, sdk.RunTxModeDeliver)
+	require.False(t, abort, result.Log)
+	require.Equal(t, addr2, GetFeePayer(newCtx))
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 900)}, mapper.GetAccount(ctx, addr2).GetCoins())
+	require.Equal(t, &sdk.Fee{Tokens: sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}, Type: sdk.FeeForProposer},
+		fees.Pool.GetFee("txHash"))
+
+	// the fee allowance is used up
+	tx = newTestTxWithFeePayer(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{1}, addr2)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeInsufficientFunds)
+
+	// the signers pay the fees without a fee payer, the sequence is increased by the failed tx
+	// as the state of the ante handler is not reverted in the test
+	tx = newTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{2})
+	newCtx, result, abort = anteHandler(ctx, tx, sdk.RunTxModeDeliver)
+	require.False(t, abort, result.Log)
+	require.Nil(t, GetFeePayer(newCtx))
+}
+
+func newTestTxWithOptions(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, opts StdTxOptions) sdk.Tx {
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		signBytes := StdSignBytesWithOptions(ctx.ChainID(), accNums[i], seqs[i], msgs, "", 0, nil, opts)
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accNums[i], Sequence: seqs[i]}
+	}
+	return NewStdTx(msgs, sigs, "", 0, nil).WithOptions(opts)
+}
+
+func TestAnteHandlerTimeoutAndUnordered(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountKeeper(cdc, capKey, ProtoBaseAccount)
+	accountCache := getAccountCache(cdc, ms, capKey)
+	anteHandler := NewAnteHandler(mapper)
+	header := abci.Header{ChainID: "mychainid", Time: time.Unix(1000, 0)}
+	ctx := sdk.NewContext(ms, header, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	ctx = ctx.WithBlockHeight(10)
+
+	priv1, addr1 := privAndAddr()
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	privs, accNums := []crypto.PrivKey{priv1}, []int64{0}
+
+	// the timeout is not accepted before the upgrade
+	tx := newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{0}, StdTxOptions{TimeoutHeight: 10})
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.UnorderedTx, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.UnorderedTx, 0)
+	sdk.UpgradeMgr.SetHeight(10)
+
+	checkValidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver)
+
+	// the timed out txs are rejected
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{1}, StdTxOptions{TimeoutHeight: 9})
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeTxTimeout)
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{1}, StdTxOptions{TimeoutTimestamp: 999})
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeTxTimeout)
+
+	// the unordered txs must time out
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{100}, StdTxOptions{Unordered: true})
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeTxTimeout)
+
+	// the unordered txs can not time out too far beyond the current block
+	farHeight := StdTxOptions{Unordered: true, TimeoutHeight: 10 + MaxUnorderedTimeoutHeightDelta + 1}
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{100}, farHeight)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeTxTimeout)
+	farTime := StdTxOptions{Unordered: true, TimeoutHeight: 11, TimeoutTimestamp: 1000 + MaxUnorderedTimeoutDuration + 1}
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{100}, farTime)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeTxTimeout)
+	require.Len(t, mapper.getUnorderedNonces(ctx, addr1), 0)
+
+	// the nonces of the unordered txs can be used in any order but only once before the txs time out
+	unordered := StdTxOptions{Unordered: true, TimeoutHeight: 11}
+	checkValidTx(t, anteHandler, ctx, newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{200}, unordered), sdk.RunTxModeDeliver)
+	checkValidTx(t, anteHandler, ctx, newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{100}, unordered), sdk.RunTxModeDeliver)
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{200}, unordered)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeInvalidSequence)
+	require.Equal(t, int64(1), mapper.GetAccount(ctx, addr1).GetSequence())
+
+	// the ordered txs are not affected by the unordered ones
+	checkValidTx(t, anteHandler, ctx, newTestTx(ctx, msgs, privs, accNums, []int64{1}), sdk.RunTxModeDeliver)
+
+	// the nonces are pruned once the txs time out
+	ctx = ctx.WithBlockHeight(12)
+	tx = newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{200}, StdTxOptions{Unordered: true, TimeoutHeight: 12})
+	checkValidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver)
+	require.Len(t, mapper.getUnorderedNonces(ctx, addr1), 1)
+}
+
+func TestAnteHandlerFeeDenom(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := codec.New()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountKeeper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	accountCache := getAccountCache(cdc, ms, capKey)
+	feeGrantKeeper := &mockFeeGrantKeeper{allowance: sdk.Coins{sdk.NewCoin("USD", 1000)}}
+	anteHandler := NewAnteHandler(mapper, WithFeeGrantKeeper(feeGrantKeeper), WithFeeCollectionKeeper(feeCollector))
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	ctx = ctx.WithBlockHeight(1)
+
+	msgType := newTestMsg().Type()
+	fees.RegisterCalculator(msgType, fees.FixedFeeCalculator(100, sdk.FeeForProposer))
+	defer fees.UnsetAllCalculators()
+	fees.Denoms.SetRate("USD", sdk.NewDecWithPrec(25, 1))
+	defer fees.Denoms.Clear()
+
+	priv1, addr1 := privAndAddr()
+	_, addr2 := privAndAddr()
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.Coins{sdk.NewCoin("USD", 1000)})
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.Coins{sdk.NewCoin("USD", 1000), sdk.NewCoin(sdk.NativeTokenSymbol, 1000)}.Sort())
+	mapper.SetAccount(ctx, acc2)
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	privs, accNums := []crypto.PrivKey{priv1}, []int64{0}
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.FeeGrant, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.FeeGrant, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	// the fee denom is not accepted before the upgrade
+	tx := newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{0}, StdTxOptions{FeePayer: addr2, FeeDenom: "USD"})
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.MultiAssetFee, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.MultiAssetFee, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	// the denom must be in the table
+	badTx := newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{0}, StdTxOptions{FeePayer: addr2, FeeDenom: "EUR"})
+	checkInvalidTx(t, anteHandler, ctx, badTx, sdk.RunTxModeDeliver, sdk.CodeInvalidCoins)
+
+	// the fee denom is not accepted when the fees can not be collected
+	noCollectorHandler := NewAnteHandler(mapper, WithFeeGrantKeeper(feeGrantKeeper))
+	checkInvalidTx(t, noCollectorHandler, ctx, tx, sdk.RunTxModeDeliver, sdk.CodeUnauthorized)
+
+	// the fees are converted with the rate and collected to be distributed
+	_, result, abort := anteHandler(ctx, tx, sdk.RunTxModeDeliver)
+	require.False(t, abort, result.Log)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 1000), sdk.NewCoin("USD", 750)}.Sort(), mapper.GetAccount(ctx, addr2).GetCoins())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("USD", 750)}, feeGrantKeeper.allowance)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("USD", 250)}, feeCollector.GetCollectedFees(ctx))
+
+	// the first signer pays in the fee denom without a fee payer
+	noPayerTx := newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{1}, StdTxOptions{FeeDenom: "USD"})
+	newCtx, result, abort := anteHandler(ctx, noPayerTx, sdk.RunTxModeDeliver)
+	require.False(t, abort, result.Log)
+	require.Equal(t, addr1, GetFeePayer(newCtx))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("USD", 750)}, mapper.GetAccount(ctx, addr1).GetCoins())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("USD", 750)}, GetSigners(newCtx)[0].GetCoins())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("USD", 500)}, feeCollector.GetCollectedFees(ctx))
+
+	// the signer must afford the converted fee
+	poorTx := newTestTxWithOptions(ctx, msgs, privs, accNums, []int64{2}, StdTxOptions{FeeDenom: "USD"})
+	fees.Denoms.SetRate("USD", sdk.NewDecWithoutFra(10))
+	checkInvalidTx(t, anteHandler, ctx, poorTx, sdk.RunTxModeDeliver, sdk.CodeInsufficientFunds)
+}
@@ -9,16 +9,34 @@ import (
 // a Msg with the other requirements for a StdSignDoc before
 // it is signed. For use in the CLI.
 type StdSignMsg struct {
-	ChainID       string    `json:"chain_id"`
-	AccountNumber int64     `json:"account_number"`
-	Sequence      int64     `json:"sequence"`
-	Msgs          []sdk.Msg `json:"msgs"`
-	Memo          string    `json:"memo"`
-	Source        int64     `json:"source"`
-	Data          []byte    `json:"data"`
+	ChainID       string         `json:"chain_id"`
+	AccountNumber int64          `json:"account_number"`
+	Sequence      int64          `json:"sequence"`
+	Msgs          []sdk.Msg      `json:"msgs"`
+	Memo          string         `json:"memo"`
+	Source        int64          `json:"source"`
+	Data          []byte         `json:"data"`
+	FeePayer      sdk.AccAddress `json:"fee_payer,omitempty"`
+
+	TimeoutHeight    int64  `json:"timeout_height,omitempty"`
+	TimeoutTimestamp int64  `json:"timeout_timestamp,omitempty"`
+	Unordered        bool   `json:"unordered,omitempty"`
+	FeeDenom         string `json:"fee_denom,omitempty"`
+}
+
+// Options returns the optional fields of the tx to be signed.
+func (msg StdSignMsg) Options() auth.StdTxOptions {
+	return auth.StdTxOptions{
+		FeePayer:         msg.FeePayer,
+		TimeoutHeight:    msg.TimeoutHeight,
+		TimeoutTimestamp: msg.TimeoutTimestamp,
+		Unordered:        msg.Unordered,
+		FeeDenom:         msg.FeeDenom,
+	}
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return auth.StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msgs, msg.Memo, msg.Source, msg.Data)
+	return auth.StdSignBytesWithOptions(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Msgs, msg.Memo, msg.Source,
+		msg.Data, msg.Options())
 }
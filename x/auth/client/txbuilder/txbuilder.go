@@ -18,6 +18,12 @@ type TxBuilder struct {
 	ChainID       string
 	Memo          string
 	Source        int64
+	FeePayer      string
+
+	TimeoutHeight    int64
+	TimeoutTimestamp int64
+	Unordered        bool
+	FeeDenom         string
 }
 
 // NewTxBuilderFromCLI returns a new initialized TxBuilder with parameters from
@@ -29,6 +35,12 @@ func NewTxBuilderFromCLI() TxBuilder {
 		Sequence:      viper.GetInt64(client.FlagSequence),
 		Memo:          viper.GetString(client.FlagMemo),
 		Source:        viper.GetInt64(client.FlagSource),
+		FeePayer:      viper.GetString(client.FlagFeePayer),
+
+		TimeoutHeight:    viper.GetInt64(client.FlagTimeoutHeight),
+		TimeoutTimestamp: viper.GetInt64(client.FlagTimeoutTimestamp),
+		Unordered:        viper.GetBool(client.FlagUnordered),
+		FeeDenom:         viper.GetString(client.FlagFeeDenom),
 	}
 }
 
@@ -68,6 +80,31 @@ func (bldr TxBuilder) WithSource(source int64) TxBuilder {
 	return bldr
 }
 
+// WithFeePayer returns a copy of the context with an updated bech32 fee payer address.
+func (bldr TxBuilder) WithFeePayer(feePayer string) TxBuilder {
+	bldr.FeePayer = feePayer
+	return bldr
+}
+
+// WithTimeout returns a copy of the context with an updated timeout height and timestamp.
+func (bldr TxBuilder) WithTimeout(timeoutHeight, timeoutTimestamp int64) TxBuilder {
+	bldr.TimeoutHeight = timeoutHeight
+	bldr.TimeoutTimestamp = timeoutTimestamp
+	return bldr
+}
+
+// WithUnordered returns a copy of the context which builds unordered txs, the sequence is used as the nonce.
+func (bldr TxBuilder) WithUnordered(unordered bool) TxBuilder {
+	bldr.Unordered = unordered
+	return bldr
+}
+
+// WithFeeDenom returns a copy of the context with an updated denom the fees are paid in.
+func (bldr TxBuilder) WithFeeDenom(feeDenom string) TxBuilder {
+	bldr.FeeDenom = feeDenom
+	return bldr
+}
+
 // Build builds a single message to be signed from a TxBuilder given a set of
 // messages.
 func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
@@ -76,6 +113,15 @@ func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
 		return StdSignMsg{}, errors.Errorf("chain ID required but not specified")
 	}
 
+	var feePayer sdk.AccAddress
+	if bldr.FeePayer != "" {
+		var err error
+		feePayer, err = sdk.AccAddressFromBech32(bldr.FeePayer)
+		if err != nil {
+			return StdSignMsg{}, errors.Wrap(err, "invalid fee payer")
+		}
+	}
+
 	return StdSignMsg{
 		ChainID:       bldr.ChainID,
 		AccountNumber: bldr.AccountNumber,
@@ -83,6 +129,12 @@ func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
 		Memo:          bldr.Memo,
 		Msgs:          msgs,
 		Source:        bldr.Source,
+		FeePayer:      feePayer,
+
+		TimeoutHeight:    bldr.TimeoutHeight,
+		TimeoutTimestamp: bldr.TimeoutTimestamp,
+		Unordered:        bldr.Unordered,
+		FeeDenom:         bldr.FeeDenom,
 	}, nil
 }
 
@@ -93,7 +145,8 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 	if err != nil {
 		return nil, err
 	}
-	return bldr.Codec.MarshalBinaryLengthPrefixed(auth.NewStdTx(msg.Msgs, []auth.StdSignature{sig}, msg.Memo, msg.Source, msg.Data))
+	stdTx := auth.NewStdTx(msg.Msgs, []auth.StdSignature{sig}, msg.Memo, msg.Source, msg.Data).WithOptions(msg.Options())
+	return bldr.Codec.MarshalBinaryLengthPrefixed(stdTx)
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -132,7 +185,7 @@ func (bldr TxBuilder) BuildWithPubKey(name string, msgs []sdk.Msg) ([]byte, erro
 		PubKey:        info.GetPubKey(),
 	}}
 
-	return bldr.Codec.MarshalBinaryLengthPrefixed(auth.NewStdTx(msg.Msgs, sigs, msg.Memo, msg.Source, msg.Data))
+	return bldr.Codec.MarshalBinaryLengthPrefixed(auth.NewStdTx(msg.Msgs, sigs, msg.Memo, msg.Source, msg.Data).WithOptions(msg.Options()))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of a it. If append
@@ -146,6 +199,12 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appen
 		Memo:          stdTx.GetMemo(),
 		Source:        stdTx.GetSource(),
 		Data:          stdTx.GetData(),
+		FeePayer:      stdTx.GetFeePayer(),
+
+		TimeoutHeight:    stdTx.TimeoutHeight,
+		TimeoutTimestamp: stdTx.TimeoutTimestamp,
+		Unordered:        stdTx.Unordered,
+		FeeDenom:         stdTx.FeeDenom,
 	})
 	if err != nil {
 		return
@@ -157,7 +216,8 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appen
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = auth.NewStdTx(stdTx.GetMsgs(), sigs, stdTx.GetMemo(), stdTx.GetSource(), stdTx.GetData())
+	signedStdTx = auth.NewStdTx(stdTx.GetMsgs(), sigs, stdTx.GetMemo(), stdTx.GetSource(), stdTx.GetData()).
+		WithOptions(stdTx.GetOptions())
 	return
 }
 
@@ -31,6 +31,7 @@ type contextKey int // local to the auth module
 
 const (
 	contextKeySigners contextKey = iota
+	contextKeyFeePayer
 )
 
 // add the signers to the context
@@ -46,3 +47,18 @@ func GetSigners(ctx types.Context) []types.Account {
 	}
 	return v.([]types.Account)
 }
+
+// mark the fees of the tx as paid by the fee payer
+func WithFeePayer(ctx types.Context, feePayer types.AccAddress) types.Context {
+	return ctx.WithValue(contextKeyFeePayer, feePayer)
+}
+
+// get the account which paid the fees of the tx, the fees are not paid yet
+// and should be charged to the signers when it is nil
+func GetFeePayer(ctx types.Context) types.AccAddress {
+	v := ctx.Value(contextKeyFeePayer)
+	if v == nil {
+		return nil
+	}
+	return v.(types.AccAddress)
+}
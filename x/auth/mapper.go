@@ -353,6 +353,38 @@ func (ac *accountCache) Write() {
 	ac.cache = sync.Map{}
 }
 
+// DirtyAccounts returns the addresses of the accounts changed in the cache sorted in ascending order,
+// and the accounts, the deleted ones are nil. It is used to report the changes of a simulation, the
+// caches which are not created by NewAccountCache have no changes to report.
+func DirtyAccounts(cache sdk.AccountCache) ([]sdk.AccAddress, []sdk.Account) {
+	ac, ok := cache.(*accountCache)
+	if !ok {
+		return nil, nil
+	}
+	keys := make([]string, 0)
+	ac.cache.Range(func(key, value interface{}) bool {
+		if value.(cValue).dirty {
+			keys = append(keys, key.(string))
+		}
+		return true
+	})
+	sort.Strings(keys)
+
+	addrs := make([]sdk.AccAddress, 0, len(keys))
+	accs := make([]sdk.Account, 0, len(keys))
+	for _, key := range keys {
+		value, _ := ac.cache.Load(key)
+		cacheValue := value.(cValue)
+		addrs = append(addrs, sdk.AccAddress(key))
+		if cacheValue.deleted || cacheValue.acc == nil {
+			accs = append(accs, nil)
+		} else {
+			accs = append(accs, cacheValue.acc.Clone())
+		}
+	}
+	return addrs, accs
+}
+
 func (ac *accountCache) getAccountFromCache(addr sdk.AccAddress) (acc sdk.Account) {
 	cacheVal, ok := ac.cache.Load(string(addr))
 	if !ok {
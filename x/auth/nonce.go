@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxUnorderedNonces is the max number of pending unordered txs of an account
+	MaxUnorderedNonces = 128
+	// MaxUnorderedTimeoutHeightDelta and MaxUnorderedTimeoutDuration(seconds) bound how far beyond
+	// the current block the unordered txs can time out, so that their nonces are pruned in time
+	MaxUnorderedTimeoutHeightDelta = 1000
+	MaxUnorderedTimeoutDuration    = 3600
+)
+
+// unorderedNonce is the nonce of an unordered tx, it is kept until the tx times out
+type unorderedNonce struct {
+	Nonce            int64
+	TimeoutHeight    int64
+	TimeoutTimestamp int64
+}
+
+func unorderedNoncesKey(addr sdk.AccAddress) []byte {
+	return append([]byte("unorderedNonces:"), addr.Bytes()...)
+}
+
+func (am AccountKeeper) getUnorderedNonces(ctx sdk.Context, addr sdk.AccAddress) (nonces []unorderedNonce) {
+	bz := ctx.KVStore(am.key).Get(unorderedNoncesKey(addr))
+	if bz == nil {
+		return nil
+	}
+	am.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &nonces)
+	return nonces
+}
+
+func (am AccountKeeper) setUnorderedNonces(ctx sdk.Context, addr sdk.AccAddress, nonces []unorderedNonce) {
+	store := ctx.KVStore(am.key)
+	if len(nonces) == 0 {
+		store.Delete(unorderedNoncesKey(addr))
+		return
+	}
+	store.Set(unorderedNoncesKey(addr), am.cdc.MustMarshalBinaryLengthPrefixed(nonces))
+}
+
+// useUnorderedNonce records the nonce of an unordered tx of the account so that the tx can not
+// be replayed before it times out. The nonces of the timed out txs are pruned.
+func (am AccountKeeper) useUnorderedNonce(ctx sdk.Context, addr sdk.AccAddress, nonce int64,
+	timeoutHeight, timeoutTimestamp int64) sdk.Error {
+	var nonces []unorderedNonce
+	for _, n := range am.getUnorderedNonces(ctx, addr) {
+		if isTimedOut(ctx, n.TimeoutHeight, n.TimeoutTimestamp) {
+			continue
+		}
+		if n.Nonce == nonce {
+			return sdk.ErrInvalidSequence(fmt.Sprintf("nonce %d of the unordered tx is used", nonce))
+		}
+		nonces = append(nonces, n)
+	}
+	if len(nonces) >= MaxUnorderedNonces {
+		return sdk.ErrInvalidSequence(fmt.Sprintf("at most %d unordered txs can be pending", MaxUnorderedNonces))
+	}
+	nonces = append(nonces, unorderedNonce{
+		Nonce:            nonce,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+	am.setUnorderedNonces(ctx, addr, nonces)
+	return nil
+}
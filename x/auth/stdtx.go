@@ -22,6 +22,31 @@ type StdTx struct {
 	Memo       string         `json:"memo"`
 	Source     int64          `json:"source"`
 	Data       []byte         `json:"data"`
+
+	// FeePayer is the account paying the fees through a fee grant of the first signer,
+	// the signers pay the fees when it is empty.
+	FeePayer sdk.AccAddress `json:"fee_payer,omitempty"`
+	// TimeoutHeight and TimeoutTimestamp(unix seconds) are the last block height and
+	// time at which the tx can be included, the tx never expires when they are zero.
+	TimeoutHeight    int64 `json:"timeout_height,omitempty"`
+	TimeoutTimestamp int64 `json:"timeout_timestamp,omitempty"`
+	// Unordered txs use the sequences of the signatures as nonces which can be used
+	// in any order, they must time out within MaxUnorderedTimeoutHeightDelta blocks or
+	// MaxUnorderedTimeoutDuration seconds.
+	Unordered bool `json:"unordered,omitempty"`
+	// FeeDenom is the denom the fees are paid in by the fee payer, or the first signer without
+	// a fee payer. It must be in the accepted fee denoms of the param hub. The fees are paid in
+	// the native token when it is empty.
+	FeeDenom string `json:"fee_denom,omitempty"`
+}
+
+// StdTxOptions are the optional fields of a StdTx, they are signed along with the msgs when set.
+type StdTxOptions struct {
+	FeePayer         sdk.AccAddress
+	TimeoutHeight    int64
+	TimeoutTimestamp int64
+	Unordered        bool
+	FeeDenom         string
 }
 
 func NewStdTx(msgs []sdk.Msg, sigs []StdSignature, memo string, source int64, data []byte) StdTx {
@@ -66,6 +91,36 @@ func (tx StdTx) GetSource() int64 { return tx.Source }
 //nolint
 func (tx StdTx) GetData() []byte { return tx.Data }
 
+// WithFeePayer returns a copy of the tx with the fees paid by the given account.
+func (tx StdTx) WithFeePayer(feePayer sdk.AccAddress) StdTx {
+	tx.FeePayer = feePayer
+	return tx
+}
+
+//nolint
+func (tx StdTx) GetFeePayer() sdk.AccAddress { return tx.FeePayer }
+
+// WithOptions returns a copy of the tx with the optional fields set.
+func (tx StdTx) WithOptions(opts StdTxOptions) StdTx {
+	tx.FeePayer = opts.FeePayer
+	tx.TimeoutHeight = opts.TimeoutHeight
+	tx.TimeoutTimestamp = opts.TimeoutTimestamp
+	tx.Unordered = opts.Unordered
+	tx.FeeDenom = opts.FeeDenom
+	return tx
+}
+
+// GetOptions returns the optional fields of the tx.
+func (tx StdTx) GetOptions() StdTxOptions {
+	return StdTxOptions{
+		FeePayer:         tx.FeePayer,
+		TimeoutHeight:    tx.TimeoutHeight,
+		TimeoutTimestamp: tx.TimeoutTimestamp,
+		Unordered:        tx.Unordered,
+		FeeDenom:         tx.FeeDenom,
+	}
+}
+
 // Signatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -91,10 +146,24 @@ type StdSignDoc struct {
 	Sequence      int64             `json:"sequence"`
 	Source        int64             `json:"source"`
 	Data          []byte            `json:"data"`
+	FeePayer      sdk.AccAddress    `json:"fee_payer,omitempty"`
+
+	TimeoutHeight    int64 `json:"timeout_height,omitempty"`
+	TimeoutTimestamp int64 `json:"timeout_timestamp,omitempty"`
+	Unordered        bool  `json:"unordered,omitempty"`
+
+	FeeDenom string `json:"fee_denom,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum int64, sequence int64, msgs []sdk.Msg, memo string, source int64, data []byte) []byte {
+	return StdSignBytesWithOptions(chainID, accnum, sequence, msgs, memo, source, data, StdTxOptions{})
+}
+
+// StdSignBytesWithOptions returns the bytes to sign for a transaction with optional fields.
+// The sign bytes are the same as the ones of StdSignBytes when no option is set.
+func StdSignBytesWithOptions(chainID string, accnum int64, sequence int64, msgs []sdk.Msg, memo string, source int64,
+	data []byte, opts StdTxOptions) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -107,6 +176,13 @@ func StdSignBytes(chainID string, accnum int64, sequence int64, msgs []sdk.Msg,
 		Sequence:      sequence,
 		Source:        source,
 		Data:          data,
+		FeePayer:      opts.FeePayer,
+
+		TimeoutHeight:    opts.TimeoutHeight,
+		TimeoutTimestamp: opts.TimeoutTimestamp,
+		Unordered:        opts.Unordered,
+
+		FeeDenom: opts.FeeDenom,
 	})
 	if err != nil {
 		panic(err)
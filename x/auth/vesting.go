@@ -0,0 +1,368 @@
+package auth
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingAccount defines an account type that vests coins via a vesting schedule.
+// Locked coins can not be transferred but can still be delegated, the delegated
+// coins are tracked so that they go back to the locked balance once undelegated.
+type VestingAccount interface {
+	sdk.Account
+
+	// Calculates the amount of coins that can be sent to other accounts given
+	// the current time.
+	SpendableCoins(blockTime time.Time) sdk.Coins
+	// Performs delegation accounting.
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// Performs undelegation accounting.
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// SpendableCoins returns the coins of the account which can be spent at the given time
+func SpendableCoins(acc sdk.Account, blockTime time.Time) sdk.Coins {
+	if vacc, ok := acc.(VestingAccount); ok {
+		return vacc.SpendableCoins(blockTime)
+	}
+	return acc.GetCoins()
+}
+
+//-----------------------------------------------------------------------------
+// Base Vesting Account
+
+// BaseVestingAccount implements the VestingAccount interface. It contains all
+// the necessary fields needed for any vesting account implementation.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins in account upon initialization
+	DelegatedFree    sdk.Coins `json:"delegated_free"`    // coins that are vested and delegated
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // coins that vesting and delegated
+
+	EndTime int64 `json:"end_time"` // when the coins become unlocked
+}
+
+// spendableCoins returns all the spendable coins for a vesting account given a
+// set of vesting coins.
+//
+// CONTRACT: The account's coins, delegated vesting coins, vestingCoins must be
+// sorted.
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+	bc := bva.GetCoins()
+
+	for _, coin := range bc {
+		baseAmt := coin.Amount
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// compute min((BC + DV) - V, BC) per the specification
+		min := baseAmt + delVestingAmt - vestingAmt
+		if baseAmt < min {
+			min = baseAmt
+		}
+
+		if min > 0 {
+			spendableCoins = spendableCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, min)})
+		}
+	}
+
+	return spendableCoins
+}
+
+// trackDelegation tracks a delegation amount for any given vesting account type
+// given the amount of coins currently vesting. It returns the resulting base
+// coins.
+//
+// CONTRACT: The account's coins, delegation coins, vesting coins, and delegated
+// vesting coins must be sorted.
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	bc := bva.GetCoins()
+
+	for _, coin := range amount {
+		// zero coins should not be delegated
+		if coin.Amount <= 0 {
+			panic("delegation attempt with zero coins")
+		}
+
+		baseAmt := bc.AmountOf(coin.Denom)
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// Panic if the delegation amount is zero or if the base coins does not
+		// exceed the desired delegation amount.
+		if baseAmt < coin.Amount {
+			panic("delegation attempt with insufficient funds")
+		}
+
+		// compute x and y per the specification, where:
+		// X := min(max(V - DV, 0), D)
+		// Y := D - X
+		x := vestingAmt - delVestingAmt
+		if x < 0 {
+			x = 0
+		}
+		if x > coin.Amount {
+			x = coin.Amount
+		}
+		y := coin.Amount - x
+
+		if x > 0 {
+			bva.DelegatedVesting = bva.DelegatedVesting.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if y > 0 {
+			bva.DelegatedFree = bva.DelegatedFree.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+
+	bva.Coins = bc.Minus(amount)
+}
+
+// TrackUndelegation tracks an undelegation amount by setting the necessary
+// values by which delegated vesting and delegated vesting need to decrease and
+// by which amount the base coins need to increase. The resulting base coins are
+// returned.
+//
+// NOTE: The undelegation (bond refund) amount may exceed the delegated vesting
+// (bond) amount due to the way undelegation truncates the bond refund, which
+// can increase the validator's exchange rate (tokens/shares) slightly if the
+// undelegated tokens are non-integral.
+//
+// CONTRACT: The account's coins and undelegation coins must be sorted.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		// panic if the undelegation amount is zero
+		if coin.Amount <= 0 {
+			panic("undelegation attempt with zero coins")
+		}
+
+		delegatedFree := bva.DelegatedFree.AmountOf(coin.Denom)
+		delegatedVesting := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		// compute x and y per the specification, where:
+		// X := min(DF, D)
+		// Y := min(DV, D - X)
+		x := coin.Amount
+		if delegatedFree < x {
+			x = delegatedFree
+		}
+		y := coin.Amount - x
+		if delegatedVesting < y {
+			y = delegatedVesting
+		}
+
+		if x > 0 {
+			bva.DelegatedFree = bva.DelegatedFree.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, x)})
+		}
+		if y > 0 {
+			bva.DelegatedVesting = bva.DelegatedVesting.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, y)})
+		}
+	}
+
+	bva.Coins = bva.GetCoins().Plus(amount)
+}
+
+// GetOriginalVesting returns a vesting account's original vesting amount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetDelegatedFree returns a vesting account's delegation amount that is not
+// vesting.
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// GetDelegatedVesting returns a vesting account's delegation amount that is
+// still vesting.
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// GetEndTime returns a vesting account's end time
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+func (bva BaseVestingAccount) clone() *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:      bva.BaseAccount.Clone().(*BaseAccount),
+		OriginalVesting:  cloneCoins(bva.OriginalVesting),
+		DelegatedFree:    cloneCoins(bva.DelegatedFree),
+		DelegatedVesting: cloneCoins(bva.DelegatedVesting),
+		EndTime:          bva.EndTime,
+	}
+}
+
+func cloneCoins(coins sdk.Coins) sdk.Coins {
+	if coins == nil {
+		return nil
+	}
+	return append(make(sdk.Coins, 0, len(coins)), coins...)
+}
+
+//-----------------------------------------------------------------------------
+// Continuous Vesting Account
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount implements the VestingAccount interface. It
+// continuously vests by unlocking coins linearly with respect to time.
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime int64 `json:"start_time"` // when the coins start to vest
+}
+
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime int64) (*ContinuousVestingAccount, error) {
+	if endTime <= startTime {
+		return nil, errors.New("vesting end time must be after the start time")
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         endTime,
+	}
+
+	return &ContinuousVestingAccount{
+		StartTime:          startTime,
+		BaseVestingAccount: baseVestingAcc,
+	}, nil
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// calculate the vesting scalar, the math is done in big.Int to avoid overflow
+	x := big.NewInt(blockTime.Unix() - cva.StartTime)
+	y := big.NewInt(cva.EndTime - cva.StartTime)
+
+	for _, ovc := range cva.OriginalVesting {
+		vestedAmt := new(big.Int).Mul(big.NewInt(ovc.Amount), x)
+		vestedAmt.Quo(vestedAmt, y)
+		if vestedAmt.Sign() > 0 {
+			vestedCoins = vestedCoins.Plus(sdk.Coins{sdk.NewCoin(ovc.Denom, vestedAmt.Int64())})
+		}
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Minus(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// continuous vesting account.
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a continuous vesting
+// account.
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Implements sdk.Account.
+func (cva *ContinuousVestingAccount) Clone() sdk.Account {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: cva.BaseVestingAccount.clone(),
+		StartTime:          cva.StartTime,
+	}
+}
+
+//-----------------------------------------------------------------------------
+// Delayed Vesting Account
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount implements the VestingAccount interface. It vests all
+// coins after a specific time, but non prior. In other words, it keeps them
+// locked until a specified time.
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime int64) *DelayedVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: baseAcc.Coins,
+		EndTime:         endTime,
+	}
+
+	return &DelayedVestingAccount{baseVestingAcc}
+}
+
+// GetVestedCoins returns the total amount of vested coins for a delayed vesting
+// account. All coins are only vested once the schedule has elapsed.
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Minus(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins for a delayed
+// vesting account.
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns zero since a delayed vesting account has no start time.
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// Implements sdk.Account.
+func (dva *DelayedVestingAccount) Clone() sdk.Account {
+	return &DelayedVestingAccount{dva.BaseVestingAccount.clone()}
+}
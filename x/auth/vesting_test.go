@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newVestingBaseAccount(coins sdk.Coins) *BaseAccount {
+	_, _, addr := keyPubAddr()
+	acc := NewBaseAccountWithAddress(addr)
+	acc.SetCoins(coins)
+	return &acc
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	now := time.Unix(1000, 0)
+	endTime := now.Add(24 * time.Hour)
+	origCoins := sdk.Coins{sdk.NewCoin("fee", 1000), sdk.NewCoin("stake", 100)}
+
+	_, err := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix(), now.Unix())
+	require.Error(t, err)
+
+	cva, err := NewContinuousVestingAccount(newVestingBaseAccount(origCoins), now.Unix(), endTime.Unix())
+	require.NoError(t, err)
+
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Nil(t, cva.SpendableCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+
+	// half of the coins are vested in the middle of the schedule
+	halfVested := sdk.Coins{sdk.NewCoin("fee", 500), sdk.NewCoin("stake", 50)}
+	require.Equal(t, halfVested, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, halfVested, cva.SpendableCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, cva.SpendableCoins(endTime))
+
+	// delegate locked coins
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewCoin("stake", 80)})
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 50)}, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 30)}, cva.GetDelegatedFree())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("fee", 1000), sdk.NewCoin("stake", 20)}, cva.GetCoins())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("fee", 500), sdk.NewCoin("stake", 20)}, cva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// the free coins are undelegated first, then the vesting ones go back to the locked balance
+	cva.TrackUndelegation(sdk.Coins{sdk.NewCoin("stake", 40)})
+	require.Nil(t, cva.GetDelegatedFree())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 40)}, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("fee", 500), sdk.NewCoin("stake", 50)}, cva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// clone is a deep copy
+	cloned := cva.Clone().(*ContinuousVestingAccount)
+	cloned.TrackUndelegation(sdk.Coins{sdk.NewCoin("stake", 40)})
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 40)}, cva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("fee", 1000), sdk.NewCoin("stake", 60)}, cva.GetCoins())
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	now := time.Unix(1000, 0)
+	endTime := now.Add(24 * time.Hour)
+	origCoins := sdk.Coins{sdk.NewCoin("fee", 1000), sdk.NewCoin("stake", 100)}
+
+	dva := NewDelayedVestingAccount(newVestingBaseAccount(origCoins), endTime.Unix())
+	require.Nil(t, dva.SpendableCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, dva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, dva.SpendableCoins(endTime))
+
+	// receive coins which are free
+	dva.SetCoins(dva.GetCoins().Plus(sdk.Coins{sdk.NewCoin("stake", 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 50)}, dva.SpendableCoins(now))
+
+	dva.TrackDelegation(now, sdk.Coins{sdk.NewCoin("stake", 120)})
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 100)}, dva.GetDelegatedVesting())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 20)}, dva.GetDelegatedFree())
+	require.Equal(t, sdk.Coins{sdk.NewCoin("stake", 30)}, dva.SpendableCoins(now))
+
+	// fees can not be paid with the locked coins
+	_, res := deductFees(now, dva, sdk.NewFee(sdk.Coins{sdk.NewCoin("fee", 1)}, sdk.FeeForProposer))
+	require.False(t, res.IsOK())
+	_, res = deductFees(endTime, dva, sdk.NewFee(sdk.Coins{sdk.NewCoin("fee", 1)}, sdk.FeeForProposer))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(999), dva.GetCoins().AmountOf("fee"))
+}
@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	RouteAuthz       = types.RouteAuthz
+	DefaultCodespace = types.DefaultCodespace
+)
+
+var (
+	// functions aliases
+	NewKeeper = keeper.NewKeeper
+
+	NewGenericAuthorization = types.NewGenericAuthorization
+	NewSendAuthorization    = types.NewSendAuthorization
+	NewStakeAuthorization   = types.NewStakeAuthorization
+	NewMsgGrant             = types.NewMsgGrant
+	NewMsgRevoke            = types.NewMsgRevoke
+	NewMsgExec              = types.NewMsgExec
+	MsgTypeURL              = types.MsgTypeURL
+
+	ErrInvalidAuthorization = types.ErrInvalidAuthorization
+	ErrNoAuthorization      = types.ErrNoAuthorization
+	ErrAuthorizationExpired = types.ErrAuthorizationExpired
+	ErrUnauthorizedMsg      = types.ErrUnauthorizedMsg
+	ErrAuthzNotEnabled      = types.ErrAuthzNotEnabled
+	ErrInvalidExecMsg       = types.ErrInvalidExecMsg
+)
+
+type (
+	Keeper               = keeper.Keeper
+	Authorization        = types.Authorization
+	GenericAuthorization = types.GenericAuthorization
+	SendAuthorization    = types.SendAuthorization
+	StakeAuthorization   = types.StakeAuthorization
+	Grant                = types.Grant
+
+	MsgGrant  = types.MsgGrant
+	MsgRevoke = types.MsgRevoke
+	MsgExec   = types.MsgExec
+)
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagGranter           = "granter"
+	flagGrantee           = "grantee"
+	flagAuthorizationType = "type"
+	flagMsgType           = "msg-type"
+	flagSpendLimit        = "spend-limit"
+	flagAllowedValidators = "allowed-validators"
+	flagMaxTokens         = "max-tokens"
+	flagExpiration        = "expiration"
+
+	authorizationTypeGeneric = "generic"
+	authorizationTypeSend    = "send"
+	authorizationTypeStake   = "stake"
+)
+
+func AddCommands(root *cobra.Command, cdc *codec.Codec) {
+	authzCmd := &cobra.Command{
+		Use:   "authz",
+		Short: "authorizations to execute msgs on behalf of other accounts",
+	}
+
+	authzCmd.AddCommand(
+		client.PostCommands(
+			GetCmdGrant(cdc),
+			GetCmdRevoke(cdc),
+			GetCmdExec(cdc),
+		)...)
+
+	authzCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryGrants(cdc),
+		)...)
+
+	root.AddCommand(authzCmd)
+}
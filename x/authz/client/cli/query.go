@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GetCmdQueryGrants implements the command to query the authorizations given by a granter to a grantee.
+func GetCmdQueryGrants(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-grants",
+		Short: "query the authorizations given by a granter to a grantee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(viper.GetString(flagGranter))
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+
+			params := authz.QueryGrantsParams{Granter: granter, Grantee: grantee, MsgTypeURL: viper.GetString(flagMsgType)}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", authz.StoreKey, authz.QueryGrants), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGranter, "", "address of the granter")
+	cmd.Flags().String(flagGrantee, "", "address of the grantee")
+	cmd.Flags().String(flagMsgType, "", "the msg type of the authorization, all msg types if empty")
+	cmd.MarkFlagRequired(flagGranter)
+	cmd.MarkFlagRequired(flagGrantee)
+	return cmd
+}
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GetCmdGrant implements the command to grant an authorization to another account.
+func GetCmdGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "grant an account to execute msgs on behalf of the granter",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(flagExpiration))
+			if err != nil {
+				return fmt.Errorf("invalid expiration, it should be in RFC3339 format: %v", err)
+			}
+			authorization, err := buildAuthorization()
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgGrant(granter, grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "address of the account allowed to execute the msgs")
+	cmd.Flags().String(flagAuthorizationType, authorizationTypeGeneric, "type of the authorization: generic, send or stake")
+	cmd.Flags().String(flagMsgType, "", "the msg type authorized by a generic or stake authorization, e.g. stake/side_delegate")
+	cmd.Flags().String(flagSpendLimit, "", "the coins sent at most with a send authorization")
+	cmd.Flags().StringSlice(flagAllowedValidators, nil, "the validators the tokens can be delegated to with a stake authorization")
+	cmd.Flags().String(flagMaxTokens, "", "the tokens delegated at most with a stake authorization, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "expiration time of the authorization in RFC3339 format")
+	cmd.MarkFlagRequired(flagGrantee)
+	cmd.MarkFlagRequired(flagExpiration)
+	return cmd
+}
+
+func buildAuthorization() (authz.Authorization, error) {
+	switch authorizationType := viper.GetString(flagAuthorizationType); authorizationType {
+	case authorizationTypeGeneric:
+		return authz.NewGenericAuthorization(viper.GetString(flagMsgType)), nil
+	case authorizationTypeSend:
+		spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+		if err != nil {
+			return nil, err
+		}
+		return authz.NewSendAuthorization(spendLimit), nil
+	case authorizationTypeStake:
+		var validators []sdk.ValAddress
+		for _, v := range viper.GetStringSlice(flagAllowedValidators) {
+			validator, err := sdk.ValAddressFromBech32(v)
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, validator)
+		}
+		maxTokens, err := sdk.ParseCoins(viper.GetString(flagMaxTokens))
+		if err != nil {
+			return nil, err
+		}
+		return authz.NewStakeAuthorization(viper.GetString(flagMsgType), validators, maxTokens), nil
+	default:
+		return nil, fmt.Errorf("unknown authorization type %s", authorizationType)
+	}
+}
+
+// GetCmdRevoke implements the command to revoke an authorization.
+func GetCmdRevoke(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "revoke the authorization of a msg type granted to an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevoke(granter, grantee, viper.GetString(flagMsgType))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "address of the account whose authorization is revoked")
+	cmd.Flags().String(flagMsgType, "", "the msg type of the authorization, e.g. bank/send")
+	cmd.MarkFlagRequired(flagGrantee)
+	cmd.MarkFlagRequired(flagMsgType)
+	return cmd
+}
+
+// GetCmdExec implements the command to execute the msgs of a tx generated with --generate-only on behalf of their signers.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "execute the msgs of a generated tx on behalf of the accounts which granted the authorizations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			grantee, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var stdTx auth.StdTx
+			if err := cdc.UnmarshalJSON(bz, &stdTx); err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgExec(grantee, stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
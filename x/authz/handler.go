@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if !sdk.IsUpgrade(sdk.Authz) {
+			return types.ErrAuthzNotEnabled().Result()
+		}
+		switch msg := msg.(type) {
+		case MsgGrant:
+			return handleMsgGrant(ctx, keeper, msg)
+		case MsgRevoke:
+			return handleMsgRevoke(ctx, keeper, msg)
+		case MsgExec:
+			return keeper.DispatchMsgs(ctx, msg.Grantee, msg.Msgs)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized authz msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrant(ctx sdk.Context, keeper Keeper, msg MsgGrant) sdk.Result {
+	if !ctx.BlockHeader().Time.Before(msg.Expiration) {
+		return types.ErrAuthorizationExpired().Result()
+	}
+	keeper.SaveGrant(ctx, msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+	return sdk.Result{}
+}
+
+func handleMsgRevoke(ctx sdk.Context, keeper Keeper, msg MsgRevoke) sdk.Result {
+	if err := keeper.DeleteGrant(ctx, msg.Granter, msg.Grantee, msg.MsgTypeURL); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
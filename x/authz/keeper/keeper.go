@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper stores the authorizations and executes the msgs on behalf of the granters
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   baseapp.Router
+}
+
+// NewKeeper creates new instances of the authz Keeper, the msgs are executed by the handlers of the router
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router baseapp.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// SaveGrant sets the authorization given by the granter to the grantee, the former one of the same msg type is replaced
+func (k Keeper) SaveGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time) {
+	grant := types.Grant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGrantKey(granter, grantee, authorization.MsgTypeURL()), k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// DeleteGrant removes the authorization of the msg type given by the granter to the grantee
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetGrantKey(granter, grantee, msgTypeURL)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(granter, grantee, msgTypeURL)
+	}
+	store.Delete(key)
+	return nil
+}
+
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGrantKey(granter, grantee, msgTypeURL))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates the authorizations given by the granter to the grantee until the callback returns true
+func (k Keeper) IterateGrants(ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(grant types.Grant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetGrantsKey(granter, grantee))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchMsgs executes the msgs with the handlers of the router. The msgs signed by other
+// accounts than the grantee are accepted by the authorizations the signers gave to the grantee.
+func (k Keeper) DispatchMsgs(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	for _, msg := range msgs {
+		granter := msg.GetSigners()[0]
+		if !granter.Equals(grantee) {
+			if err := k.acceptMsg(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+	}
+	return baseapp.DispatchMsgs(ctx, k.router, msgs)
+}
+
+func (k Keeper) acceptMsg(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgTypeURL := types.MsgTypeURL(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return types.ErrNoAuthorization(granter, grantee, msgTypeURL)
+	}
+	if grant.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrAuthorizationExpired()
+	}
+
+	updated, remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+	if remove {
+		return k.DeleteGrant(ctx, granter, grantee, msgTypeURL)
+	}
+	k.SaveGrant(ctx, granter, grantee, updated, grant.Expiration)
+	return nil
+}
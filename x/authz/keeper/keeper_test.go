@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, *[]sdk.Msg) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+
+	// the bank handler records the msgs executed
+	var executed []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute("bank", func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		executed = append(executed, msg)
+		return sdk.Result{}
+	})
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, sdk.RunTxModeDeliver, log.NewNopLogger())
+	return ctx, NewKeeper(cdc, key, router), &executed
+}
+
+func TestDispatchMsgs(t *testing.T) {
+	ctx, keeper, executed := createTestInput(t)
+	granter := sdk.AccAddress([]byte("granter-------------"))
+	grantee := sdk.AccAddress([]byte("grantee-------------"))
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, amount)}
+		return bank.NewMsgSend([]bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(grantee, coins)})
+	}
+	expiration := ctx.BlockHeader().Time.Add(time.Hour)
+
+	// the msgs of the grantee do not need authorizations
+	require.True(t, keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(grantee, 10)}).IsOK())
+	require.Len(t, *executed, 1)
+
+	res := keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(granter, 10)})
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNoAuthorization), res.Code)
+
+	// the spend limit is consumed and the authorization is removed once used up
+	spendLimit := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}
+	keeper.SaveGrant(ctx, granter, grantee, types.NewSendAuthorization(spendLimit), expiration)
+	require.True(t, keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(granter, 60), send(grantee, 10)}).IsOK())
+	require.Len(t, *executed, 3)
+	grant, found := keeper.GetGrant(ctx, granter, grantee, types.MsgTypeURL(bank.MsgSend{}))
+	require.True(t, found)
+	require.Equal(t, types.NewSendAuthorization(sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 40)}), grant.Authorization)
+
+	res = keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(granter, 50)})
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeUnauthorizedMsg), res.Code)
+	require.True(t, keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(granter, 40)}).IsOK())
+	_, found = keeper.GetGrant(ctx, granter, grantee, types.MsgTypeURL(bank.MsgSend{}))
+	require.False(t, found)
+
+	// the expired authorizations are not accepted
+	keeper.SaveGrant(ctx, granter, grantee, types.NewGenericAuthorization(types.MsgTypeURL(bank.MsgSend{})), expiration)
+	require.True(t, keeper.DispatchMsgs(ctx, grantee, []sdk.Msg{send(granter, 1000)}).IsOK())
+	res = keeper.DispatchMsgs(ctx.WithBlockHeader(abci.Header{Time: expiration}), grantee, []sdk.Msg{send(granter, 1000)})
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeAuthorizationExpired), res.Code)
+
+	var grants []types.Grant
+	keeper.IterateGrants(ctx, granter, grantee, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 1)
+	require.Nil(t, keeper.DeleteGrant(ctx, granter, grantee, types.MsgTypeURL(bank.MsgSend{})))
+}
@@ -0,0 +1,54 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryGrants = "grants"
+)
+
+type QueryGrantsParams struct {
+	Granter    sdk.AccAddress
+	Grantee    sdk.AccAddress
+	MsgTypeURL string // optional, query the authorizations of all msg types if empty
+}
+
+// creates a querier for the authz REST endpoints
+func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryGrants:
+			return queryGrants(ctx, k, cdc, req)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown authz query endpoint")
+		}
+	}
+}
+
+func queryGrants(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryGrantsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := make([]Grant, 0)
+	if len(params.MsgTypeURL) != 0 {
+		if grant, found := k.GetGrant(ctx, params.Granter, params.Grantee, params.MsgTypeURL); found {
+			grants = append(grants, grant)
+		}
+	} else {
+		k.IterateGrants(ctx, params.Granter, params.Grantee, func(grant Grant) bool {
+			grants = append(grants, grant)
+			return false
+		})
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
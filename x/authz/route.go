@@ -0,0 +1,12 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func Routes(keeper Keeper) map[string]sdk.Handler {
+	routes := make(map[string]sdk.Handler)
+	routes[types.RouteAuthz] = NewHandler(keeper)
+	return routes
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// Authorization lets the grantee execute the msgs of a msg type on behalf of the granter
+type Authorization interface {
+	// MsgTypeURL returns the msg type authorized, see MsgTypeURL
+	MsgTypeURL() string
+	ValidateBasic() sdk.Error
+	// Accept checks the msg is allowed by the authorization, it returns the updated authorization
+	// to be stored, and whether the authorization is used up and should be removed.
+	Accept(msg sdk.Msg) (updated Authorization, remove bool, err sdk.Error)
+}
+
+var _ Authorization = GenericAuthorization{}
+
+// GenericAuthorization authorizes any msg of the msg type
+type GenericAuthorization struct {
+	MsgType string `json:"msg_type"`
+}
+
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{MsgType: msgType}
+}
+
+func (a GenericAuthorization) MsgTypeURL() string { return a.MsgType }
+
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	if len(a.MsgType) == 0 {
+		return ErrInvalidAuthorization("msg type can not be empty")
+	}
+	if a.MsgType == RouteAuthz+"/"+ExecMsgType {
+		return ErrInvalidAuthorization("exec msgs can not be authorized")
+	}
+	return nil
+}
+
+func (a GenericAuthorization) Accept(msg sdk.Msg) (Authorization, bool, sdk.Error) {
+	return a, false, nil
+}
+
+var _ Authorization = SendAuthorization{}
+
+// SendAuthorization authorizes the grantee to send the coins of the granter up to the spend limit
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit"`
+}
+
+func NewSendAuthorization(spendLimit sdk.Coins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+func (a SendAuthorization) MsgTypeURL() string { return MsgTypeURL(bank.MsgSend{}) }
+
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive() {
+		return ErrInvalidAuthorization(fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	return nil
+}
+
+func (a SendAuthorization) Accept(msg sdk.Msg) (Authorization, bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return nil, false, ErrUnauthorizedMsg(fmt.Sprintf("unexpected msg type %s", MsgTypeURL(msg)))
+	}
+	var amount sdk.Coins
+	for _, in := range send.Inputs {
+		amount = amount.Plus(in.Coins)
+	}
+	if !a.SpendLimit.IsGTE(amount) {
+		return nil, false, ErrUnauthorizedMsg(fmt.Sprintf("amount %s exceeds the spend limit %s", amount, a.SpendLimit))
+	}
+	a.SpendLimit = a.SpendLimit.Minus(amount)
+	return a, a.SpendLimit.IsZero(), nil
+}
+
+var _ Authorization = StakeAuthorization{}
+
+// StakeAuthorization authorizes the grantee to delegate the tokens of the granter to the allowed validators
+type StakeAuthorization struct {
+	MsgType           string           `json:"msg_type"`           // the delegate msg type, stake/delegate or stake/side_delegate
+	AllowedValidators []sdk.ValAddress `json:"allowed_validators"` // the validators the tokens can be delegated to
+	MaxTokens         sdk.Coins        `json:"max_tokens"`         // the tokens delegated at most, unlimited if empty
+}
+
+func NewStakeAuthorization(msgType string, allowedValidators []sdk.ValAddress, maxTokens sdk.Coins) StakeAuthorization {
+	return StakeAuthorization{
+		MsgType:           msgType,
+		AllowedValidators: allowedValidators,
+		MaxTokens:         maxTokens,
+	}
+}
+
+func (a StakeAuthorization) MsgTypeURL() string { return a.MsgType }
+
+func (a StakeAuthorization) ValidateBasic() sdk.Error {
+	if a.MsgType != MsgTypeURL(stake.MsgDelegate{}) && a.MsgType != MsgTypeURL(stake.MsgSideChainDelegate{}) {
+		return ErrInvalidAuthorization(fmt.Sprintf("msg type %s can not be authorized by stake authorization", a.MsgType))
+	}
+	if len(a.AllowedValidators) == 0 || len(a.AllowedValidators) > MaxAllowedValidators {
+		return ErrInvalidAuthorization(fmt.Sprintf("the number of allowed validators should be between 1 and %d", MaxAllowedValidators))
+	}
+	for _, validator := range a.AllowedValidators {
+		if len(validator) != sdk.AddrLen {
+			return ErrInvalidAuthorization(fmt.Sprintf("invalid validator address length %d", len(validator)))
+		}
+	}
+	if len(a.MaxTokens) != 0 && (!a.MaxTokens.IsValid() || !a.MaxTokens.IsPositive()) {
+		return ErrInvalidAuthorization(fmt.Sprintf("invalid max tokens %s", a.MaxTokens))
+	}
+	return nil
+}
+
+func (a StakeAuthorization) Accept(msg sdk.Msg) (Authorization, bool, sdk.Error) {
+	var validator sdk.ValAddress
+	var delegation sdk.Coin
+	switch msg := msg.(type) {
+	case stake.MsgDelegate:
+		validator, delegation = msg.ValidatorAddr, msg.Delegation
+	case stake.MsgSideChainDelegate:
+		validator, delegation = msg.ValidatorAddr, msg.Delegation
+	}
+	if MsgTypeURL(msg) != a.MsgType {
+		return nil, false, ErrUnauthorizedMsg(fmt.Sprintf("unexpected msg type %s", MsgTypeURL(msg)))
+	}
+
+	allowed := false
+	for _, v := range a.AllowedValidators {
+		if v.Equals(validator) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, false, ErrUnauthorizedMsg(fmt.Sprintf("validator %s is not allowed", validator))
+	}
+
+	if len(a.MaxTokens) == 0 {
+		return a, false, nil
+	}
+	amount := sdk.Coins{delegation}
+	if !a.MaxTokens.IsGTE(amount) {
+		return nil, false, ErrUnauthorizedMsg(fmt.Sprintf("delegation %s exceeds the max tokens %s", amount, a.MaxTokens))
+	}
+	a.MaxTokens = a.MaxTokens.Minus(amount)
+	return a, a.MaxTokens.IsZero(), nil
+}
+
+// Grant is an authorization given by a granter to a grantee
+type Grant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    time.Time      `json:"expiration"`
+}
+
+func (g Grant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+func (g Grant) String() string {
+	return fmt.Sprintf(`Granter: %s
+Grantee: %s
+Authorization: %v
+Expiration: %s`, g.Granter, g.Grantee, g.Authorization, g.Expiration)
+}
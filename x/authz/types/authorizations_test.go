@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestStakeAuthorization(t *testing.T) {
+	delegator := sdk.AccAddress([]byte("delegator-----------"))
+	validator := sdk.ValAddress([]byte("validator-----------"))
+	other := sdk.ValAddress([]byte("other---------------"))
+	msgType := MsgTypeURL(stake.MsgSideChainDelegate{})
+	delegate := func(validator sdk.ValAddress, amount int64) sdk.Msg {
+		return stake.NewMsgSideChainDelegate("bsc", delegator, validator, sdk.NewCoin(sdk.NativeTokenSymbol, amount))
+	}
+
+	require.NotNil(t, NewStakeAuthorization("bank/send", []sdk.ValAddress{validator}, nil).ValidateBasic())
+	require.NotNil(t, NewStakeAuthorization(msgType, nil, nil).ValidateBasic())
+
+	authorization := NewStakeAuthorization(msgType, []sdk.ValAddress{validator}, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)})
+	require.Nil(t, authorization.ValidateBasic())
+
+	// only the whitelisted validators are accepted
+	_, _, err := authorization.Accept(delegate(other, 10))
+	require.Equal(t, CodeUnauthorizedMsg, err.Code())
+	_, _, err = authorization.Accept(stake.NewMsgDelegate(delegator, validator, sdk.NewCoin(sdk.NativeTokenSymbol, 10)))
+	require.Equal(t, CodeUnauthorizedMsg, err.Code())
+
+	updated, remove, err := authorization.Accept(delegate(validator, 60))
+	require.Nil(t, err)
+	require.False(t, remove)
+	_, _, err = updated.Accept(delegate(validator, 50))
+	require.Equal(t, CodeUnauthorizedMsg, err.Code())
+	_, remove, err = updated.Accept(delegate(validator, 40))
+	require.Nil(t, err)
+	require.True(t, remove)
+}
+
+func TestMsgExecValidateBasic(t *testing.T) {
+	grantee := sdk.AccAddress([]byte("grantee-------------"))
+	granter := sdk.AccAddress([]byte("granter-------------"))
+	revoke := NewMsgRevoke(granter, grantee, "bank/send")
+
+	require.Nil(t, NewMsgExec(grantee, []sdk.Msg{revoke}).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, nil).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, []sdk.Msg{NewMsgExec(granter, []sdk.Msg{revoke})}).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, []sdk.Msg{sdk.NewTestMsg(granter, grantee)}).ValidateBasic())
+	require.NotNil(t, NewMsgExec(grantee, []sdk.Msg{NewMsgRevoke(granter, granter, "bank/send")}).ValidateBasic())
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 33
+
+	CodeInvalidAuthorization sdk.CodeType = 1
+	CodeNoAuthorization      sdk.CodeType = 2
+	CodeAuthorizationExpired sdk.CodeType = 3
+	CodeUnauthorizedMsg      sdk.CodeType = 4
+	CodeAuthzNotEnabled      sdk.CodeType = 5
+	CodeInvalidExecMsg       sdk.CodeType = 6
+)
+
+func ErrInvalidAuthorization(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidAuthorization, msg)
+}
+
+func ErrNoAuthorization(granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoAuthorization,
+		fmt.Sprintf("no authorization for %s granted by %s to %s", msgType, granter, grantee))
+}
+
+func ErrAuthorizationExpired() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAuthorizationExpired, "authorization expired")
+}
+
+func ErrUnauthorizedMsg(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeUnauthorizedMsg, msg)
+}
+
+func ErrAuthzNotEnabled() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAuthzNotEnabled, "authz is not enabled yet")
+}
+
+func ErrInvalidExecMsg(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidExecMsg, msg)
+}
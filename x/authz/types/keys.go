@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey   = "authz"
+	RouteAuthz = "authz"
+
+	MaxExecMsgs          = 16
+	MaxAllowedValidators = 32
+)
+
+var (
+	GrantKeyPrefix = []byte{0x01} // prefix for each key to an authorization grant
+)
+
+func GetGrantsKey(granter, grantee sdk.AccAddress) []byte {
+	key := append(GrantKeyPrefix, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+func GetGrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGrantsKey(granter, grantee), []byte(msgType)...)
+}
+
+// MsgTypeURL identifies the msg type across the modules, e.g. bank/send
+func MsgTypeURL(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
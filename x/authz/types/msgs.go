@@ -0,0 +1,196 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	GrantMsgType  = "grant"
+	RevokeMsgType = "revoke"
+	ExecMsgType   = "exec"
+)
+
+var _ sdk.Msg = MsgGrant{}
+
+// MsgGrant grants an authorization to the grantee, the former authorization of
+// the same msg type given by the granter to the grantee is replaced.
+type MsgGrant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    time.Time      `json:"expiration"`
+}
+
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) MsgGrant {
+	return MsgGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// nolint
+func (msg MsgGrant) Route() string { return RouteAuthz }
+func (msg MsgGrant) Type() string  { return GrantMsgType }
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgGrant) String() string {
+	return fmt.Sprintf("MsgGrant{%s -> %s, %v, %s}", msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+}
+
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgGrant) ValidateBasic() sdk.Error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if msg.Authorization == nil {
+		return ErrInvalidAuthorization("authorization is required")
+	}
+	if msg.Expiration.IsZero() {
+		return ErrInvalidAuthorization("expiration is required")
+	}
+	return msg.Authorization.ValidateBasic()
+}
+
+func (msg MsgGrant) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter, msg.Grantee}
+}
+
+var _ sdk.Msg = MsgRevoke{}
+
+// MsgRevoke removes the authorization of the msg type given by the granter to the grantee
+type MsgRevoke struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	MsgTypeURL string         `json:"msg_type_url"`
+}
+
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeURL: msgTypeURL,
+	}
+}
+
+// nolint
+func (msg MsgRevoke) Route() string { return RouteAuthz }
+func (msg MsgRevoke) Type() string  { return RevokeMsgType }
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgRevoke) String() string {
+	return fmt.Sprintf("MsgRevoke{%s -> %s, %s}", msg.Granter, msg.Grantee, msg.MsgTypeURL)
+}
+
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevoke) ValidateBasic() sdk.Error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if len(msg.MsgTypeURL) == 0 {
+		return ErrInvalidAuthorization("msg type can not be empty")
+	}
+	return nil
+}
+
+func (msg MsgRevoke) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter, msg.Grantee}
+}
+
+var _ sdk.Msg = MsgExec{}
+
+// MsgExec executes the msgs on behalf of their signers, who granted the grantee to execute them
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// nolint
+func (msg MsgExec) Route() string { return RouteAuthz }
+func (msg MsgExec) Type() string  { return ExecMsgType }
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+func (msg MsgExec) String() string {
+	return fmt.Sprintf("MsgExec{%s, %v}", msg.Grantee, msg.Msgs)
+}
+
+func (msg MsgExec) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+	b, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgsBytes})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if len(msg.Grantee) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid grantee address length %d", len(msg.Grantee)))
+	}
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxExecMsgs {
+		return ErrInvalidExecMsg(fmt.Sprintf("the number of msgs should be between 1 and %d", MaxExecMsgs))
+	}
+	for _, m := range msg.Msgs {
+		if _, ok := m.(MsgExec); ok {
+			return ErrInvalidExecMsg("exec msgs can not be nested")
+		}
+		if len(m.GetSigners()) != 1 {
+			return ErrInvalidExecMsg(fmt.Sprintf("msg %s should have exactly one signer", MsgTypeURL(m)))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgExec) GetInvolvedAddresses() []sdk.AccAddress {
+	addrs := []sdk.AccAddress{msg.Grantee}
+	for _, m := range msg.Msgs {
+		addrs = append(addrs, m.GetInvolvedAddresses()...)
+	}
+	return addrs
+}
+
+func validateGranterGrantee(granter, grantee sdk.AccAddress) sdk.Error {
+	if len(granter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid granter address length %d", len(granter)))
+	}
+	if len(grantee) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid grantee address length %d", len(grantee)))
+	}
+	if granter.Equals(grantee) {
+		return ErrInvalidAuthorization("granter and grantee can not be the same account")
+	}
+	return nil
+}
@@ -7,6 +7,9 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/Send", nil)
+	cdc.RegisterConcrete(MsgSetTransferHooks{}, "cosmos-sdk/SetTransferHooks", nil)
+	cdc.RegisterConcrete(MsgSetAccountFlag{}, "cosmos-sdk/SetAccountFlag", nil)
+	cdc.RegisterConcrete(MsgCreateVestingAccount{}, "cosmos-sdk/CreateVestingAccount", nil)
 }
 
 var msgCdc = codec.New()
@@ -0,0 +1,61 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var denomOwnerKey = []byte{0x03} // prefix for the owners of the denoms registered by the issuers
+
+func getDenomOwnerKey(denom string) []byte {
+	return append(append([]byte{}, denomOwnerKey...), []byte(denom)...)
+}
+
+// DenomOwner is the owner of a denom
+type DenomOwner struct {
+	Denom string         `json:"denom"`
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// DenomOwnerRegistry is the DenomOwners kept in the store, the module issuing the tokens registers their owners
+type DenomOwnerRegistry struct {
+	storeKey sdk.StoreKey
+}
+
+var _ DenomOwners = DenomOwnerRegistry{}
+
+// NewDenomOwnerRegistry creates the registry, it can share the store of the transfer hooks
+func NewDenomOwnerRegistry(storeKey sdk.StoreKey) DenomOwnerRegistry {
+	return DenomOwnerRegistry{storeKey: storeKey}
+}
+
+// GetDenomOwner returns the registered owner of the denom
+func (r DenomOwnerRegistry) GetDenomOwner(ctx sdk.Context, denom string) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(r.storeKey).Get(getDenomOwnerKey(denom))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetDenomOwner registers the owner of the denom, the owner is removed if the address is empty
+func (r DenomOwnerRegistry) SetDenomOwner(ctx sdk.Context, denom string, owner sdk.AccAddress) {
+	store := ctx.KVStore(r.storeKey)
+	if len(owner) == 0 {
+		store.Delete(getDenomOwnerKey(denom))
+		return
+	}
+	store.Set(getDenomOwnerKey(denom), owner.Bytes())
+}
+
+// IterateDenomOwners iterates through the registered owners in the order of the denoms
+func (r DenomOwnerRegistry) IterateDenomOwners(ctx sdk.Context, fn func(owner DenomOwner) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(r.storeKey), denomOwnerKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		owner := DenomOwner{Denom: string(iterator.Key()[len(denomOwnerKey):]), Owner: sdk.AccAddress(iterator.Value())}
+		if fn(owner) {
+			break
+		}
+	}
+}
@@ -2,6 +2,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,8 +11,14 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 2
 
-	CodeInvalidInput  sdk.CodeType = 101
-	CodeInvalidOutput sdk.CodeType = 102
+	CodeInvalidInput            sdk.CodeType = 101
+	CodeInvalidOutput           sdk.CodeType = 102
+	CodeTransferRejected        sdk.CodeType = 103
+	CodeUnknownTransferHook     sdk.CodeType = 104
+	CodeTransferHooksNotEnabled sdk.CodeType = 105
+	CodeVestingNotEnabled       sdk.CodeType = 106
+	CodeInvalidVestingSchedule  sdk.CodeType = 107
+	CodeAccountExists           sdk.CodeType = 108
 )
 
 // NOTE: Don't stringer this, we'll put better messages in later.
@@ -20,6 +28,18 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid input coins"
 	case CodeInvalidOutput:
 		return "invalid output coins"
+	case CodeTransferRejected:
+		return "transfer rejected by the hooks of the denom"
+	case CodeUnknownTransferHook:
+		return "unknown transfer hook"
+	case CodeTransferHooksNotEnabled:
+		return "transfer hooks are not enabled"
+	case CodeVestingNotEnabled:
+		return "vesting accounts are not enabled"
+	case CodeInvalidVestingSchedule:
+		return "invalid vesting schedule"
+	case CodeAccountExists:
+		return "account already exists"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -44,6 +64,30 @@ func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidOutput, "")
 }
 
+func ErrTransferRejected(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeTransferRejected, msg)
+}
+
+func ErrUnknownTransferHook(codespace sdk.CodespaceType, name string) sdk.Error {
+	return newError(codespace, CodeUnknownTransferHook, fmt.Sprintf("unknown transfer hook %s", name))
+}
+
+func ErrTransferHooksNotEnabled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeTransferHooksNotEnabled, "")
+}
+
+func ErrVestingNotEnabled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeVestingNotEnabled, "")
+}
+
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidVestingSchedule, msg)
+}
+
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return newError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
+
 //----------------------------------------
 
 func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
@@ -0,0 +1,47 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the owners of the denoms which can enable transfer hooks
+type GenesisState struct {
+	DenomOwners []DenomOwner `json:"denom_owners"`
+}
+
+// ValidateGenesis checks the denom owners are valid and unique
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.DenomOwners))
+	for _, owner := range data.DenomOwners {
+		if err := validateDenom(owner.Denom); err != nil {
+			return err
+		}
+		if len(owner.Owner) != sdk.AddrLen {
+			return fmt.Errorf("invalid owner %s of denom %s", owner.Owner, owner.Denom)
+		}
+		if seen[owner.Denom] {
+			return fmt.Errorf("duplicate owner of denom %s", owner.Denom)
+		}
+		seen[owner.Denom] = true
+	}
+	return nil
+}
+
+// InitGenesis registers the denom owners
+func InitGenesis(ctx sdk.Context, registry DenomOwnerRegistry, data GenesisState) {
+	for _, owner := range data.DenomOwners {
+		registry.SetDenomOwner(ctx, owner.Denom, owner.Owner)
+	}
+}
+
+// ExportGenesis returns the registered denom owners
+func ExportGenesis(ctx sdk.Context, registry DenomOwnerRegistry) GenesisState {
+	var owners []DenomOwner
+	registry.IterateDenomOwners(ctx, func(owner DenomOwner) bool {
+		owners = append(owners, owner)
+		return false
+	})
+	return GenesisState{DenomOwners: owners}
+}
@@ -12,6 +12,12 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSend:
 			return handleMsgSend(ctx, k, msg)
+		case MsgSetTransferHooks:
+			return handleMsgSetTransferHooks(ctx, k, msg)
+		case MsgSetAccountFlag:
+			return handleMsgSetAccountFlag(ctx, k, msg)
+		case MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -50,3 +56,40 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 		Tags: tags,
 	}
 }
+
+func handleMsgSetTransferHooks(ctx sdk.Context, k Keeper, msg MsgSetTransferHooks) sdk.Result {
+	hooks := k.GetTransferHooks()
+	if hooks == nil || !sdk.IsUpgrade(sdk.TransferHooks) {
+		return ErrTransferHooksNotEnabled(DefaultCodespace).Result()
+	}
+	if err := hooks.CheckOwner(ctx, msg.Denom, msg.From); err != nil {
+		return err.Result()
+	}
+	if err := hooks.SetDenomHooks(ctx, msg.Denom, msg.Hooks); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleMsgSetAccountFlag(ctx sdk.Context, k Keeper, msg MsgSetAccountFlag) sdk.Result {
+	hooks := k.GetTransferHooks()
+	if hooks == nil || !sdk.IsUpgrade(sdk.TransferHooks) {
+		return ErrTransferHooksNotEnabled(DefaultCodespace).Result()
+	}
+	if err := hooks.CheckOwner(ctx, msg.Denom, msg.From); err != nil {
+		return err.Result()
+	}
+	hooks.SetAccountFlag(ctx, msg.Denom, msg.Flag, msg.Address, msg.Value)
+	return sdk.Result{}
+}
+
+func handleMsgCreateVestingAccount(ctx sdk.Context, k Keeper, msg MsgCreateVestingAccount) sdk.Result {
+	if !sdk.IsUpgrade(sdk.VestingAccount) {
+		return ErrVestingNotEnabled(DefaultCodespace).Result()
+	}
+	tags, err := k.CreateVestingAccount(ctx, msg.From, msg.To, msg.Amount, msg.StartTime, msg.EndTime, msg.Delayed)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: tags}
+}
@@ -3,12 +3,15 @@ package bank
 import (
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
@@ -122,3 +125,152 @@ func createSendMsg(from sdk.AccAddress, to sdk.AccAddress, coins sdk.Coins) sdk.
 	msg := NewMsgSend([]Input{input}, []Output{output})
 	return msg
 }
+
+type testDenomOwners map[string]sdk.AccAddress
+
+func (o testDenomOwners) GetDenomOwner(_ sdk.Context, denom string) (sdk.AccAddress, bool) {
+	owner, ok := o[denom]
+	return owner, ok
+}
+
+func TestHandleTransferHooks(t *testing.T) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bank")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{}, sdk.RunTxModeDeliver, log.NewNopLogger()).
+		WithAccountCache(getAccountCache(cdc, ms, authKey))
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	hooks := NewTransferHooks(bankKey, testDenomOwners{"XYZ-000": owner})
+	bankKeeper := NewBaseKeeper(accountKeeper).WithTransferHooks(hooks)
+	handler := NewHandler(bankKeeper)
+
+	for _, a := range []sdk.AccAddress{owner, addr, addr2} {
+		acc := accountKeeper.NewAccountWithAddress(ctx, a)
+		acc.SetCoins(sdk.Coins{sdk.NewCoin("BNB", 100), sdk.NewCoin("XYZ-000", 100)})
+		accountKeeper.SetAccount(ctx, acc)
+	}
+	xyz := sdk.Coins{sdk.NewCoin("XYZ-000", 10)}
+	bnb := sdk.Coins{sdk.NewCoin("BNB", 10)}
+	send := func(from, to sdk.AccAddress, coins sdk.Coins) sdk.Result {
+		return handler(ctx, NewMsgSend([]Input{NewInput(from, coins)}, []Output{NewOutput(to, coins)}))
+	}
+
+	// the hooks can not be enabled before the upgrade
+	res := handler(ctx, NewMsgSetTransferHooks(owner, "XYZ-000", []string{FreezeHook}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeTransferHooksNotEnabled), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 0)
+	sdk.UpgradeMgr.SetHeight(10)
+
+	res = handler(ctx, NewMsgSetTransferHooks(addr, "XYZ-000", []string{FreezeHook}))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+	res = handler(ctx, NewMsgSetTransferHooks(owner, "ABC-000", []string{FreezeHook}))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidCoins), res.Code)
+	res = handler(ctx, NewMsgSetTransferHooks(owner, "XYZ-000", []string{"unknown"}))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnknownTransferHook), res.Code)
+	res = handler(ctx, NewMsgSetTransferHooks(owner, "XYZ-000", []string{FreezeHook}))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []string{FreezeHook}, hooks.GetDenomHooks(ctx, "XYZ-000"))
+
+	// the frozen account can neither send nor receive the denom, the other denoms are not affected
+	res = handler(ctx, NewMsgSetAccountFlag(owner, "XYZ-000", FrozenFlag, addr, true))
+	require.True(t, res.IsOK(), res.Log)
+	res = send(addr, addr2, xyz)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeTransferRejected), res.Code)
+	res = send(addr2, addr, xyz)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeTransferRejected), res.Code)
+	_, err := bankKeeper.SendCoins(ctx, addr2, addr, xyz)
+	require.NotNil(t, err)
+	require.True(t, send(addr, addr2, bnb).IsOK())
+	require.Equal(t, int64(100), accountKeeper.GetAccount(ctx, addr).GetCoins().AmountOf("XYZ-000"))
+
+	res = handler(ctx, NewMsgSetAccountFlag(owner, "XYZ-000", FrozenFlag, addr, false))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, send(addr, addr2, xyz).IsOK())
+
+	// only the whitelisted accounts can transfer the denom
+	res = handler(ctx, NewMsgSetTransferHooks(owner, "XYZ-000", []string{FreezeHook, WhitelistHook}))
+	require.True(t, res.IsOK(), res.Log)
+	res = handler(ctx, NewMsgSetAccountFlag(owner, "XYZ-000", WhitelistedFlag, addr, true))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeTransferRejected), send(addr, addr2, xyz).Code)
+	res = handler(ctx, NewMsgSetAccountFlag(owner, "XYZ-000", WhitelistedFlag, addr2, true))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, send(addr, addr2, xyz).IsOK())
+	require.Equal(t, int64(80), accountKeeper.GetAccount(ctx, addr).GetCoins().AmountOf("XYZ-000"))
+
+	// the denom is transferred freely once the hooks are removed
+	res = handler(ctx, NewMsgSetTransferHooks(owner, "XYZ-000", nil))
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, hooks.GetDenomHooks(ctx, "XYZ-000"))
+	require.True(t, send(owner, addr, xyz).IsOK())
+}
+
+func TestHandleCreateVestingAccount(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	now := time.Unix(1000, 0)
+	ctx := sdk.NewContext(ms, abci.Header{Time: now}, sdk.RunTxModeDeliver, log.NewNopLogger()).
+		WithAccountCache(getAccountCache(cdc, ms, authKey))
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	handler := NewHandler(NewBaseKeeper(accountKeeper))
+
+	from := sdk.AccAddress([]byte("from"))
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	acc := accountKeeper.NewAccountWithAddress(ctx, from)
+	acc.SetCoins(sdk.Coins{sdk.NewCoin("BNB", 1000)})
+	accountKeeper.SetAccount(ctx, acc)
+	coins := sdk.Coins{sdk.NewCoin("BNB", 100)}
+
+	// vesting accounts can not be created before the upgrade
+	res := handler(ctx, NewMsgCreateVestingAccount(from, addr, coins, now.Unix(), now.Unix()+100, false))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeVestingNotEnabled), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.VestingAccount, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.VestingAccount, 0)
+	sdk.UpgradeMgr.SetHeight(10)
+
+	res = handler(ctx, NewMsgCreateVestingAccount(from, addr, coins, now.Unix(), now.Unix(), false))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidVestingSchedule), res.Code)
+	res = handler(ctx, NewMsgCreateVestingAccount(from, addr, coins, now.Unix(), now.Unix()+100, false))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(900), accountKeeper.GetAccount(ctx, from).GetCoins().AmountOf("BNB"))
+	res = handler(ctx, NewMsgCreateVestingAccount(from, addr, coins, now.Unix(), now.Unix()+100, false))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeAccountExists), res.Code)
+
+	// the coins of the continuous vesting account vest linearly
+	vacc, ok := accountKeeper.GetAccount(ctx, addr).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.True(t, vacc.GetOriginalVesting().IsEqual(coins))
+	send := func(ctx sdk.Context, from sdk.AccAddress, amount int64) sdk.Result {
+		coins := sdk.Coins{sdk.NewCoin("BNB", amount)}
+		return handler(ctx, NewMsgSend([]Input{NewInput(from, coins)}, []Output{NewOutput(from, coins)}))
+	}
+	require.False(t, send(ctx, addr, 1).IsOK())
+	require.False(t, send(ctx.WithBlockTime(now.Add(50*time.Second)), addr, 51).IsOK())
+	require.True(t, send(ctx.WithBlockTime(now.Add(50*time.Second)), addr, 50).IsOK())
+
+	// the coins of the delayed vesting account are locked until the end time
+	res = handler(ctx, NewMsgCreateVestingAccount(from, addr2, coins, 0, now.Unix()+100, true))
+	require.True(t, res.IsOK(), res.Log)
+	_, ok = accountKeeper.GetAccount(ctx, addr2).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+	require.False(t, send(ctx.WithBlockTime(now.Add(99*time.Second)), addr2, 1).IsOK())
+	require.True(t, send(ctx.WithBlockTime(now.Add(100*time.Second)), addr2, 100).IsOK())
+}
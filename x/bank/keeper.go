@@ -15,6 +15,13 @@ type Keeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
 	GetAccountKeeper() auth.AccountKeeper
+	GetTransferHooks() *TransferHooks
+
+	DelegateCoins(ctx sdk.Context, delegatorAddr sdk.AccAddress, delegationAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	UndelegateCoins(ctx sdk.Context, delegationAddr sdk.AccAddress, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins,
+		startTime, endTime int64, delayed bool) (sdk.Tags, sdk.Error)
 }
 
 var _ Keeper = (*BaseKeeper)(nil)
@@ -22,7 +29,8 @@ var _ Keeper = (*BaseKeeper)(nil)
 // BaseKeeper manages transfers between accounts. It implements the Keeper
 // interface.
 type BaseKeeper struct {
-	am auth.AccountKeeper
+	am    auth.AccountKeeper
+	hooks *TransferHooks
 }
 
 // NewBaseKeeper returns a new BaseKeeper
@@ -37,7 +45,11 @@ func (keeper BaseKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coin
 
 // SetCoins sets the coins at the addr.
 func (keeper BaseKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return setCoins(ctx, keeper.am, addr, amt)
+	inputs, outputs := balanceChanges(addr, getCoins(ctx, keeper.am, addr), amt)
+	_, err := runWithHooks(ctx, keeper.hooks, inputs, outputs, func() (sdk.Tags, sdk.Error) {
+		return nil, setCoins(ctx, keeper.am, addr, amt)
+	})
+	return err
 }
 
 // HasCoins returns whether or not an account has at least amt coins.
@@ -50,19 +62,46 @@ func (keeper BaseKeeper) SubtractCoins(
 	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Coins, sdk.Tags, sdk.Error) {
 
-	return subtractCoins(ctx, keeper.am, addr, amt)
+	var newCoins sdk.Coins
+	tags, err := runWithHooks(ctx, keeper.hooks, []Input{NewInput(addr, amt)}, nil, func() (tags sdk.Tags, err sdk.Error) {
+		newCoins, tags, err = subtractCoins(ctx, keeper.am, addr, amt)
+		return tags, err
+	})
+	if err != nil {
+		return amt, nil, err
+	}
+	return newCoins, tags, nil
 }
 
 func (keeper BaseKeeper) GetAccountKeeper() auth.AccountKeeper {
 	return keeper.am
 }
 
+// WithTransferHooks returns a copy of the keeper which runs the transfer hooks of the denoms
+func (keeper BaseKeeper) WithTransferHooks(hooks *TransferHooks) BaseKeeper {
+	keeper.hooks = hooks
+	return keeper
+}
+
+// GetTransferHooks returns the transfer hooks registry, it is nil if the keeper runs no hooks
+func (keeper BaseKeeper) GetTransferHooks() *TransferHooks {
+	return keeper.hooks
+}
+
 // AddCoins adds amt to the coins at the addr.
 func (keeper BaseKeeper) AddCoins(
 	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Coins, sdk.Tags, sdk.Error) {
 
-	return addCoins(ctx, keeper.am, addr, amt)
+	var newCoins sdk.Coins
+	tags, err := runWithHooks(ctx, keeper.hooks, nil, []Output{NewOutput(addr, amt)}, func() (tags sdk.Tags, err sdk.Error) {
+		newCoins, tags, err = addCoins(ctx, keeper.am, addr, amt)
+		return tags, err
+	})
+	if err != nil {
+		return amt, nil, err
+	}
+	return newCoins, tags, nil
 }
 
 // SendCoins moves coins from one account to another
@@ -70,12 +109,72 @@ func (keeper BaseKeeper) SendCoins(
 	ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Tags, sdk.Error) {
 
-	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
+	return sendCoinsWithHooks(ctx, keeper.am, keeper.hooks, fromAddr, toAddr, amt)
 }
 
 // InputOutputCoins handles a list of inputs and outputs
 func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
-	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
+	return inputOutputCoinsWithHooks(ctx, keeper.am, keeper.hooks, inputs, outputs)
+}
+
+// DelegateCoins moves coins from the delegator to the delegation account.
+// Locked coins of vesting accounts can be delegated as well.
+func (keeper BaseKeeper) DelegateCoins(
+	ctx sdk.Context, delegatorAddr sdk.AccAddress, delegationAddr sdk.AccAddress, amt sdk.Coins,
+) sdk.Error {
+
+	_, err := runWithHooks(ctx, keeper.hooks, []Input{NewInput(delegatorAddr, amt)}, []Output{NewOutput(delegationAddr, amt)},
+		func() (sdk.Tags, sdk.Error) {
+			return nil, delegateCoins(ctx, keeper.am, delegatorAddr, delegationAddr, amt)
+		})
+	return err
+}
+
+// UndelegateCoins moves coins from the delegation account back to the delegator.
+// The coins delegated from the locked balance of vesting accounts are locked again.
+func (keeper BaseKeeper) UndelegateCoins(
+	ctx sdk.Context, delegationAddr sdk.AccAddress, delegatorAddr sdk.AccAddress, amt sdk.Coins,
+) sdk.Error {
+
+	_, err := runWithHooks(ctx, keeper.hooks, []Input{NewInput(delegationAddr, amt)}, []Output{NewOutput(delegatorAddr, amt)},
+		func() (sdk.Tags, sdk.Error) {
+			return nil, undelegateCoins(ctx, keeper.am, delegationAddr, delegatorAddr, amt)
+		})
+	return err
+}
+
+// CreateVestingAccount moves coins to a new vesting account, the moved coins are locked until they vest.
+// NOTE: Make sure to revert state changes from tx on error
+func (keeper BaseKeeper) CreateVestingAccount(
+	ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins, startTime, endTime int64, delayed bool,
+) (sdk.Tags, sdk.Error) {
+
+	if keeper.am.GetAccount(ctx, toAddr) != nil {
+		return nil, ErrAccountExists(DefaultCodespace, toAddr)
+	}
+	var vacc auth.VestingAccount
+	baseAcc := &auth.BaseAccount{Address: toAddr, Coins: amt}
+	if delayed {
+		vacc = auth.NewDelayedVestingAccount(baseAcc, endTime)
+	} else {
+		cva, err := auth.NewContinuousVestingAccount(baseAcc, startTime, endTime)
+		if err != nil {
+			return nil, ErrInvalidVestingSchedule(DefaultCodespace, err.Error())
+		}
+		vacc = cva
+	}
+
+	tags, err := keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	// the account created by the transfer is replaced by the vesting account with the same account number
+	acc := keeper.am.GetAccount(ctx, toAddr)
+	if err := vacc.SetAccountNumber(acc.GetAccountNumber()); err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	keeper.am.SetAccount(ctx, vacc)
+	return tags, nil
 }
 
 //______________________________________________________________________________________________
@@ -93,7 +192,8 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 // SendKeeper only allows transfers between accounts without the possibility of
 // creating coins. It implements the SendKeeper interface.
 type BaseSendKeeper struct {
-	am auth.AccountKeeper
+	am    auth.AccountKeeper
+	hooks *TransferHooks
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
@@ -101,6 +201,12 @@ func NewBaseSendKeeper(am auth.AccountKeeper) BaseSendKeeper {
 	return BaseSendKeeper{am: am}
 }
 
+// WithTransferHooks returns a copy of the keeper which runs the transfer hooks of the denoms
+func (keeper BaseSendKeeper) WithTransferHooks(hooks *TransferHooks) BaseSendKeeper {
+	keeper.hooks = hooks
+	return keeper
+}
+
 // GetCoins returns the coins at the addr.
 func (keeper BaseSendKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return getCoins(ctx, keeper.am, addr)
@@ -116,7 +222,7 @@ func (keeper BaseSendKeeper) SendCoins(
 	ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins,
 ) (sdk.Tags, sdk.Error) {
 
-	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
+	return sendCoinsWithHooks(ctx, keeper.am, keeper.hooks, fromAddr, toAddr, amt)
 }
 
 // InputOutputCoins handles a list of inputs and outputs
@@ -124,7 +230,7 @@ func (keeper BaseSendKeeper) InputOutputCoins(
 	ctx sdk.Context, inputs []Input, outputs []Output,
 ) (sdk.Tags, sdk.Error) {
 
-	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
+	return inputOutputCoinsWithHooks(ctx, keeper.am, keeper.hooks, inputs, outputs)
 }
 
 //______________________________________________________________________________________________
@@ -173,12 +279,14 @@ func setCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 	if acc == nil {
 		acc = am.NewAccountWithAddress(ctx, addr)
 	}
+	before := acc.GetCoins()
 	err := acc.SetCoins(amt)
 	if err != nil {
 		// Handle w/ #870
 		panic(err)
 	}
 	am.SetAccount(ctx, acc)
+	sdk.RecordBalanceChange(ctx, addr, before, acc.GetCoins(), sdk.BalanceChangeTransfer)
 	return nil
 }
 
@@ -189,11 +297,19 @@ func hasCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 
 // SubtractCoins subtracts amt from the coins at the addr.
 func subtractCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
-	oldCoins := getCoins(ctx, am, addr)
-	newCoins := oldCoins.Minus(amt)
-	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	oldCoins, spendableCoins := sdk.Coins{}, sdk.Coins{}
+	acc := am.GetAccount(ctx, addr)
+	if acc != nil {
+		oldCoins = acc.GetCoins()
+		spendableCoins = auth.SpendableCoins(acc, ctx.BlockHeader().Time)
+	}
+
+	// for non-vesting accounts the spendable coins are simply the coins of the account,
+	// the locked coins of vesting accounts can not be subtracted.
+	if !spendableCoins.Minus(amt).IsNotNegative() {
+		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", spendableCoins, amt))
 	}
+	newCoins := oldCoins.Minus(amt)
 	err := setCoins(ctx, am, addr, newCoins)
 	tags := sdk.NewTags("sender", []byte(addr.String()))
 	return newCoins, tags, err
@@ -214,6 +330,9 @@ func addCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt s
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
 func sendCoins(ctx sdk.Context, am auth.AccountKeeper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	if fromAddr.Equals(sdk.PegAccount) || toAddr.Equals(sdk.PegAccount) {
+		ctx = withPegReason(ctx)
+	}
 	_, subTags, err := subtractCoins(ctx, am, fromAddr, amt)
 	if err != nil {
 		return nil, err
@@ -231,6 +350,16 @@ func sendCoins(ctx sdk.Context, am auth.AccountKeeper, fromAddr sdk.AccAddress,
 // NOTE: Make sure to revert state changes from tx on error
 func inputOutputCoins(ctx sdk.Context, am auth.AccountKeeper, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
 	allTags := sdk.EmptyTags()
+	for _, in := range inputs {
+		if in.Address.Equals(sdk.PegAccount) {
+			ctx = withPegReason(ctx)
+		}
+	}
+	for _, out := range outputs {
+		if out.Address.Equals(sdk.PegAccount) {
+			ctx = withPegReason(ctx)
+		}
+	}
 
 	for _, in := range inputs {
 		_, tags, err := subtractCoins(ctx, am, in.Address, in.Coins)
@@ -250,3 +379,117 @@ func inputOutputCoins(ctx sdk.Context, am auth.AccountKeeper, inputs []Input, ou
 
 	return allTags, nil
 }
+
+// sendCoinsWithHooks is sendCoins run by the transfer hooks of the denoms
+func sendCoinsWithHooks(ctx sdk.Context, am auth.AccountKeeper, hooks *TransferHooks, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	return runWithHooks(ctx, hooks, []Input{NewInput(fromAddr, amt)}, []Output{NewOutput(toAddr, amt)}, func() (sdk.Tags, sdk.Error) {
+		return sendCoins(ctx, am, fromAddr, toAddr, amt)
+	})
+}
+
+// inputOutputCoinsWithHooks is inputOutputCoins run by the transfer hooks of the denoms
+func inputOutputCoinsWithHooks(ctx sdk.Context, am auth.AccountKeeper, hooks *TransferHooks, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
+	return runWithHooks(ctx, hooks, inputs, outputs, func() (sdk.Tags, sdk.Error) {
+		return inputOutputCoins(ctx, am, inputs, outputs)
+	})
+}
+
+// runWithHooks runs the balance mutation between the transfer hooks of the denoms it moves, the inputs and the
+// outputs describe the mutation and do not need to balance, e.g. the coins minted to an account have no input.
+func runWithHooks(ctx sdk.Context, hooks *TransferHooks, inputs []Input, outputs []Output, mutate func() (sdk.Tags, sdk.Error)) (sdk.Tags, sdk.Error) {
+	if hooks == nil || !sdk.IsUpgrade(sdk.TransferHooks) {
+		return mutate()
+	}
+	transfers := splitTransfers(inputs, outputs)
+	if err := hooks.beforeTransfer(ctx, transfers); err != nil {
+		return nil, err
+	}
+	tags, err := mutate()
+	if err != nil {
+		return nil, err
+	}
+	hooks.afterTransfer(ctx, transfers)
+	return tags, nil
+}
+
+// balanceChanges describes setting the coins of the address as the decreased coins sent and the increased coins received
+func balanceChanges(addr sdk.AccAddress, oldCoins, newCoins sdk.Coins) (inputs []Input, outputs []Output) {
+	var sent, received sdk.Coins
+	for _, coin := range newCoins.Minus(oldCoins) {
+		if coin.Amount > 0 {
+			received = append(received, coin)
+		} else if coin.Amount < 0 {
+			sent = append(sent, sdk.NewCoin(coin.Denom, -coin.Amount))
+		}
+	}
+	if len(sent) > 0 {
+		inputs = []Input{NewInput(addr, sent)}
+	}
+	if len(received) > 0 {
+		outputs = []Output{NewOutput(addr, received)}
+	}
+	return inputs, outputs
+}
+
+// delegateCoins moves coins from the delegator to the delegation account, the delegation
+// of vesting accounts is tracked so that the locked coins can be locked again when undelegated.
+// NOTE: Make sure to revert state changes from tx on error
+func delegateCoins(ctx sdk.Context, am auth.AccountKeeper, delegatorAddr, delegationAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if !amt.IsNotNegative() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	delegatorAcc := am.GetAccount(ctx, delegatorAddr)
+	if delegatorAcc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", delegatorAddr))
+	}
+	oldCoins := delegatorAcc.GetCoins()
+	if !oldCoins.IsGTE(amt) {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	}
+
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.GetBalanceChangeReason(ctx, sdk.BalanceChangeStake))
+	if vacc, ok := delegatorAcc.(auth.VestingAccount); ok && amt.IsPositive() {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	} else if err := delegatorAcc.SetCoins(oldCoins.Minus(amt)); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	am.SetAccount(ctx, delegatorAcc)
+	sdk.RecordBalanceChange(ctx, delegatorAddr, oldCoins, delegatorAcc.GetCoins(), sdk.BalanceChangeStake)
+
+	_, _, err := addCoins(ctx, am, delegationAddr, amt)
+	return err
+}
+
+// undelegateCoins moves coins from the delegation account back to the delegator
+// NOTE: Make sure to revert state changes from tx on error
+func undelegateCoins(ctx sdk.Context, am auth.AccountKeeper, delegationAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if !amt.IsNotNegative() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.GetBalanceChangeReason(ctx, sdk.BalanceChangeStake))
+	if _, _, err := subtractCoins(ctx, am, delegationAddr, amt); err != nil {
+		return err
+	}
+
+	delegatorAcc := am.GetAccount(ctx, delegatorAddr)
+	if delegatorAcc == nil {
+		delegatorAcc = am.NewAccountWithAddress(ctx, delegatorAddr)
+	}
+	oldCoins := delegatorAcc.GetCoins()
+	if vacc, ok := delegatorAcc.(auth.VestingAccount); ok && amt.IsPositive() {
+		vacc.TrackUndelegation(amt)
+	} else if err := delegatorAcc.SetCoins(delegatorAcc.GetCoins().Plus(amt)); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	am.SetAccount(ctx, delegatorAcc)
+	sdk.RecordBalanceChange(ctx, delegatorAddr, oldCoins, delegatorAcc.GetCoins(), sdk.BalanceChangeStake)
+	return nil
+}
+
+// withPegReason records the balance changes of the transfers from or to the peg account as peg
+// unless the caller set a reason
+func withPegReason(ctx sdk.Context) sdk.Context {
+	return sdk.WithBalanceChangeReason(ctx, sdk.GetBalanceChangeReason(ctx, sdk.BalanceChangePeg))
+}
@@ -2,6 +2,7 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -214,3 +215,155 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5)}))
 }
+
+func TestVestingAccountKeeper(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	accountCache := getAccountCache(cdc, ms, authKey)
+
+	now := time.Unix(1000, 0)
+	ctx := sdk.NewContext(ms, abci.Header{Time: now}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	bankKeeper := NewBaseKeeper(accountKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	delegationAddr := sdk.AccAddress([]byte("delegation"))
+
+	baseAcc := accountKeeper.NewAccountWithAddress(ctx, addr).(*auth.BaseAccount)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("foocoin", 100)})
+	vacc, err := auth.NewContinuousVestingAccount(baseAcc, now.Unix(), now.Add(100*time.Second).Unix())
+	require.NoError(t, err)
+	accountKeeper.SetAccount(ctx, vacc)
+
+	// locked coins can not be sent
+	_, err2 := bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 1)})
+	require.NotNil(t, err2)
+
+	// half of the coins are vested
+	ctx = ctx.WithBlockTime(now.Add(50 * time.Second))
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 51)})
+	require.NotNil(t, err2)
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	require.Nil(t, err2)
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 90)}))
+
+	// the locked coins can be delegated
+	err2 = bankKeeper.DelegateCoins(ctx, addr, delegationAddr, sdk.Coins{sdk.NewCoin("foocoin", 80)})
+	require.Nil(t, err2)
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10)}))
+	require.True(t, bankKeeper.GetCoins(ctx, delegationAddr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 80)}))
+	acc := accountKeeper.GetAccount(ctx, addr).(auth.VestingAccount)
+	require.True(t, acc.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 50)}))
+	require.True(t, acc.GetDelegatedFree().IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 30)}))
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	require.Nil(t, err2)
+
+	// the undelegated locked coins go back to the locked balance
+	err2 = bankKeeper.UndelegateCoins(ctx, delegationAddr, addr, sdk.Coins{sdk.NewCoin("foocoin", 80)})
+	require.Nil(t, err2)
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 80)}))
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 31)})
+	require.NotNil(t, err2)
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 30)})
+	require.Nil(t, err2)
+
+	// all the coins are spendable once vested
+	ctx = ctx.WithBlockTime(now.Add(100 * time.Second))
+	_, err2 = bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
+	require.Nil(t, err2)
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 100)}))
+}
+
+func TestBalanceJournal(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	accountCache := getAccountCache(cdc, ms, authKey)
+
+	journal := &sdk.BalanceJournal{}
+	ctx := sdk.NewContext(ms, abci.Header{}, sdk.RunTxModeDeliver, log.NewNopLogger()).WithAccountCache(accountCache)
+	ctx = sdk.WithBalanceJournal(ctx, journal)
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+	bankKeeper := NewBaseKeeper(accountKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	bankKeeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 100)})
+	bankKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	bankKeeper.SendCoins(ctx, addr, sdk.PegAccount, sdk.Coins{sdk.NewCoin("foocoin", 20)})
+	bankKeeper.AddCoins(sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward), addr2, sdk.Coins{sdk.NewCoin("foocoin", 5)})
+
+	require.Equal(t, []sdk.BalanceChange{
+		{Address: addr, Denom: "foocoin", Before: 0, After: 100, Reason: sdk.BalanceChangeTransfer},
+		{Address: addr, Denom: "foocoin", Before: 100, After: 90, Reason: sdk.BalanceChangeTransfer},
+		{Address: addr2, Denom: "foocoin", Before: 0, After: 10, Reason: sdk.BalanceChangeTransfer},
+		{Address: addr, Denom: "foocoin", Before: 90, After: 70, Reason: sdk.BalanceChangePeg},
+		{Address: sdk.PegAccount, Denom: "foocoin", Before: 0, After: 20, Reason: sdk.BalanceChangePeg},
+		{Address: addr2, Denom: "foocoin", Before: 10, After: 15, Reason: sdk.BalanceChangeReward},
+	}, journal.Changes())
+}
+
+func TestKeeperTransferHooks(t *testing.T) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bank")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{}, sdk.RunTxModeDeliver, log.NewNopLogger()).
+		WithAccountCache(getAccountCache(cdc, ms, authKey))
+	accountKeeper := auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	addr := sdk.AccAddress([]byte("addr1"))
+	delegationAddr := sdk.AccAddress([]byte("delegation"))
+	owners := NewDenomOwnerRegistry(bankKey)
+	InitGenesis(ctx, owners, GenesisState{DenomOwners: []DenomOwner{{Denom: "XYZ-000", Owner: owner}}})
+	require.Equal(t, []DenomOwner{{Denom: "XYZ-000", Owner: owner}}, ExportGenesis(ctx, owners).DenomOwners)
+	hooks := NewTransferHooks(bankKey, owners)
+	bankKeeper := NewBaseKeeper(accountKeeper).WithTransferHooks(hooks)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 0)
+	sdk.UpgradeMgr.SetHeight(10)
+
+	xyz := sdk.Coins{sdk.NewCoin("XYZ-000", 10)}
+	_, _, err := bankKeeper.AddCoins(ctx, addr, xyz.Plus(xyz))
+	require.Nil(t, err)
+	require.Nil(t, hooks.CheckOwner(ctx, "XYZ-000", owner))
+	require.Nil(t, hooks.SetDenomHooks(ctx, "XYZ-000", []string{FreezeHook}))
+	hooks.SetAccountFlag(ctx, "XYZ-000", FrozenFlag, addr, true)
+
+	// every mutation of the balance of the frozen account is rejected
+	_, _, err = bankKeeper.AddCoins(ctx, addr, xyz)
+	require.Equal(t, CodeTransferRejected, err.Code())
+	_, _, err = bankKeeper.SubtractCoins(ctx, addr, xyz)
+	require.Equal(t, CodeTransferRejected, err.Code())
+	err = bankKeeper.SetCoins(ctx, addr, xyz)
+	require.Equal(t, CodeTransferRejected, err.Code())
+	err = bankKeeper.DelegateCoins(ctx, addr, delegationAddr, xyz)
+	require.Equal(t, CodeTransferRejected, err.Code())
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(xyz.Plus(xyz)))
+
+	// the balance of the other denoms can still change, and the setting is not rejected if the denom is unchanged
+	bnb := sdk.Coins{sdk.NewCoin("BNB", 10)}
+	_, _, err = bankKeeper.AddCoins(ctx, addr, bnb)
+	require.Nil(t, err)
+	require.Nil(t, bankKeeper.SetCoins(ctx, addr, xyz.Plus(xyz)))
+
+	hooks.SetAccountFlag(ctx, "XYZ-000", FrozenFlag, addr, false)
+	require.Nil(t, bankKeeper.DelegateCoins(ctx, addr, delegationAddr, xyz))
+	hooks.SetAccountFlag(ctx, "XYZ-000", FrozenFlag, addr, true)
+	err = bankKeeper.UndelegateCoins(ctx, delegationAddr, addr, xyz)
+	require.Equal(t, CodeTransferRejected, err.Code())
+	require.True(t, bankKeeper.GetCoins(ctx, delegationAddr).IsEqual(xyz))
+}
@@ -0,0 +1,118 @@
+package bank
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSetTransferHooks - the owner of a denom replaces the transfer hooks enabled by the denom
+type MsgSetTransferHooks struct {
+	From  sdk.AccAddress `json:"from"`
+	Denom string         `json:"denom"`
+	Hooks []string       `json:"hooks"`
+}
+
+var _ sdk.Msg = MsgSetTransferHooks{}
+
+func NewMsgSetTransferHooks(from sdk.AccAddress, denom string, hooks []string) MsgSetTransferHooks {
+	return MsgSetTransferHooks{From: from, Denom: denom, Hooks: hooks}
+}
+
+// nolint
+func (msg MsgSetTransferHooks) Route() string                { return "bank" }
+func (msg MsgSetTransferHooks) Type() string                 { return "setTransferHooks" }
+func (msg MsgSetTransferHooks) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.From} }
+func (msg MsgSetTransferHooks) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+func (msg MsgSetTransferHooks) ValidateBasic() sdk.Error {
+	if len(msg.From) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if err := validateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.Hooks) > MaxDenomHooks {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("a denom can enable at most %d transfer hooks", MaxDenomHooks))
+	}
+	seen := make(map[string]bool, len(msg.Hooks))
+	for _, hook := range msg.Hooks {
+		if err := validateName(hook, MaxHookNameLen); err != nil {
+			return sdk.ErrUnknownRequest(err.Error())
+		}
+		if seen[hook] {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("duplicate transfer hook %s", hook))
+		}
+		seen[hook] = true
+	}
+	return nil
+}
+
+func (msg MsgSetTransferHooks) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// MsgSetAccountFlag - the owner of a denom sets or clears a flag read by the transfer hooks on an account,
+// e.g. FrozenFlag or WhitelistedFlag
+type MsgSetAccountFlag struct {
+	From    sdk.AccAddress `json:"from"`
+	Denom   string         `json:"denom"`
+	Flag    string         `json:"flag"`
+	Address sdk.AccAddress `json:"address"`
+	Value   bool           `json:"value"`
+}
+
+var _ sdk.Msg = MsgSetAccountFlag{}
+
+func NewMsgSetAccountFlag(from sdk.AccAddress, denom, flag string, addr sdk.AccAddress, value bool) MsgSetAccountFlag {
+	return MsgSetAccountFlag{From: from, Denom: denom, Flag: flag, Address: addr, Value: value}
+}
+
+// nolint
+func (msg MsgSetAccountFlag) Route() string                { return "bank" }
+func (msg MsgSetAccountFlag) Type() string                 { return "setAccountFlag" }
+func (msg MsgSetAccountFlag) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.From} }
+func (msg MsgSetAccountFlag) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From, msg.Address}
+}
+
+func (msg MsgSetAccountFlag) ValidateBasic() sdk.Error {
+	if len(msg.From) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if len(msg.Address) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Address.String())
+	}
+	if err := validateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := validateName(msg.Flag, MaxFlagNameLen); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetAccountFlag) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func validateDenom(denom string) sdk.Error {
+	if len(denom) == 0 || len(denom) > MaxDenomLen {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid denom %q", denom))
+	}
+	if denom == sdk.NativeTokenSymbol {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("transfer hooks can not be enabled for %s", denom))
+	}
+	return nil
+}
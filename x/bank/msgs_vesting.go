@@ -0,0 +1,63 @@
+package bank
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgCreateVestingAccount - moves coins to a new vesting account, the coins are locked until they vest.
+// The coins vest continuously from the start time to the end time, or all at the end time if delayed.
+type MsgCreateVestingAccount struct {
+	From      sdk.AccAddress `json:"from"`
+	To        sdk.AccAddress `json:"to"`
+	Amount    sdk.Coins      `json:"amount"`
+	StartTime int64          `json:"start_time"`
+	EndTime   int64          `json:"end_time"`
+	Delayed   bool           `json:"delayed"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+func NewMsgCreateVestingAccount(from, to sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, delayed bool) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{From: from, To: to, Amount: amount, StartTime: startTime, EndTime: endTime, Delayed: delayed}
+}
+
+// nolint
+func (msg MsgCreateVestingAccount) Route() string                { return "bank" }
+func (msg MsgCreateVestingAccount) Type() string                 { return "createVestingAccount" }
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.From} }
+func (msg MsgCreateVestingAccount) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From, msg.To}
+}
+
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if len(msg.From) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if len(msg.To) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.To.String())
+	}
+	if msg.From.Equals(msg.To) {
+		return sdk.ErrInvalidAddress("vesting account can not be created by itself")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVestingSchedule(DefaultCodespace, fmt.Sprintf("invalid end time %d", msg.EndTime))
+	}
+	if !msg.Delayed && msg.EndTime <= msg.StartTime {
+		return ErrInvalidVestingSchedule(DefaultCodespace, "vesting end time must be after the start time")
+	}
+	return nil
+}
+
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
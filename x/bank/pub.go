@@ -0,0 +1,29 @@
+package bank
+
+import (
+	"github.com/cosmos/cosmos-sdk/pubsub"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const BalanceJournalTopic = pubsub.Topic("balance-journal")
+
+// BalanceJournalEvent carries the balance changes of a block, per tx in the order they were made
+type BalanceJournalEvent struct {
+	Height int64
+	Txs    []sdk.TxBalanceChanges
+}
+
+func (event BalanceJournalEvent) GetTopic() pubsub.Topic {
+	return BalanceJournalTopic
+}
+
+// PublishBalanceJournal publishes the balance changes collected in the pool, it should be called after
+// EndBlock and before Commit clears the pool
+func PublishBalanceJournal(server *pubsub.Server, height int64, pool *sdk.Pool) {
+	if server == nil {
+		return
+	}
+	if txs := pool.BalanceChanges(); len(txs) != 0 {
+		server.Publish(BalanceJournalEvent{Height: height, Txs: txs})
+	}
+}
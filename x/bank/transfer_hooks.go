@@ -0,0 +1,288 @@
+package bank
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the built-in transfer hooks
+const (
+	FreezeHook    = "freeze"    // rejects the transfers of the accounts flagged as frozen
+	WhitelistHook = "whitelist" // rejects the transfers of the accounts not flagged as whitelisted
+
+	FrozenFlag      = "frozen"
+	WhitelistedFlag = "whitelisted"
+
+	MaxDenomHooks  = 8
+	MaxHookNameLen = 32
+	MaxFlagNameLen = 32
+	MaxDenomLen    = 255
+)
+
+var (
+	denomHooksKey  = []byte{0x01} // prefix for the hooks enabled by the denoms
+	accountFlagKey = []byte{0x02} // prefix for the flags set on the accounts by the owners of the denoms
+)
+
+func getDenomHooksKey(denom string) []byte {
+	return append(denomHooksKey, []byte(denom)...)
+}
+
+func getAccountFlagKey(denom, flag string, addr sdk.AccAddress) []byte {
+	key := append([]byte{}, accountFlagKey...)
+	key = append(key, byte(len(denom)))
+	key = append(key, []byte(denom)...)
+	key = append(key, byte(len(flag)))
+	key = append(key, []byte(flag)...)
+	return append(key, addr.Bytes()...)
+}
+
+// DenomOwners resolves the owners of the denoms, only the owner of a denom can manage its transfer hooks.
+// DenomOwnerRegistry implements it with the owners registered by the module issuing the tokens.
+type DenomOwners interface {
+	GetDenomOwner(ctx sdk.Context, denom string) (owner sdk.AccAddress, found bool)
+}
+
+// TransferAmount is the amount of a denom sent or received by an account in a transfer
+type TransferAmount struct {
+	Address sdk.AccAddress
+	Amount  int64
+}
+
+// Transfer is the part of a transfer which moves one denom
+type Transfer struct {
+	Denom     string
+	Senders   []TransferAmount
+	Receivers []TransferAmount
+}
+
+// Accounts returns the senders and the receivers of the transfer
+func (t Transfer) Accounts() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, 0, len(t.Senders)+len(t.Receivers))
+	for _, sender := range t.Senders {
+		addrs = append(addrs, sender.Address)
+	}
+	for _, receiver := range t.Receivers {
+		addrs = append(addrs, receiver.Address)
+	}
+	return addrs
+}
+
+// AccountFlags reads the flags the owner of a denom set on the accounts
+type AccountFlags struct {
+	store sdk.KVStore
+	denom string
+}
+
+// Has returns true if the flag is set on the account
+func (f AccountFlags) Has(addr sdk.AccAddress, flag string) bool {
+	return f.store.Has(getAccountFlagKey(f.denom, flag, addr))
+}
+
+// TransferHook is run on the transfers of the denoms which enable it. The hooks must only depend on the
+// transfer and the state, they are part of the consensus.
+type TransferHook interface {
+	// BeforeTransfer is run before the coins move, the transfer is rejected if an error is returned
+	BeforeTransfer(ctx sdk.Context, flags AccountFlags, transfer Transfer) sdk.Error
+	// AfterTransfer is run after the coins moved
+	AfterTransfer(ctx sdk.Context, flags AccountFlags, transfer Transfer)
+}
+
+// TransferHooks is the registry of the transfer hooks. The hooks are implemented in code and registered by
+// name, the owners of the denoms enable them for their denoms on chain.
+type TransferHooks struct {
+	storeKey sdk.StoreKey
+	owners   DenomOwners
+	hooks    map[string]TransferHook
+}
+
+// NewTransferHooks creates the registry with the built-in hooks, the owners can be nil if no module issues
+// tokens, the hooks can not be enabled then.
+func NewTransferHooks(storeKey sdk.StoreKey, owners DenomOwners) *TransferHooks {
+	h := &TransferHooks{
+		storeKey: storeKey,
+		owners:   owners,
+		hooks:    make(map[string]TransferHook),
+	}
+	h.Register(FreezeHook, freezeHook{})
+	h.Register(WhitelistHook, whitelistHook{})
+	return h
+}
+
+// Register adds a hook to the registry, it panics if the name is taken
+func (h *TransferHooks) Register(name string, hook TransferHook) {
+	if err := validateName(name, MaxHookNameLen); err != nil {
+		panic(err)
+	}
+	if _, ok := h.hooks[name]; ok {
+		panic(fmt.Sprintf("transfer hook %s is already registered", name))
+	}
+	h.hooks[name] = hook
+}
+
+// IsRegistered returns true if the hook is registered
+func (h *TransferHooks) IsRegistered(name string) bool {
+	_, ok := h.hooks[name]
+	return ok
+}
+
+// CheckOwner returns an error if the address is not the owner of the denom
+func (h *TransferHooks) CheckOwner(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Error {
+	if h.owners == nil {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the owner of %s can not be resolved", denom))
+	}
+	owner, found := h.owners.GetDenomOwner(ctx, denom)
+	if !found {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("unknown denom %s", denom))
+	}
+	if !owner.Equals(addr) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the owner of %s", addr, denom))
+	}
+	return nil
+}
+
+// GetDenomHooks returns the names of the hooks enabled by the denom, in the order they run
+func (h *TransferHooks) GetDenomHooks(ctx sdk.Context, denom string) []string {
+	bz := ctx.KVStore(h.storeKey).Get(getDenomHooksKey(denom))
+	if bz == nil {
+		return nil
+	}
+	var names []string
+	msgCdc.MustUnmarshalBinaryLengthPrefixed(bz, &names)
+	return names
+}
+
+// SetDenomHooks replaces the hooks enabled by the denom, no hook is run for the denom if the names are empty
+func (h *TransferHooks) SetDenomHooks(ctx sdk.Context, denom string, names []string) sdk.Error {
+	for _, name := range names {
+		if !h.IsRegistered(name) {
+			return ErrUnknownTransferHook(DefaultCodespace, name)
+		}
+	}
+	store := ctx.KVStore(h.storeKey)
+	if len(names) == 0 {
+		store.Delete(getDenomHooksKey(denom))
+		return nil
+	}
+	store.Set(getDenomHooksKey(denom), msgCdc.MustMarshalBinaryLengthPrefixed(names))
+	return nil
+}
+
+// SetAccountFlag sets or clears the flag on the account for the denom
+func (h *TransferHooks) SetAccountFlag(ctx sdk.Context, denom, flag string, addr sdk.AccAddress, value bool) {
+	store := ctx.KVStore(h.storeKey)
+	if value {
+		store.Set(getAccountFlagKey(denom, flag, addr), []byte{1})
+	} else {
+		store.Delete(getAccountFlagKey(denom, flag, addr))
+	}
+}
+
+// GetAccountFlags returns the reader of the flags set on the accounts for the denom
+func (h *TransferHooks) GetAccountFlags(ctx sdk.Context, denom string) AccountFlags {
+	return AccountFlags{store: ctx.KVStore(h.storeKey), denom: denom}
+}
+
+// beforeTransfer runs the hooks of the denoms in the transfer, the denoms are visited in order
+func (h *TransferHooks) beforeTransfer(ctx sdk.Context, transfers []Transfer) sdk.Error {
+	for _, transfer := range transfers {
+		for _, name := range h.GetDenomHooks(ctx, transfer.Denom) {
+			hook, ok := h.hooks[name]
+			if !ok {
+				return ErrUnknownTransferHook(DefaultCodespace, name)
+			}
+			if err := hook.BeforeTransfer(ctx, h.GetAccountFlags(ctx, transfer.Denom), transfer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (h *TransferHooks) afterTransfer(ctx sdk.Context, transfers []Transfer) {
+	for _, transfer := range transfers {
+		for _, name := range h.GetDenomHooks(ctx, transfer.Denom) {
+			if hook, ok := h.hooks[name]; ok {
+				hook.AfterTransfer(ctx, h.GetAccountFlags(ctx, transfer.Denom), transfer)
+			}
+		}
+	}
+}
+
+// splitTransfers splits the inputs and the outputs by denom, sorted by denom
+func splitTransfers(inputs []Input, outputs []Output) []Transfer {
+	byDenom := make(map[string]*Transfer)
+	get := func(denom string) *Transfer {
+		if t, ok := byDenom[denom]; ok {
+			return t
+		}
+		t := &Transfer{Denom: denom}
+		byDenom[denom] = t
+		return t
+	}
+	for _, in := range inputs {
+		for _, coin := range in.Coins {
+			t := get(coin.Denom)
+			t.Senders = append(t.Senders, TransferAmount{Address: in.Address, Amount: coin.Amount})
+		}
+	}
+	for _, out := range outputs {
+		for _, coin := range out.Coins {
+			t := get(coin.Denom)
+			t.Receivers = append(t.Receivers, TransferAmount{Address: out.Address, Amount: coin.Amount})
+		}
+	}
+
+	transfers := make([]Transfer, 0, len(byDenom))
+	for _, t := range byDenom {
+		transfers = append(transfers, *t)
+	}
+	sort.Slice(transfers, func(i, j int) bool {
+		return transfers[i].Denom < transfers[j].Denom
+	})
+	return transfers
+}
+
+func validateName(name string, maxLen int) error {
+	if len(name) == 0 || len(name) > maxLen {
+		return fmt.Errorf("the length of name %q should be between 1 and %d", name, maxLen)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return fmt.Errorf("name %q contains invalid characters", name)
+		}
+	}
+	return nil
+}
+
+//______________________________________________________________________________________________
+
+type freezeHook struct{}
+
+func (freezeHook) BeforeTransfer(_ sdk.Context, flags AccountFlags, transfer Transfer) sdk.Error {
+	for _, addr := range transfer.Accounts() {
+		if flags.Has(addr, FrozenFlag) {
+			return ErrTransferRejected(DefaultCodespace, fmt.Sprintf("%s is frozen for %s", addr, transfer.Denom))
+		}
+	}
+	return nil
+}
+
+func (freezeHook) AfterTransfer(sdk.Context, AccountFlags, Transfer) {}
+
+// whitelistHook only lets the whitelisted accounts send and receive the denom, the owner has to whitelist
+// its own accounts as well
+type whitelistHook struct{}
+
+func (whitelistHook) BeforeTransfer(_ sdk.Context, flags AccountFlags, transfer Transfer) sdk.Error {
+	for _, addr := range transfer.Accounts() {
+		if !flags.Has(addr, WhitelistedFlag) {
+			return ErrTransferRejected(DefaultCodespace, fmt.Sprintf("%s is not whitelisted for %s", addr, transfer.Denom))
+		}
+	}
+	return nil
+}
+
+func (whitelistHook) AfterTransfer(sdk.Context, AccountFlags, Transfer) {}
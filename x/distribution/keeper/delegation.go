@@ -98,7 +98,7 @@ func (k Keeper) WithdrawDelegationReward(ctx sdk.Context, delegatorAddr sdk.AccA
 	coinsToAdd, change := withdraw.TruncateDecimal()
 	feePool.CommunityPool = feePool.CommunityPool.Plus(change)
 	k.SetFeePool(ctx, feePool)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coinsToAdd)
+	_, _, err := k.bankKeeper.AddCoins(sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward), withdrawAddr, coinsToAdd)
 	if err != nil {
 		panic(err)
 	}
@@ -116,7 +116,7 @@ func (k Keeper) WithdrawDelegationRewardsAll(ctx sdk.Context, delegatorAddr sdk.
 	coinsToAdd, change := withdraw.TruncateDecimal()
 	feePool.CommunityPool = feePool.CommunityPool.Plus(change)
 	k.SetFeePool(ctx, feePool)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coinsToAdd)
+	_, _, err := k.bankKeeper.AddCoins(sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward), withdrawAddr, coinsToAdd)
 	if err != nil {
 		panic(err)
 	}
@@ -67,7 +67,7 @@ func (k Keeper) WithdrawValidatorRewardsAll(ctx sdk.Context, operatorAddr sdk.Va
 	truncated, change := withdraw.TruncateDecimal()
 	feePool.CommunityPool = feePool.CommunityPool.Plus(change)
 	k.SetFeePool(ctx, feePool)
-	_, _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, truncated)
+	_, _, err := k.bankKeeper.AddCoins(sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward), withdrawAddr, truncated)
 	if err != nil {
 		panic(err)
 	}
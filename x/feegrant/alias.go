@@ -0,0 +1,37 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	RouteFeeGrant    = types.RouteFeeGrant
+	DefaultCodespace = types.DefaultCodespace
+)
+
+var (
+	// functions aliases
+	NewKeeper = keeper.NewKeeper
+
+	NewFeeAllowance          = types.NewFeeAllowance
+	NewMsgGrantFeeAllowance  = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance = types.NewMsgRevokeFeeAllowance
+
+	ErrInvalidAllowance   = types.ErrInvalidAllowance
+	ErrNoAllowance        = types.ErrNoAllowance
+	ErrAllowanceExpired   = types.ErrAllowanceExpired
+	ErrFeeLimitExceeded   = types.ErrFeeLimitExceeded
+	ErrMsgTypeNotAllowed  = types.ErrMsgTypeNotAllowed
+	ErrFeeGrantNotEnabled = types.ErrFeeGrantNotEnabled
+)
+
+type (
+	Keeper       = keeper.Keeper
+	FeeAllowance = types.FeeAllowance
+	FeeGrant     = types.FeeGrant
+
+	MsgGrantFeeAllowance  = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance = types.MsgRevokeFeeAllowance
+)
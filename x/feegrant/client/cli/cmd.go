@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagGranter         = "granter"
+	flagGrantee         = "grantee"
+	flagSpendLimit      = "spend-limit"
+	flagExpiration      = "expiration"
+	flagAllowedMsgTypes = "allowed-msg-types"
+)
+
+func AddCommands(root *cobra.Command, cdc *codec.Codec) {
+	feeGrantCmd := &cobra.Command{
+		Use:   "feegrant",
+		Short: "fee allowances granted to other accounts",
+	}
+
+	feeGrantCmd.AddCommand(
+		client.PostCommands(
+			GetCmdGrantFeeAllowance(cdc),
+			GetCmdRevokeFeeAllowance(cdc),
+		)...)
+
+	feeGrantCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryFeeAllowance(cdc),
+			GetCmdQueryFeeAllowances(cdc),
+		)...)
+
+	root.AddCommand(feeGrantCmd)
+}
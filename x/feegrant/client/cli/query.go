@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdQueryFeeAllowance implements the command to query the fee allowance given by a granter to a grantee.
+func GetCmdQueryFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-allowance",
+		Short: "query the fee allowance given by a granter to a grantee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(viper.GetString(flagGranter))
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(feegrant.QueryAllowanceParams{Granter: granter, Grantee: grantee})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", feegrant.StoreKey, feegrant.QueryAllowance), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGranter, "", "address of the granter")
+	cmd.Flags().String(flagGrantee, "", "address of the grantee")
+	cmd.MarkFlagRequired(flagGranter)
+	cmd.MarkFlagRequired(flagGrantee)
+	return cmd
+}
+
+// GetCmdQueryFeeAllowances implements the command to query the fee allowances given to a grantee.
+func GetCmdQueryFeeAllowances(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-allowances",
+		Short: "query the fee allowances given to a grantee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(feegrant.QueryAllowancesParams{Grantee: grantee})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", feegrant.StoreKey, feegrant.QueryAllowances), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "address of the grantee")
+	cmd.MarkFlagRequired(flagGrantee)
+	return cmd
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdGrantFeeAllowance implements the command to grant a fee allowance to another account.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "grant an account to have its fees paid by the granter",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(flagExpiration))
+			if err != nil {
+				return fmt.Errorf("invalid expiration, it should be in RFC3339 format: %v", err)
+			}
+
+			allowance := feegrant.NewFeeAllowance(spendLimit, expiration, viper.GetStringSlice(flagAllowedMsgTypes))
+			msg := feegrant.NewMsgGrantFeeAllowance(granter, grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "address of the account whose fees are paid")
+	cmd.Flags().String(flagSpendLimit, "", "the fees paid at most, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "expiration time of the allowance in RFC3339 format")
+	cmd.Flags().StringSlice(flagAllowedMsgTypes, nil, "the msg types whose fees are paid, all msg types if empty")
+	cmd.MarkFlagRequired(flagGrantee)
+	cmd.MarkFlagRequired(flagExpiration)
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance implements the command to revoke a fee allowance.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "revoke the fee allowance granted to an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeFeeAllowance(granter, grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagGrantee, "", "address of the account whose fee allowance is revoked")
+	cmd.MarkFlagRequired(flagGrantee)
+	return cmd
+}
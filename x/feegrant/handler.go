@@ -0,0 +1,40 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if !sdk.IsUpgrade(sdk.FeeGrant) {
+			return types.ErrFeeGrantNotEnabled().Result()
+		}
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, keeper, msg)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized feegrant msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, keeper Keeper, msg MsgGrantFeeAllowance) sdk.Result {
+	if msg.Allowance.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrAllowanceExpired().Result()
+	}
+	keeper.GrantFeeAllowance(ctx, msg.Granter, msg.Grantee, msg.Allowance)
+	return sdk.Result{}
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, keeper Keeper, msg MsgRevokeFeeAllowance) sdk.Result {
+	if err := keeper.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper stores the fee allowances and consumes them when the granters pay the fees of the grantees
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates new instances of the fee grant Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// GrantFeeAllowance sets the fee allowance given by the granter to the grantee, the former one is replaced
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowance types.FeeAllowance) {
+	grant := types.FeeGrant{Granter: granter, Grantee: grantee, Allowance: allowance}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeAllowanceKey(granter, grantee), k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+}
+
+// RevokeFeeAllowance removes the fee allowance given by the granter to the grantee
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return types.ErrNoAllowance(granter, grantee)
+	}
+	store.Delete(key)
+	return nil
+}
+
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateFeeGrants iterates the fee allowances given to the grantee until the callback returns true
+func (k Keeper) IterateFeeGrants(ctx sdk.Context, grantee sdk.AccAddress, cb func(grant types.FeeGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetFeeAllowancesKey(grantee))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees checks the fees of the msgs are covered by the fee allowance given by the granter to the
+// grantee and consumes the spend limit of the allowance. The allowance is removed once it is used up or expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return types.ErrNoAllowance(granter, grantee)
+	}
+	allowance := grant.Allowance
+	if allowance.IsExpired(ctx.BlockHeader().Time) {
+		// the removal is reverted with the failed tx, the expired allowance is left to be revoked by the granter
+		return types.ErrAllowanceExpired()
+	}
+	for _, msg := range msgs {
+		if !allowance.IsMsgTypeAllowed(msg.Type()) {
+			return types.ErrMsgTypeNotAllowed(msg.Type())
+		}
+	}
+
+	if len(allowance.SpendLimit) != 0 {
+		if !allowance.SpendLimit.IsGTE(fee) {
+			return types.ErrFeeLimitExceeded(allowance.SpendLimit, fee)
+		}
+		allowance.SpendLimit = allowance.SpendLimit.Minus(fee)
+		if allowance.SpendLimit.IsZero() {
+			k.RevokeFeeAllowance(ctx, granter, grantee)
+			return nil
+		}
+	}
+	k.GrantFeeAllowance(ctx, granter, grantee, allowance)
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, sdk.RunTxModeDeliver, log.NewNopLogger())
+	return ctx, NewKeeper(codec.New(), key)
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	granter := sdk.AccAddress([]byte("granter-------------"))
+	grantee := sdk.AccAddress([]byte("grantee-------------"))
+	other := sdk.AccAddress([]byte("other---------------"))
+	msgs := []sdk.Msg{sdk.NewTestMsg(grantee)}
+	fee := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}
+	expiration := ctx.BlockHeader().Time.Add(time.Hour)
+
+	require.Equal(t, types.CodeNoAllowance, keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs).Code())
+
+	// the msg types are restricted
+	keeper.GrantFeeAllowance(ctx, granter, grantee, types.NewFeeAllowance(nil, expiration, []string{"send"}))
+	require.Equal(t, types.CodeMsgTypeNotAllowed, keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs).Code())
+
+	// the spend limit is consumed and the allowance is removed once used up
+	spendLimit := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 150)}
+	keeper.GrantFeeAllowance(ctx, granter, grantee, types.NewFeeAllowance(spendLimit, expiration, nil))
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs))
+	grant, found := keeper.GetFeeGrant(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 50)}, grant.Allowance.SpendLimit)
+	require.Equal(t, types.CodeFeeLimitExceeded, keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs).Code())
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter, grantee, grant.Allowance.SpendLimit, msgs))
+	_, found = keeper.GetFeeGrant(ctx, granter, grantee)
+	require.False(t, found)
+
+	// the allowance can not be used from the expiration on
+	keeper.GrantFeeAllowance(ctx, granter, grantee, types.NewFeeAllowance(nil, expiration, nil))
+	keeper.GrantFeeAllowance(ctx, other, grantee, types.NewFeeAllowance(nil, expiration, nil))
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs))
+	expiredCtx := ctx.WithBlockHeader(abci.Header{Time: expiration})
+	require.Equal(t, types.CodeAllowanceExpired, keeper.UseGrantedFees(expiredCtx, granter, grantee, fee, msgs).Code())
+
+	var grants []types.FeeGrant
+	keeper.IterateFeeGrants(ctx, grantee, func(grant types.FeeGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	require.Nil(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	require.Equal(t, types.CodeNoAllowance, keeper.RevokeFeeAllowance(ctx, granter, grantee).Code())
+}
@@ -0,0 +1,71 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryAllowance  = "allowance"
+	QueryAllowances = "allowances"
+)
+
+type QueryAllowanceParams struct {
+	Granter sdk.AccAddress
+	Grantee sdk.AccAddress
+}
+
+type QueryAllowancesParams struct {
+	Grantee sdk.AccAddress
+}
+
+// creates a querier for the fee grant REST endpoints
+func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryAllowance:
+			return queryAllowance(ctx, k, cdc, req)
+		case QueryAllowances:
+			return queryAllowances(ctx, k, cdc, req)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown feegrant query endpoint")
+		}
+	}
+}
+
+func queryAllowance(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAllowanceParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grant, found := k.GetFeeGrant(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, types.ErrNoAllowance(params.Granter, params.Grantee)
+	}
+	return marshalJSON(cdc, grant)
+}
+
+func queryAllowances(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAllowancesParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := make([]FeeGrant, 0)
+	k.IterateFeeGrants(ctx, params.Grantee, func(grant FeeGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return marshalJSON(cdc, grants)
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,12 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func Routes(keeper Keeper) map[string]sdk.Handler {
+	routes := make(map[string]sdk.Handler)
+	routes[types.RouteFeeGrant] = NewHandler(keeper)
+	return routes
+}
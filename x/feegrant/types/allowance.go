@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance limits the fees a granter pays for a grantee
+type FeeAllowance struct {
+	SpendLimit      sdk.Coins `json:"spend_limit"`       // the remaining fees the granter pays, unlimited if empty
+	Expiration      time.Time `json:"expiration"`        // the allowance can not be used from the expiration on
+	AllowedMsgTypes []string  `json:"allowed_msg_types"` // the msg types whose fees are paid, all msg types if empty
+}
+
+func NewFeeAllowance(spendLimit sdk.Coins, expiration time.Time, allowedMsgTypes []string) FeeAllowance {
+	return FeeAllowance{
+		SpendLimit:      spendLimit,
+		Expiration:      expiration,
+		AllowedMsgTypes: allowedMsgTypes,
+	}
+}
+
+func (a FeeAllowance) ValidateBasic() sdk.Error {
+	if len(a.SpendLimit) != 0 && (!a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive()) {
+		return ErrInvalidAllowance(fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	if a.Expiration.IsZero() {
+		return ErrInvalidAllowance("expiration is required")
+	}
+	if len(a.AllowedMsgTypes) > MaxAllowedMsgTypes {
+		return ErrInvalidAllowance(fmt.Sprintf("at most %d msg types can be allowed", MaxAllowedMsgTypes))
+	}
+	for _, msgType := range a.AllowedMsgTypes {
+		if len(msgType) == 0 {
+			return ErrInvalidAllowance("allowed msg type can not be empty")
+		}
+	}
+	return nil
+}
+
+func (a FeeAllowance) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Expiration)
+}
+
+func (a FeeAllowance) IsMsgTypeAllowed(msgType string) bool {
+	if len(a.AllowedMsgTypes) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedMsgTypes {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+func (a FeeAllowance) String() string {
+	return fmt.Sprintf(`SpendLimit: %s
+Expiration: %s
+AllowedMsgTypes: %v`, a.SpendLimit, a.Expiration, a.AllowedMsgTypes)
+}
+
+// FeeGrant is the fee allowance given by a granter to a grantee
+type FeeGrant struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+func (g FeeGrant) String() string {
+	return fmt.Sprintf(`Granter: %s
+Grantee: %s
+%s`, g.Granter, g.Grantee, g.Allowance)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 32
+
+	CodeInvalidAllowance   sdk.CodeType = 1
+	CodeNoAllowance        sdk.CodeType = 2
+	CodeAllowanceExpired   sdk.CodeType = 3
+	CodeFeeLimitExceeded   sdk.CodeType = 4
+	CodeMsgTypeNotAllowed  sdk.CodeType = 5
+	CodeFeeGrantNotEnabled sdk.CodeType = 6
+)
+
+func ErrInvalidAllowance(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidAllowance, msg)
+}
+
+func ErrNoAllowance(granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoAllowance,
+		fmt.Sprintf("no fee allowance granted by %s to %s", granter, grantee))
+}
+
+func ErrAllowanceExpired() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAllowanceExpired, "fee allowance expired")
+}
+
+func ErrFeeLimitExceeded(limit, fee sdk.Coins) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeFeeLimitExceeded,
+		fmt.Sprintf("fee %s exceeds the remaining spend limit %s", fee, limit))
+}
+
+func ErrMsgTypeNotAllowed(msgType string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMsgTypeNotAllowed,
+		fmt.Sprintf("fees of msg type %s are not covered by the fee allowance", msgType))
+}
+
+func ErrFeeGrantNotEnabled() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeFeeGrantNotEnabled, "fee grant is not enabled yet")
+}
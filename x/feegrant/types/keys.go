@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey      = "feegrant"
+	RouteFeeGrant = "feegrant"
+
+	MaxAllowedMsgTypes = 32
+)
+
+var (
+	FeeAllowanceKeyPrefix = []byte{0x01} // prefix for each key to a fee allowance
+)
+
+// the fee allowances are indexed by grantee so that the allowances of a grantee can be iterated
+func GetFeeAllowancesKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
+
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesKey(grantee), granter.Bytes()...)
+}
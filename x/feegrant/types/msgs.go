@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	GrantFeeAllowanceMsgType  = "grant_fee_allowance"
+	RevokeFeeAllowanceMsgType = "revoke_fee_allowance"
+)
+
+var _ sdk.Msg = MsgGrantFeeAllowance{}
+
+// MsgGrantFeeAllowance grants a fee allowance to the grantee, the former
+// allowance given by the granter to the grantee is replaced.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// nolint
+func (msg MsgGrantFeeAllowance) Route() string { return RouteFeeGrant }
+func (msg MsgGrantFeeAllowance) Type() string  { return GrantFeeAllowanceMsgType }
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgGrantFeeAllowance) String() string {
+	return fmt.Sprintf("MsgGrantFeeAllowance{%s -> %s, %v}", msg.Granter, msg.Grantee, msg.Allowance)
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	return msg.Allowance.ValidateBasic()
+}
+
+func (msg MsgGrantFeeAllowance) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter, msg.Grantee}
+}
+
+var _ sdk.Msg = MsgRevokeFeeAllowance{}
+
+// MsgRevokeFeeAllowance removes the fee allowance given by the granter to the grantee
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// nolint
+func (msg MsgRevokeFeeAllowance) Route() string { return RouteFeeGrant }
+func (msg MsgRevokeFeeAllowance) Type() string  { return RevokeFeeAllowanceMsgType }
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgRevokeFeeAllowance) String() string {
+	return fmt.Sprintf("MsgRevokeFeeAllowance{%s -> %s}", msg.Granter, msg.Grantee)
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	return validateGranterGrantee(msg.Granter, msg.Grantee)
+}
+
+func (msg MsgRevokeFeeAllowance) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter, msg.Grantee}
+}
+
+func validateGranterGrantee(granter, grantee sdk.AccAddress) sdk.Error {
+	if len(granter) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid granter address length %d", len(granter)))
+	}
+	if len(grantee) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("invalid grantee address length %d", len(grantee)))
+	}
+	if granter.Equals(grantee) {
+		return ErrInvalidAllowance("granter and grantee can not be the same account")
+	}
+	return nil
+}
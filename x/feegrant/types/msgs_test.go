@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgGrantFeeAllowanceValidateBasic(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter-------------"))
+	grantee := sdk.AccAddress([]byte("grantee-------------"))
+	expiration := time.Unix(1000, 0)
+	spendLimit := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}
+
+	tests := []struct {
+		msg     MsgGrantFeeAllowance
+		expPass bool
+	}{
+		{NewMsgGrantFeeAllowance(granter, grantee, NewFeeAllowance(spendLimit, expiration, []string{"send"})), true},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewFeeAllowance(nil, expiration, nil)), true},
+		{NewMsgGrantFeeAllowance(granter, granter, NewFeeAllowance(nil, expiration, nil)), false},
+		{NewMsgGrantFeeAllowance(nil, grantee, NewFeeAllowance(nil, expiration, nil)), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewFeeAllowance(nil, time.Time{}, nil)), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewFeeAllowance(sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 0)}, expiration, nil)), false},
+		{NewMsgGrantFeeAllowance(granter, grantee, NewFeeAllowance(nil, expiration, []string{""})), false},
+	}
+
+	for i, tc := range tests {
+		if tc.expPass {
+			require.Nil(t, tc.msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, tc.msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -24,6 +24,7 @@ func AddCommands(cmd *cobra.Command, cdc *codec.Codec) {
 			GetCmdSubmitListProposal(cdc),
 			GetCmdSubmitDelistProposal(cdc),
 			GetCmdVote(cdc),
+			GetCmdSetVotingProxy(cdc),
 		)...,
 	)
 
@@ -37,6 +38,16 @@ func AddCommands(cmd *cobra.Command, cdc *codec.Codec) {
 			GetCmdQueryDeposits(storeGov, cdc),
 			GetCmdQueryVote(storeGov, cdc),
 			GetCmdQueryVotes(storeGov, cdc),
+			GetCmdQueryVoteHistory(storeGov, cdc),
+			GetCmdQueryTimelockQueue(storeGov, cdc),
+			GetCmdQueryProposalTypeParams(storeGov, cdc),
+			GetCmdQueryIndexedProposals(storeGov, cdc),
+			GetCmdQueryArchivedVotes(storeGov, cdc),
+			GetCmdQueryTallyBreakdown(storeGov, cdc),
+			GetCmdQueryVotingProxy(storeGov, cdc),
+			GetCmdQueryProxiedDelegators(storeGov, cdc),
+			GetCmdQueryUpgradePlan(storeGov, cdc),
+			GetCmdQueryAppliedUpgradePlans(storeGov, cdc),
 		)...,
 	)
 	cmd.AddCommand(govCmd)
@@ -41,6 +41,16 @@ const (
 	flagInitPrice         = "init-price"
 	flagExpireTime        = "expire-time"
 	flagSideChainId       = "side-chain-id"
+	flagContent           = "content"
+	flagUpgradeName       = "name"
+	flagWeightedOptions   = "weighted-options"
+	flagSubmitAfter       = "submit-after"
+	flagSubmitBefore      = "submit-before"
+	flagReverse           = "reverse"
+	flagPage              = "page"
+	flagLimit             = "limit"
+	flagProxy             = "proxy"
+	flagAddress           = "address"
 )
 
 type proposal struct {
@@ -50,6 +60,8 @@ type proposal struct {
 	Type         string `json:"type"`
 	Deposit      string `json:"deposit"`
 	SideChainId  string `json:"side_chain_id, omitempty"`
+
+	Content json.RawMessage `json:"content,omitempty"`
 }
 
 var proposalFlags = []string{
@@ -58,6 +70,7 @@ var proposalFlags = []string{
 	flagVotingPeriod,
 	flagProposalType,
 	flagDeposit,
+	flagContent,
 }
 
 // GetCmdSubmitProposal implements submitting a proposal transaction command.
@@ -83,6 +96,31 @@ where proposal.json contains:
 is equivalent to
 
 $ CLI gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="1000:test" --voting-period=1000
+
+Proposals other than text proposals carry their parameters in a typed content, given by the "content" field of the
+proposal JSON file or the --content flag, for example:
+
+{
+  "type": "gov/FeeChangeContent",
+  "value": {"changes": {"fee_params": [...], "description": "fee change"}}
+}
+
+Passed proposals changing params, fees or channel permissions are queued for a timelock before execution. An
+EmergencyVeto proposal, which needs a supermajority to pass, cancels a queued proposal:
+
+{
+  "type": "gov/EmergencyVetoContent",
+  "value": {"proposal_id": "1"}
+}
+
+A GovParamsChange proposal sets the min deposit and the tally params of some proposal types, the other types use the
+global params:
+
+{
+  "type": "gov/GovParamsChangeContent",
+  "value": {"params": [{"proposal_type": "RemoveValidator", "min_deposit": [{"denom": "BNB", "amount": "500000000000"}],
+    "tally_params": {"quorum": "60000000", "threshold": "66666667", "veto": "33400000"}}]}
+}
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			proposal, err := parseSubmitProposalFlags()
@@ -135,11 +173,19 @@ $ CLI gov submit-proposal --title="Test Proposal" --description="My awesome prop
 			if err != nil {
 				return err
 			}
+			var content gov.Content
+			if len(proposal.Content) != 0 {
+				if err := cdc.UnmarshalJSON(proposal.Content, &content); err != nil {
+					return errors.Wrap(err, "invalid proposal content")
+				}
+			}
 			var msg sdk.Msg
 			if sideChainId == gov.NativeChainID {
-				msg = gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, fromAddr, amount, votingPeriod)
+				msg = gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, fromAddr, amount, votingPeriod).
+					WithContent(content)
 			} else {
-				msg = gov.NewMsgSideChainSubmitProposal(proposal.Title, proposal.Description, proposalType, fromAddr, amount, votingPeriod, sideChainId)
+				msg = gov.NewMsgSideChainSubmitProposal(proposal.Title, proposal.Description, proposalType, fromAddr, amount, votingPeriod, sideChainId).
+					WithContent(content)
 			}
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -164,6 +210,7 @@ $ CLI gov submit-proposal --title="Test Proposal" --description="My awesome prop
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 	cmd.Flags().String(flagSideChainId, gov.NativeChainID, "the id of side chain, default is native chain")
+	cmd.Flags().String(flagContent, "", "path of the JSON file of the typed proposal content")
 	return cmd
 }
 
@@ -178,6 +225,13 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Type = client.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(flagDeposit)
 		proposal.SideChainId = viper.GetString(flagSideChainId)
+		if contentFile := viper.GetString(flagContent); contentFile != "" {
+			content, err := os.ReadFile(contentFile)
+			if err != nil {
+				return nil, err
+			}
+			proposal.Content = content
+		}
 		return proposal, nil
 	}
 
@@ -260,6 +314,14 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote",
 		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(`
+Vote for an active proposal with a single option, or split the voting power across the options by weight:
+
+$ CLI gov vote --proposal-id 1 --option yes
+$ CLI gov vote --proposal-id 1 --weighted-options yes=0.6,no=0.3,abstain=0.1
+
+A vote can be changed until the voting period ends, the history of the votes is kept for audit.
+`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
 			cliCtx := context.NewCLIContext().
@@ -279,15 +341,31 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("side-chain-id exceed the max length %d", types.MaxSideChainIdLength)
 			}
 
-			byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
-			if err != nil {
-				return err
-			}
 			var msg sdk.Msg
-			if sideChainId == gov.NativeChainID {
-				msg = gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
+			if weightedOptions := viper.GetString(flagWeightedOptions); weightedOptions != "" {
+				if option != "" {
+					return fmt.Errorf("--%s and --%s can not be used together", flagOption, flagWeightedOptions)
+				}
+				options, err := gov.WeightedVoteOptionsFromString(client.NormalizeWeightedVoteOptions(weightedOptions))
+				if err != nil {
+					return err
+				}
+				option = options.String()
+				if sideChainId == gov.NativeChainID {
+					msg = gov.NewMsgWeightedVote(voterAddr, proposalID, options)
+				} else {
+					msg = gov.NewMsgSideChainWeightedVote(voterAddr, proposalID, options, sideChainId)
+				}
 			} else {
-				msg = gov.NewMsgSideChainVote(voterAddr, proposalID, byteVoteOption, sideChainId)
+				byteVoteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(option))
+				if err != nil {
+					return err
+				}
+				if sideChainId == gov.NativeChainID {
+					msg = gov.NewMsgVote(voterAddr, proposalID, byteVoteOption)
+				} else {
+					msg = gov.NewMsgSideChainVote(voterAddr, proposalID, byteVoteOption, sideChainId)
+				}
 			}
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -315,11 +393,113 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
 	cmd.Flags().String(flagOption, "", "vote option {yes, no, no_with_veto, abstain}")
+	cmd.Flags().String(flagWeightedOptions, "", "weighted vote options which split the voting power, e.g. yes=0.6,no=0.4")
 	cmd.Flags().String(flagSideChainId, gov.NativeChainID, "the id of side chain, default is native chain")
 
 	return cmd
 }
 
+// GetCmdSetVotingProxy implements the command to assign the voting power of the delegator to a proxy.
+func GetCmdSetVotingProxy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-voting-proxy",
+		Short: "Assign your governance voting power to a proxy, the proxy is removed if it is empty",
+		Long: strings.TrimSpace(`
+Assign your governance voting power to a proxy globally or for a side chain, the proxy of a side chain takes
+precedence over the global one. The proxy votes for you unless you vote yourself, your validators vote for you if
+neither of you votes:
+
+$ CLI gov set-voting-proxy --proxy bnb1...
+$ CLI gov set-voting-proxy --proxy bnb1... --side-chain-id bsc
+$ CLI gov set-voting-proxy --proxy ""
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			var proxyAddr sdk.AccAddress
+			if bechProxyAddr := viper.GetString(flagProxy); len(bechProxyAddr) != 0 {
+				proxyAddr, err = sdk.AccAddressFromBech32(bechProxyAddr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := gov.NewMsgSetVotingProxy(delegatorAddr, proxyAddr, viper.GetString(flagSideChainId))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProxy, "", "the address of the proxy, the proxy is removed if it is empty")
+	cmd.Flags().String(flagSideChainId, gov.NativeChainID, "the id of side chain, the proxy is global if it is empty")
+
+	return cmd
+}
+
+// GetCmdQueryVotingProxy implements the command to query the proxies representing an address.
+func GetCmdQueryVotingProxy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return getCmdQueryVotingProxies(queryRoute, cdc, "query-voting-proxy",
+		"Query the proxies voting for an address, the nearest first", gov.QueryVotingProxy)
+}
+
+// GetCmdQueryProxiedDelegators implements the command to query the delegators represented by a proxy.
+func GetCmdQueryProxiedDelegators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return getCmdQueryVotingProxies(queryRoute, cdc, "query-proxied-delegators",
+		"Query the delegators represented by a voting proxy", gov.QueryProxiedDelegators)
+}
+
+func getCmdQueryVotingProxies(queryRoute string, cdc *codec.Codec, use, short, path string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(viper.GetString(flagAddress))
+			if err != nil {
+				return err
+			}
+
+			params := gov.QueryVotingProxyParams{
+				BaseParams: gov.NewBaseParams(viper.GetString(flagSideChainId)),
+				Address:    addr,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, path), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "the address of the delegator or the proxy")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -480,6 +660,48 @@ func GetCmdQueryVote(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryVoteHistory implements the query vote history command.
+func GetCmdQueryVoteHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-vote-history",
+		Short: "Query the history of the votes of a voter on a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := viper.GetInt64(flagProposalID)
+			sideChainId := viper.GetString(flagSideChainId)
+
+			voterAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagVoter))
+			if err != nil {
+				return err
+			}
+
+			params := gov.QueryVoteParams{
+				BaseParams: gov.NewBaseParams(sideChainId),
+				Voter:      voterAddr,
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryVoteHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
+	cmd.Flags().String(flagVoter, "", "bech32 voter address")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
 // GetCmdQueryVotes implements the command to query for proposal votes.
 func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -627,6 +849,278 @@ func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryTimelockQueue implements the command to query the proposals waiting for the timelock.
+func GetCmdQueryTimelockQueue(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-proposals",
+		Short: "Query the passed proposals waiting for the timelock to be executed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			sideChainId := viper.GetString(flagSideChainId)
+
+			bz, err := cdc.MarshalJSON(gov.NewBaseParams(sideChainId))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryTimelockQueue), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
+// GetCmdQueryProposalTypeParams implements the command to query the params of the proposal types.
+func GetCmdQueryProposalTypeParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-type-params",
+		Short: "Query the min deposit and the tally params overridden by proposal type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			sideChainId := viper.GetString(flagSideChainId)
+
+			bz, err := cdc.MarshalJSON(gov.NewBaseParams(sideChainId))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryProposalTypeParams), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
+// GetCmdQueryIndexedProposals implements the command to query the indexed proposals with filters and pagination.
+func GetCmdQueryIndexedProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-indexed-proposals",
+		Short: "Query proposals by type, status, voter, depositor and submit time, page by page",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := gov.QueryIndexedProposalsParams{
+				BaseParams: gov.NewBaseParams(viper.GetString(flagSideChainId)),
+				PageParams: gov.PageParams{Page: viper.GetInt(flagPage), Limit: viper.GetInt(flagLimit)},
+				Reverse:    viper.GetBool(flagReverse),
+			}
+
+			if strProposalType := viper.GetString(flagProposalType); len(strProposalType) != 0 {
+				proposalType, err := gov.ProposalTypeFromString(client.NormalizeProposalType(strProposalType))
+				if err != nil {
+					return err
+				}
+				params.ProposalType = proposalType
+			}
+			if strProposalStatus := viper.GetString(flagStatus); len(strProposalStatus) != 0 {
+				proposalStatus, err := gov.ProposalStatusFromString(client.NormalizeProposalStatus(strProposalStatus))
+				if err != nil {
+					return err
+				}
+				params.Status = proposalStatus
+			}
+			if bechVoterAddr := viper.GetString(flagVoter); len(bechVoterAddr) != 0 {
+				voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+				if err != nil {
+					return err
+				}
+				params.Voter = voterAddr
+			}
+			if bechDepositerAddr := viper.GetString(flagDepositer); len(bechDepositerAddr) != 0 {
+				depositerAddr, err := sdk.AccAddressFromBech32(bechDepositerAddr)
+				if err != nil {
+					return err
+				}
+				params.Depositor = depositerAddr
+			}
+			if strSubmitAfter := viper.GetString(flagSubmitAfter); len(strSubmitAfter) != 0 {
+				submitAfter, err := time.Parse(time.RFC3339, strSubmitAfter)
+				if err != nil {
+					return err
+				}
+				params.SubmitAfter = submitAfter
+			}
+			if strSubmitBefore := viper.GetString(flagSubmitBefore); len(strSubmitBefore) != 0 {
+				submitBefore, err := time.Parse(time.RFC3339, strSubmitBefore)
+				if err != nil {
+					return err
+				}
+				params.SubmitBefore = submitBefore
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryIndexedProposals), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, "", "(optional) filter proposals by proposal type")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voter")
+	cmd.Flags().String(flagDepositer, "", "(optional) filter by proposals deposited on by depositer")
+	cmd.Flags().String(flagSubmitAfter, "", "(optional) filter by proposals submitted at or after the time, RFC3339 format")
+	cmd.Flags().String(flagSubmitBefore, "", "(optional) filter by proposals submitted before the time, RFC3339 format")
+	cmd.Flags().Bool(flagReverse, false, "list the latest proposals first")
+	cmd.Flags().Int(flagPage, 1, "the page to query, starts from 1")
+	cmd.Flags().Int(flagLimit, 30, "the number of proposals per page, max 100")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
+// GetCmdQueryArchivedVotes implements the command to query the votes kept after the tally of a proposal.
+func GetCmdQueryArchivedVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-archived-votes",
+		Short: "Query the votes of a tallied proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := gov.QueryArchivedVotesParams{
+				BaseParams: gov.NewBaseParams(viper.GetString(flagSideChainId)),
+				PageParams: gov.PageParams{Page: viper.GetInt(flagPage), Limit: viper.GetInt(flagLimit)},
+				ProposalID: viper.GetInt64(flagProposalID),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryArchivedVotes), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's votes are being queried")
+	cmd.Flags().Int(flagPage, 1, "the page to query, starts from 1")
+	cmd.Flags().Int(flagLimit, 30, "the number of votes per page, max 100")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
+// GetCmdQueryTallyBreakdown implements the command to query the votes of the validators in the final tally.
+func GetCmdQueryTallyBreakdown(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-tally-breakdown",
+		Short: "Query the votes of the validators counted in the final tally of a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proposalID := viper.GetInt64(flagProposalID)
+
+			params := gov.QueryTallyParams{
+				BaseParams: gov.NewBaseParams(viper.GetString(flagSideChainId)),
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QueryTallyBreakdown), bz)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				fmt.Printf("No tally breakdown of proposal %d\n", proposalID)
+				return nil
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's tally breakdown is being queried")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, default is native chain")
+
+	return cmd
+}
+
+// GetCmdQueryUpgradePlan implements the command to query the pending software upgrade plan.
+func GetCmdQueryUpgradePlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-plan",
+		Short: "Query the pending software upgrade plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/upgradePlan", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.New("no pending upgrade plan")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryAppliedUpgradePlans implements the command to query the applied software upgrade plans.
+func GetCmdQueryAppliedUpgradePlans(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "applied-upgrade-plans",
+		Short: "Query the applied software upgrade plans, optionally by name",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := gov.QueryAppliedUpgradePlansParams{
+				Name: viper.GetString(flagUpgradeName),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/appliedUpgradePlans", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagUpgradeName, "", "name of the upgrade")
+
+	return cmd
+}
+
 // GetCmdSubmitListProposal implements submitting a proposal transaction command.
 func GetCmdSubmitListProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +24,14 @@ const (
 	RestVoter          = "voter"
 	RestProposalStatus = "status"
 	RestNumLatest      = "latest"
+	RestProposalType   = "type"
+	RestSubmitAfter    = "submit_after"
+	RestSubmitBefore   = "submit_before"
+	RestReverse        = "reverse"
+	RestSideChainId    = "side_chain_id"
+	RestPage           = "page"
+	RestLimit          = "limit"
+	RestAddress        = "address"
 	storeName          = "gov"
 )
 
@@ -39,6 +47,12 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositer), queryDepositHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryVotesOnProposalHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter), queryVoteHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}/history", RestProposalID, RestVoter), queryVoteHistoryHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/archived_votes", RestProposalID), queryArchivedVotesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/tally_breakdown", RestProposalID), queryTallyBreakdownHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/gov/indexed/proposals", queryIndexedProposalsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/voting_proxies/{%s}", RestAddress), queryVotingProxiesHandlerFn(cdc, cliCtx, gov.QueryVotingProxy)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/voting_proxies/{%s}/delegators", RestAddress), queryVotingProxiesHandlerFn(cdc, cliCtx, gov.QueryProxiedDelegators)).Methods("GET")
 }
 
 type postProposalReq struct {
@@ -49,6 +63,7 @@ type postProposalReq struct {
 	ProposalType   string         `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	Content        gov.Content    `json:"content"`         // Typed content of the proposal, empty for text proposals
 }
 
 type depositReq struct {
@@ -59,8 +74,9 @@ type depositReq struct {
 
 type voteReq struct {
 	BaseReq utils.BaseReq  `json:"base_req"`
-	Voter   sdk.AccAddress `json:"voter"`  //  address of the voter
-	Option  string         `json:"option"` //  option from OptionSet chosen by the voter
+	Voter   sdk.AccAddress `json:"voter"`   //  address of the voter
+	Option  string         `json:"option"`  //  option from OptionSet chosen by the voter
+	Options string         `json:"options"` //  weighted options of a weighted vote, e.g. yes=0.6,no=0.4
 }
 
 func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -95,7 +111,8 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 		}
 
 		// create the message
-		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, proposalType, req.Proposer, req.InitialDeposit, votingPeriod)
+		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, proposalType, req.Proposer, req.InitialDeposit, votingPeriod).
+			WithContent(req.Content)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -172,14 +189,23 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
-		if err != nil {
-			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
 		// create the message
-		msg := gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		var msg gov.MsgVote
+		if len(req.Options) != 0 {
+			options, err := gov.WeightedVoteOptionsFromString(client.NormalizeWeightedVoteOptions(req.Options))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = gov.NewMsgWeightedVote(req.Voter, proposalID, options)
+		} else {
+			voteOption, err := gov.VoteOptionFromString(client.NormalizeVoteOption(req.Option))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			msg = gov.NewMsgVote(req.Voter, proposalID, voteOption)
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -387,6 +413,42 @@ func queryVoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 	}
 }
 
+func queryVoteHistoryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+		bechVoterAddr := vars[RestVoter]
+
+		proposalID, ok := utils.ParseInt64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := gov.QueryVoteParams{
+			Voter:      voterAddr,
+			ProposalID: proposalID,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryVoteHistory), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 // todo: Split this functionality into helper functions to remove the above
 func queryVotesOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -522,3 +584,186 @@ func queryTallyOnProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// parses the optional page and limit query parameters, zero values fall back to the defaults of the querier
+func parsePageParams(w http.ResponseWriter, r *http.Request) (params gov.PageParams, ok bool) {
+	if pageStr := r.URL.Query().Get(RestPage); pageStr != "" {
+		page, ok := utils.ParseInt64OrReturnBadRequest(w, pageStr)
+		if !ok {
+			return params, false
+		}
+		params.Page = int(page)
+	}
+	if limitStr := r.URL.Query().Get(RestLimit); limitStr != "" {
+		limit, ok := utils.ParseInt64OrReturnBadRequest(w, limitStr)
+		if !ok {
+			return params, false
+		}
+		params.Limit = int(limit)
+	}
+	return params, true
+}
+
+func queryIndexedProposalsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageParams, ok := parsePageParams(w, r)
+		if !ok {
+			return
+		}
+		params := gov.QueryIndexedProposalsParams{
+			BaseParams: gov.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			PageParams: pageParams,
+			Reverse:    r.URL.Query().Get(RestReverse) == "true",
+		}
+
+		if strProposalType := r.URL.Query().Get(RestProposalType); len(strProposalType) != 0 {
+			proposalType, err := gov.ProposalTypeFromString(client.NormalizeProposalType(strProposalType))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.ProposalType = proposalType
+		}
+		if strProposalStatus := r.URL.Query().Get(RestProposalStatus); len(strProposalStatus) != 0 {
+			proposalStatus, err := gov.ProposalStatusFromString(client.NormalizeProposalStatus(strProposalStatus))
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Status = proposalStatus
+		}
+		if bechVoterAddr := r.URL.Query().Get(RestVoter); len(bechVoterAddr) != 0 {
+			voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Voter = voterAddr
+		}
+		if bechDepositorAddr := r.URL.Query().Get(RestDepositer); len(bechDepositorAddr) != 0 {
+			depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Depositor = depositorAddr
+		}
+		if strSubmitAfter := r.URL.Query().Get(RestSubmitAfter); len(strSubmitAfter) != 0 {
+			submitAfter, err := time.Parse(time.RFC3339, strSubmitAfter)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.SubmitAfter = submitAfter
+		}
+		if strSubmitBefore := r.URL.Query().Get(RestSubmitBefore); len(strSubmitBefore) != 0 {
+			submitBefore, err := time.Parse(time.RFC3339, strSubmitBefore)
+			if err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.SubmitBefore = submitBefore
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryIndexedProposals), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryArchivedVotesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, ok := utils.ParseInt64OrReturnBadRequest(w, mux.Vars(r)[RestProposalID])
+		if !ok {
+			return
+		}
+		pageParams, ok := parsePageParams(w, r)
+		if !ok {
+			return
+		}
+
+		params := gov.QueryArchivedVotesParams{
+			BaseParams: gov.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			PageParams: pageParams,
+			ProposalID: proposalID,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryArchivedVotes), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTallyBreakdownHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, ok := utils.ParseInt64OrReturnBadRequest(w, mux.Vars(r)[RestProposalID])
+		if !ok {
+			return
+		}
+
+		params := gov.QueryTallyParams{
+			BaseParams: gov.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			ProposalID: proposalID,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", gov.QueryTallyBreakdown), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			utils.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no tally breakdown of proposal %d", proposalID))
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryVotingProxiesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestAddress])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := gov.QueryVotingProxyParams{
+			BaseParams: gov.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			Address:    addr,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", path), bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -1,5 +1,7 @@
 package client
 
+import "strings"
+
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
 	switch option {
@@ -15,6 +17,19 @@ func NormalizeVoteOption(option string) string {
 	return ""
 }
 
+// NormalizeWeightedVoteOptions - normalize the options of user specified weighted vote options, e.g. yes=0.6,no=0.4
+func NormalizeWeightedVoteOptions(options string) string {
+	parts := strings.Split(options, ",")
+	for i, part := range parts {
+		fields := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if option := NormalizeVoteOption(strings.TrimSpace(fields[0])); option != "" {
+			fields[0] = option
+		}
+		parts[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(parts, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -38,6 +53,10 @@ func NormalizeProposalType(proposalType string) string {
 		return "CSCParamsChange"
 	case "ManageChanPermission", "manage_chan_permission":
 		return "ManageChanPermission"
+	case "EmergencyVeto", "emergency_veto":
+		return "EmergencyVeto"
+	case "GovParamsChange", "gov_params_change":
+		return "GovParamsChange"
 	}
 	return ""
 }
@@ -53,6 +72,10 @@ func NormalizeProposalStatus(status string) string {
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Queued", "queued":
+		return "Queued"
+	case "Cancelled", "cancelled":
+		return "Cancelled"
 	}
 	return ""
 }
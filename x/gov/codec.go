@@ -10,6 +10,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgSetVotingProxy{}, "cosmos-sdk/MsgSetVotingProxy", nil)
 
 	cdc.RegisterConcrete(MsgSideChainSubmitProposal{}, "cosmos-sdk/MsgSideChainSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgSideChainDeposit{}, "cosmos-sdk/MsgSideChainDeposit", nil)
@@ -17,6 +18,16 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&TextProposal{}, "gov/TextProposal", nil)
+
+	cdc.RegisterInterface((*Content)(nil), nil)
+	cdc.RegisterConcrete(ParameterChangeContent{}, "gov/ParameterChangeContent", nil)
+	cdc.RegisterConcrete(FeeChangeContent{}, "gov/FeeChangeContent", nil)
+	cdc.RegisterConcrete(SCParamsChangeContent{}, "gov/SCParamsChangeContent", nil)
+	cdc.RegisterConcrete(CSCParamsChangeContent{}, "gov/CSCParamsChangeContent", nil)
+	cdc.RegisterConcrete(ManageChanPermissionContent{}, "gov/ManageChanPermissionContent", nil)
+	cdc.RegisterConcrete(SoftwareUpgradeContent{}, "gov/SoftwareUpgradeContent", nil)
+	cdc.RegisterConcrete(EmergencyVetoContent{}, "gov/EmergencyVetoContent", nil)
+	cdc.RegisterConcrete(GovParamsChangeContent{}, "gov/GovParamsChangeContent", nil)
 }
 
 var msgCdc = codec.New()
@@ -0,0 +1,177 @@
+package gov
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/paramHub/types"
+	sctypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// Content is the typed payload of a proposal. Every proposal kind except text proposals has its own content type,
+// the content is validated when the proposal is submitted and handled by the registered ContentHandler when the
+// proposal passes.
+type Content interface {
+	ProposalType() ProposalKind
+	ValidateBasic() sdk.Error
+	// Payload returns the parameters carried by the content, which were passed as JSON in the description before.
+	Payload() interface{}
+}
+
+// ContentHandler executes the content of a passed proposal. The proposal is marked as failed if an error is returned,
+// and the state changes made by the handler are discarded.
+type ContentHandler func(ctx sdk.Context, proposal Proposal, content Content) sdk.Error
+
+// DecodeProposalContent decodes the payload of a proposal into ptr. The typed content is used if the proposal has
+// one, otherwise the payload is decoded from the JSON description of the proposal.
+func DecodeProposalContent(cdc *codec.Codec, proposal Proposal, ptr interface{}) error {
+	content := proposal.GetContent()
+	if content == nil {
+		return cdc.UnmarshalJSON([]byte(proposal.GetDescription()), ptr)
+	}
+
+	payload := reflect.ValueOf(content.Payload())
+	target := reflect.ValueOf(ptr)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return fmt.Errorf("expected a non-nil pointer, got %T", ptr)
+	}
+	if payload.Type() != target.Elem().Type() {
+		return fmt.Errorf("the content of proposal %d is %T, not %s", proposal.GetProposalID(), content.Payload(), target.Elem().Type())
+	}
+	target.Elem().Set(payload)
+	return nil
+}
+
+func validateContent(proposalType ProposalKind, content Content) sdk.Error {
+	if content == nil {
+		if proposalType == ProposalTypeEmergencyVeto {
+			return ErrInvalidProposalContent(DefaultCodespace, "emergency veto proposal should have content")
+		}
+		if proposalType == ProposalTypeGovParamsChange {
+			return ErrInvalidProposalContent(DefaultCodespace, "gov params change proposal should have content")
+		}
+		return nil
+	}
+	if proposalType == ProposalTypeText {
+		return ErrInvalidProposalContent(DefaultCodespace, "text proposal should not have content")
+	}
+	if content.ProposalType() != proposalType {
+		return ErrInvalidProposalContent(DefaultCodespace,
+			fmt.Sprintf("content of %s proposal does not match the proposal type %s", content.ProposalType(), proposalType))
+	}
+	return content.ValidateBasic()
+}
+
+// -----------------------------------------------------------
+// ParameterChangeContent changes the parameters of the native chain
+type ParameterChangeContent struct {
+	Changes paramtypes.BCChangeParams `json:"changes"`
+}
+
+var _ Content = ParameterChangeContent{}
+
+func (c ParameterChangeContent) ProposalType() ProposalKind { return ProposalTypeParameterChange }
+func (c ParameterChangeContent) Payload() interface{}       { return c.Changes }
+func (c ParameterChangeContent) ValidateBasic() sdk.Error {
+	changes := c.Changes
+	if err := changes.Check(); err != nil {
+		return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------
+// FeeChangeContent changes the fees of the messages
+type FeeChangeContent struct {
+	Changes paramtypes.FeeChangeParams `json:"changes"`
+}
+
+var _ Content = FeeChangeContent{}
+
+func (c FeeChangeContent) ProposalType() ProposalKind { return ProposalTypeFeeChange }
+func (c FeeChangeContent) Payload() interface{}       { return c.Changes }
+func (c FeeChangeContent) ValidateBasic() sdk.Error {
+	changes := c.Changes
+	if err := changes.Check(); err != nil {
+		return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------
+// SCParamsChangeContent changes the parameters of a side chain
+type SCParamsChangeContent struct {
+	Changes paramtypes.SCChangeParams `json:"changes"`
+}
+
+var _ Content = SCParamsChangeContent{}
+
+func (c SCParamsChangeContent) ProposalType() ProposalKind { return ProposalTypeSCParamsChange }
+func (c SCParamsChangeContent) Payload() interface{}       { return c.Changes }
+func (c SCParamsChangeContent) ValidateBasic() sdk.Error {
+	changes := c.Changes
+	if err := changes.Check(); err != nil {
+		return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------
+// CSCParamsChangeContent changes a parameter of the contracts on a side chain
+type CSCParamsChangeContent struct {
+	Change paramtypes.CSCParamChange `json:"change"`
+}
+
+var _ Content = CSCParamsChangeContent{}
+
+func (c CSCParamsChangeContent) ProposalType() ProposalKind { return ProposalTypeCSCParamsChange }
+func (c CSCParamsChangeContent) Payload() interface{}       { return c.Change }
+func (c CSCParamsChangeContent) ValidateBasic() sdk.Error {
+	change := c.Change
+	if err := change.Check(); err != nil {
+		return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// -----------------------------------------------------------
+// ManageChanPermissionContent enables or disables a cross chain channel of a side chain
+type ManageChanPermissionContent struct {
+	Setting sctypes.ChanPermissionSetting `json:"setting"`
+}
+
+var _ Content = ManageChanPermissionContent{}
+
+func (c ManageChanPermissionContent) ProposalType() ProposalKind {
+	return ProposalTypeManageChanPermission
+}
+func (c ManageChanPermissionContent) Payload() interface{} { return c.Setting }
+func (c ManageChanPermissionContent) ValidateBasic() sdk.Error {
+	setting := c.Setting
+	if err := setting.Check(); err != nil {
+		return ErrInvalidProposalContent(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// executeContent runs the content handler of a passed proposal in a cached context, the changes are only written if
+// the handler succeeds.
+func (keeper Keeper) executeContent(ctx sdk.Context, proposal Proposal) sdk.Error {
+	content := proposal.GetContent()
+	if content == nil || !sdk.IsUpgrade(sdk.TypedProposalContent) {
+		return nil
+	}
+	handler, ok := keeper.contentHandlers[content.ProposalType()]
+	if !ok {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := handler(cacheCtx, proposal, content); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
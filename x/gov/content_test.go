@@ -0,0 +1,177 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestMsgSubmitProposalContent(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	content := gov.ManageChanPermissionContent{
+		Setting: types.ChanPermissionSetting{SideChainId: "bsc", ChannelId: 8, Permission: sdk.ChannelForbidden},
+	}
+	invalidContent := gov.ManageChanPermissionContent{
+		Setting: types.ChanPermissionSetting{SideChainId: "bsc", ChannelId: types.GovChannelId, Permission: sdk.ChannelForbidden},
+	}
+	tests := []struct {
+		proposalType gov.ProposalKind
+		content      gov.Content
+		expectPass   bool
+	}{
+		{gov.ProposalTypeManageChanPermission, content, true},
+		{gov.ProposalTypeManageChanPermission, nil, true},
+		{gov.ProposalTypeManageChanPermission, invalidContent, false},
+		{gov.ProposalTypeFeeChange, content, false},
+		{gov.ProposalTypeText, content, false},
+	}
+
+	for i, tc := range tests {
+		msg := gov.NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", tc.proposalType,
+			addrs[0], coinsPos, 1000*time.Second).WithContent(tc.content)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestProposalContent(t *testing.T) {
+	mapp, ck, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()})
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// the handler only accepts to forbid channels, and pays a coin to the executed account
+	_, executed := mock.GeneratePrivKeyAddressPairs(1)
+	keeper.AddContentHandler(gov.ProposalTypeManageChanPermission, func(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+		if _, _, err := ck.AddCoins(ctx, executed[0], sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 1)}); err != nil {
+			return err
+		}
+		if content.Payload().(types.ChanPermissionSetting).Permission != sdk.ChannelForbidden {
+			return gov.ErrInvalidProposalContent(gov.DefaultCodespace, "only forbidding channels is allowed")
+		}
+		return nil
+	})
+
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 1000 * time.Second
+	submit := func(permission sdk.ChannelPermission) sdk.Result {
+		setting := types.ChanPermissionSetting{SideChainId: "bsc", ChannelId: 8, Permission: permission}
+		msg := gov.NewMsgSubmitProposal("Test", "test", gov.ProposalTypeManageChanPermission, addrs[0],
+			sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).
+			WithContent(gov.ManageChanPermissionContent{Setting: setting})
+		return govHandler(ctx, msg)
+	}
+
+	// typed content is not accepted before the upgrade
+	res := submit(sdk.ChannelForbidden)
+	require.Equal(t, sdk.ToABCICode(gov.DefaultCodespace, gov.CodeInvalidProposalContent), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+	var proposalIDs []int64
+	for _, permission := range []sdk.ChannelPermission{sdk.ChannelForbidden, sdk.ChannelAllow} {
+		res = submit(permission)
+		require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+		proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+		proposalIDs = append(proposalIDs, proposalID)
+
+		res = govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionYes))
+		require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+	}
+
+	// the payload is decoded from the typed content
+	var setting types.ChanPermissionSetting
+	require.NoError(t, gov.DecodeProposalContent(mapp.Cdc, keeper.GetProposal(ctx, proposalIDs[0]), &setting))
+	require.Equal(t, sdk.ChannelForbidden, setting.Permission)
+	var feeParams struct{}
+	require.Error(t, gov.DecodeProposalContent(mapp.Cdc, keeper.GetProposal(ctx, proposalIDs[0]), &feeParams))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, keeper)
+
+	// the changes of the failed handler are discarded
+	require.Equal(t, gov.StatusPassed, keeper.GetProposal(ctx, proposalIDs[0]).GetStatus())
+	require.Equal(t, gov.StatusFailed, keeper.GetProposal(ctx, proposalIDs[1]).GetStatus())
+	require.EqualValues(t, 1, ck.GetCoins(ctx, executed[0]).AmountOf(gov.DefaultDepositDenom))
+}
+
+type mockIbcKeeper struct {
+	packages [][]byte
+}
+
+func (k *mockIbcKeeper) CreateRawIBCPackageById(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	packageType sdk.CrossChainPackageType, packageLoad []byte) (uint64, sdk.Error) {
+	k.packages = append(k.packages, packageLoad)
+	return uint64(len(k.packages)), nil
+}
+
+func TestChanPermissionContentHandler(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 1)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 0)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.LaunchBscUpgrade, 0)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// connecting the side chain keeper registers the handler of the typed channel permission proposals
+	scKeeper := stakeKeeper.ScKeeper
+	chainID, channelID := sdk.ChainID(96), sdk.ChannelID(8) // the stake channel registered by the stake keeper
+	ibcKeeper := &mockIbcKeeper{}
+	scKeeper.SetIbcKeeper(ibcKeeper)
+	scKeeper.SetGovKeeper(&keeper)
+	require.NoError(t, scKeeper.RegisterDestChain("bsc", chainID))
+	scKeeper.SetChannelSendPermission(ctx, chainID, channelID, sdk.ChannelAllow)
+
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 1000 * time.Second
+	setting := types.ChanPermissionSetting{SideChainId: "bsc", ChannelId: channelID, Permission: sdk.ChannelForbidden}
+	msg := gov.NewMsgSubmitProposal("Test", "test", gov.ProposalTypeManageChanPermission, addrs[0],
+		sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).
+		WithContent(gov.ManageChanPermissionContent{Setting: setting})
+	res := govHandler(ctx, msg)
+	require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+	proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+	res = govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionYes))
+	require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, keeper)
+
+	// the passed proposal takes effect and is sent to the side chain
+	require.Equal(t, gov.StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	require.Equal(t, sdk.ChannelForbidden, scKeeper.GetChannelSendPermission(ctx, chainID, channelID))
+	require.Len(t, ibcKeeper.packages, 1)
+}
@@ -10,14 +10,24 @@ import (
 
 // Vote
 type Vote struct {
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	ProposalID int64          `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Voter      sdk.AccAddress      `json:"voter"`             //  address of the voter
+	ProposalID int64               `json:"proposal_id"`       //  proposalID of the proposal
+	Option     VoteOption          `json:"option"`            //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty"` //  weighted options of a weighted vote, Option is empty then
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option &&
+		voteA.Options.Equals(voteB.Options)
+}
+
+// Returns the weighted options of the vote, a vote of a single option gets all the voting power
+func (voteA Vote) WeightedOptions() WeightedVoteOptions {
+	if len(voteA.Options) != 0 {
+		return voteA.Options
+	}
+	return NewNonSplitVoteOption(voteA.Option)
 }
 
 // Returns whether a vote is empty
@@ -106,6 +116,11 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+	// the option of a weighted vote is empty
+	if s == "" {
+		*vo = OptionEmpty
+		return nil
+	}
 
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
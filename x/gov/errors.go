@@ -25,6 +25,9 @@ const (
 	CodeInvalidProposal         sdk.CodeType = 12
 	CodeInvalidVotingPeriod     sdk.CodeType = 13
 	CodeInvalidSideChainId      sdk.CodeType = 14
+	CodeInvalidProposalContent  sdk.CodeType = 15
+	CodeInvalidWeightedVote     sdk.CodeType = 16
+	CodeInvalidVotingProxy      sdk.CodeType = 17
 )
 
 //----------------------------------------
@@ -81,3 +84,15 @@ func ErrInvalidVotingPeriod(codespace sdk.CodespaceType, votingPeriod time.Durat
 func ErrInvalidSideChainId(codespace sdk.CodespaceType, sideChain string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidSideChainId, fmt.Sprintf("Invalid side chain id: %s", sideChain))
 }
+
+func ErrInvalidProposalContent(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalContent, fmt.Sprintf("Invalid proposal content: %s", msg))
+}
+
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWeightedVote, fmt.Sprintf("Invalid weighted vote: %s", msg))
+}
+
+func ErrInvalidVotingProxy(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVotingProxy, fmt.Sprintf("Invalid voting proxy: %s", msg))
+}
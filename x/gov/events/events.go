@@ -1,11 +1,15 @@
 package events
 
 var (
-	EventTypeProposalDropped  = "proposal-dropped"
-	EventTypeProposalPassed   = "proposal-passed"
-	EventTypeProposalRejected = "proposal-rejected"
+	EventTypeProposalDropped   = "proposal-dropped"
+	EventTypeProposalPassed    = "proposal-passed"
+	EventTypeProposalRejected  = "proposal-rejected"
+	EventTypeProposalFailed    = "proposal-failed"
+	EventTypeProposalQueued    = "proposal-queued"
+	EventTypeProposalCancelled = "proposal-cancelled"
 
 	ProposalID        = "proposal-id"
 	VotingPeriodStart = "voting-period-start"
 	SideChainID       = "side-chain-id"
+	ExecutionTime     = "execution-time"
 )
@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +13,13 @@ const (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID int64         `json:"starting_proposalID"`
-	DepositParams      DepositParams `json:"deposit_params"`
-	TallyParams        TallyParams   `json:"tally_params"`
+	StartingProposalID int64           `json:"starting_proposalID"`
+	DepositParams      DepositParams   `json:"deposit_params"`
+	TallyParams        TallyParams     `json:"tally_params"`
+	TimelockParams     *TimelockParams `json:"timelock_params,omitempty"` // the default params are used if it is not set
+
+	ProposalTypeParams []ProposalTypeParams `json:"proposal_type_params,omitempty"`
+	VotingProxies      []VotingProxy        `json:"voting_proxies,omitempty"` // the global voting proxies
 }
 
 func NewGenesisState(startingProposalID int64, dp DepositParams, tp TallyParams) GenesisState {
@@ -50,6 +55,26 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	}
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	if data.TimelockParams != nil {
+		if err := data.TimelockParams.ValidateBasic(); err != nil {
+			panic(err)
+		}
+		k.SetTimelockParams(ctx, *data.TimelockParams)
+	}
+	if len(data.ProposalTypeParams) > 0 {
+		if err := validateProposalTypeParams(data.ProposalTypeParams); err != nil {
+			panic(err)
+		}
+		k.SetProposalTypeParams(ctx, data.ProposalTypeParams)
+	}
+	for _, proxy := range data.VotingProxies {
+		if proxy.Delegator.Empty() || proxy.Proxy.Empty() || proxy.Delegator.Equals(proxy.Proxy) {
+			panic(fmt.Errorf("invalid voting proxy %s", proxy))
+		}
+		if err := k.SetVotingProxy(ctx, proxy.Delegator, proxy.Proxy); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // WriteGenesis - output genesis parameters
@@ -57,10 +82,20 @@ func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	startingProposalID, _ := k.getNewProposalID(ctx)
 	depositParams := k.GetDepositParams(ctx)
 	tallyingParams := k.GetTallyParams(ctx)
+	timelockParams := k.GetTimelockParams(ctx)
+	proposalTypeParams := k.GetProposalTypeParams(ctx)
+	var votingProxies []VotingProxy
+	k.IterateVotingProxies(ctx, func(proxy VotingProxy) bool {
+		votingProxies = append(votingProxies, proxy)
+		return false
+	})
 
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		DepositParams:      depositParams,
 		TallyParams:        tallyingParams,
+		TimelockParams:     &timelockParams,
+		ProposalTypeParams: proposalTypeParams,
+		VotingProxies:      votingProxies,
 	}
 }
@@ -19,6 +19,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgSetVotingProxy:
+			return handleMsgSetVotingProxy(ctx, keeper, msg)
 		case MsgSideChainDeposit:
 			return handleMsgSideChainDeposit(ctx, keeper, msg)
 		case MsgSideChainSubmitProposal:
@@ -36,8 +38,20 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
+	if msg.Content != nil && !sdk.IsUpgrade(sdk.TypedProposalContent) {
+		return ErrInvalidProposalContent(keeper.codespace, "typed proposal content is not supported yet").Result()
+	}
+	if _, ok := msg.Content.(SoftwareUpgradeContent); ok && !sdk.IsUpgrade(sdk.SoftwareUpgradePlan) {
+		return ErrInvalidProposalContent(keeper.codespace, "software upgrade plan is not supported yet").Result()
+	}
+	if msg.ProposalType == ProposalTypeEmergencyVeto && !sdk.IsUpgrade(sdk.GovTimelock) {
+		return ErrInvalidProposalType(keeper.codespace, msg.ProposalType).Result()
+	}
+	if msg.ProposalType == ProposalTypeGovParamsChange && !sdk.IsUpgrade(sdk.GovProposalTypeParams) {
+		return ErrInvalidProposalType(keeper.codespace, msg.ProposalType).Result()
+	}
 
-	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType, msg.VotingPeriod)
+	proposal := keeper.NewProposal(ctx, msg.Title, msg.Description, msg.ProposalType, msg.Content, msg.VotingPeriod)
 
 	hooksErr := keeper.OnProposalSubmitted(ctx, proposal)
 	if hooksErr != nil {
@@ -96,15 +110,23 @@ func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	validator := keeper.vs.Validator(ctx, sdk.ValAddress(msg.Voter))
 
-	if validator == nil {
+	// the voting proxies vote for the delegators they represent
+	isProxy := validator == nil && sdk.IsUpgrade(sdk.GovVotingProxy) && keeper.IsVotingProxy(ctx, msg.Voter)
+	if validator == nil && !isProxy {
 		return sdk.ErrUnauthorized("Vote is not from a validator operator").Result()
 	}
 
-	if validator.GetPower().IsZero() {
+	if validator != nil && validator.GetPower().IsZero() {
 		return sdk.ErrUnauthorized("Validator is not bonded").Result()
 	}
 
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	_, changed := keeper.GetVote(ctx, msg.ProposalID, msg.Voter)
+	var err sdk.Error
+	if len(msg.Options) != 0 {
+		err = keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	} else {
+		err = keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	}
 	if err != nil {
 		return err.Result()
 	}
@@ -116,6 +138,9 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 		tags.Voter, []byte(msg.Voter.String()),
 		tags.ProposalID, proposalIDBytes,
 	)
+	if changed && sdk.IsUpgrade(sdk.WeightedVote) {
+		resTags = resTags.AppendTag(tags.VoteChanged, []byte("true"))
+	}
 	return sdk.Result{
 		Tags: resTags,
 	}
@@ -136,10 +161,13 @@ func EndBlocker(baseCtx sdk.Context, keeper Keeper) (refundProposals, notRefundP
 		tmpSideIDs, storePrefixes := keeper.ScKeeper.GetAllSideChainPrefixes(baseCtx)
 		chainIDs = append(chainIDs, tmpSideIDs...)
 		for i := range storePrefixes {
-			contexts = append(contexts, baseCtx.WithSideChainKeyPrefix(storePrefixes[i]))
+			contexts = append(contexts, baseCtx.WithSideChainKeyPrefix(storePrefixes[i]).WithSideChainId(tmpSideIDs[i]))
 		}
 	}
 	for i := 0; i < len(chainIDs); i++ {
+		if sdk.IsUpgradeHeight(sdk.GovIndex) {
+			keeper.rebuildIndex(contexts[i])
+		}
 		resEvents, refund, noRefund := settleProposals(contexts[i], keeper, chainIDs[i])
 		events = events.AppendEvents(resEvents)
 		refundProposals = append(refundProposals, refund...)
@@ -180,12 +208,33 @@ func settleProposals(ctx sdk.Context, keeper Keeper, chainId string) (resEvents
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %v (had only %v); distribute to validator",
 				inactiveProposal.GetProposalID(),
 				inactiveProposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParamsByType(ctx, inactiveProposal.GetProposalType()).MinDeposit,
 				inactiveProposal.GetTotalDeposit(),
 			),
 		)
 	}
 
+	// Execute the queued proposals whose timelock has expired
+	for _, queuedProposal := range keeper.popMaturedQueuedProposals(ctx) {
+		queuedProposal.SetStatus(StatusPassed)
+		action := events.EventTypeProposalPassed
+		if err := keeper.executeContent(ctx, queuedProposal); err != nil {
+			queuedProposal.SetStatus(StatusFailed)
+			action = events.EventTypeProposalFailed
+			logger.Error(fmt.Sprintf("proposal %d (%s) failed to execute", queuedProposal.GetProposalID(),
+				queuedProposal.GetTitle()), "err", err.Error())
+		}
+		keeper.SetProposal(ctx, queuedProposal)
+
+		logger.Info(fmt.Sprintf("proposal %d (%s) dequeued after the timelock", queuedProposal.GetProposalID(), queuedProposal.GetTitle()))
+		event := sdk.NewEvent(action, sdk.NewAttribute(events.ProposalID,
+			strconv.FormatInt(queuedProposal.GetProposalID(), 10)))
+		if chainId != NativeChainID {
+			event = event.AppendAttributes(sdk.NewAttribute(events.SideChainID, chainId))
+		}
+		resEvents = resEvents.AppendEvent(event)
+	}
+
 	// Check if earliest Active Proposal ended voting period yet
 	for ShouldPopActiveProposalQueue(ctx, keeper) {
 		activeProposal := keeper.ActiveProposalQueuePop(ctx)
@@ -196,8 +245,11 @@ func settleProposals(ctx sdk.Context, keeper Keeper, chainId string) (resEvents
 			continue
 		}
 
-		passes, refundDeposits, tallyResults := Tally(ctx, keeper, activeProposal)
+		keeper.archiveVotes(ctx, activeProposal.GetProposalID())
+		passes, refundDeposits, tallyResults, breakdown := tally(ctx, keeper, activeProposal)
+		keeper.setTallyBreakdown(ctx, activeProposal.GetProposalID(), breakdown)
 		var action string
+		var extraAttributes []sdk.Attribute
 		if passes {
 			activeProposal.SetStatus(StatusPassed)
 			action = events.EventTypeProposalPassed
@@ -205,6 +257,23 @@ func settleProposals(ctx sdk.Context, keeper Keeper, chainId string) (resEvents
 			// refund deposits
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
 			refundProposals = append(refundProposals, SimpleProposal{activeProposal.GetProposalID(), chainId})
+
+			if queued, ok := keeper.queueProposal(ctx, activeProposal); ok {
+				action = events.EventTypeProposalQueued
+				extraAttributes = append(extraAttributes, sdk.NewAttribute(events.ExecutionTime, queued.ExecutionTime.String()))
+			} else if err := keeper.executeContent(ctx, activeProposal); err != nil {
+				activeProposal.SetStatus(StatusFailed)
+				action = events.EventTypeProposalFailed
+				logger.Error(fmt.Sprintf("proposal %d (%s) failed to execute", activeProposal.GetProposalID(),
+					activeProposal.GetTitle()), "err", err.Error())
+			} else if veto, ok := activeProposal.GetContent().(EmergencyVetoContent); ok {
+				event := sdk.NewEvent(events.EventTypeProposalCancelled,
+					sdk.NewAttribute(events.ProposalID, strconv.FormatInt(veto.ProposalID, 10)))
+				if chainId != NativeChainID {
+					event = event.AppendAttributes(sdk.NewAttribute(events.SideChainID, chainId))
+				}
+				resEvents = resEvents.AppendEvent(event)
+			}
 		} else {
 			activeProposal.SetStatus(StatusRejected)
 			action = events.EventTypeProposalRejected
@@ -226,6 +295,7 @@ func settleProposals(ctx sdk.Context, keeper Keeper, chainId string) (resEvents
 			activeProposal.GetProposalID(), activeProposal.GetTitle(), passes))
 		event := sdk.NewEvent(action, sdk.NewAttribute(events.ProposalID,
 			strconv.FormatInt(activeProposal.GetProposalID(), 10)))
+		event = event.AppendAttributes(extraAttributes...)
 		if chainId != NativeChainID {
 			event.AppendAttributes(sdk.NewAttribute(events.SideChainID, chainId))
 		}
@@ -23,7 +23,7 @@ func handleMsgSideChainSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSid
 
 	result := handleMsgSubmitProposal(ctx, keeper,
 		NewMsgSubmitProposal(msg.Title, msg.Description, msg.ProposalType, msg.Proposer, msg.InitialDeposit,
-			msg.VotingPeriod))
+			msg.VotingPeriod).WithContent(msg.Content))
 	if result.IsOK() {
 		result.Tags = result.Tags.AppendTag(events.SideChainID, []byte(msg.SideChainId))
 	}
@@ -48,7 +48,9 @@ func handleMsgSideChainVote(ctx sdk.Context, keeper Keeper, msg MsgSideChainVote
 	if err != nil {
 		return ErrInvalidSideChainId(keeper.codespace, msg.SideChainId).Result()
 	}
-	result := handleMsgVote(ctx, keeper, NewMsgVote(msg.Voter, msg.ProposalID, msg.Option))
+	voteMsg := NewMsgVote(msg.Voter, msg.ProposalID, msg.Option)
+	voteMsg.Options = msg.Options
+	result := handleMsgVote(ctx, keeper, voteMsg)
 	if result.IsOK() {
 		result.Tags = result.Tags.AppendTag(events.SideChainID, []byte(msg.SideChainId))
 	}
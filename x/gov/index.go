@@ -0,0 +1,212 @@
+package gov
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorTally is the vote of a validator counted in the final tally of a proposal
+type ValidatorTally struct {
+	Validator   sdk.ValAddress      `json:"validator"`
+	Power       sdk.Dec             `json:"power"`        // power of the validator
+	VotingPower sdk.Dec             `json:"voting_power"` // power counted for the vote, the delegators voting on their own are deducted
+	Options     WeightedVoteOptions `json:"options"`
+}
+
+// ProposalFilter selects the proposals from the index, the zero fields match all proposals
+type ProposalFilter struct {
+	ProposalType ProposalKind
+	Status       ProposalStatus
+	Voter        sdk.AccAddress
+	Depositor    sdk.AccAddress
+	SubmitAfter  time.Time // inclusive
+	SubmitBefore time.Time // exclusive
+}
+
+func (f ProposalFilter) match(keeper Keeper, ctx sdk.Context, proposal Proposal) bool {
+	if f.ProposalType != ProposalTypeNil && proposal.GetProposalType() != f.ProposalType {
+		return false
+	}
+	if validProposalStatus(f.Status) && proposal.GetStatus() != f.Status {
+		return false
+	}
+	submitTime := proposal.GetSubmitTime()
+	if !f.SubmitAfter.IsZero() && submitTime.Before(f.SubmitAfter) {
+		return false
+	}
+	if !f.SubmitBefore.IsZero() && !submitTime.Before(f.SubmitBefore) {
+		return false
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	if !f.Voter.Empty() && !store.Has(KeyVoterProposal(f.Voter, proposal.GetProposalID())) {
+		return false
+	}
+	if !f.Depositor.Empty() && !store.Has(KeyDepositorProposal(f.Depositor, proposal.GetProposalID())) {
+		return false
+	}
+	return true
+}
+
+// indexProposal adds a proposal to the index by submit time and type
+func (keeper Keeper) indexProposal(ctx sdk.Context, proposal Proposal) {
+	if !sdk.IsUpgrade(sdk.GovIndex) {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal.GetProposalID())
+	store.Set(KeyProposalTimeIndex(proposal.GetSubmitTime(), proposal.GetProposalID()), bz)
+	store.Set(KeyProposalTypeIndex(proposal.GetProposalType(), proposal.GetSubmitTime(), proposal.GetProposalID()), bz)
+}
+
+func (keeper Keeper) unindexProposal(ctx sdk.Context, proposal Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyProposalTimeIndex(proposal.GetSubmitTime(), proposal.GetProposalID()))
+	store.Delete(KeyProposalTypeIndex(proposal.GetProposalType(), proposal.GetSubmitTime(), proposal.GetProposalID()))
+}
+
+func (keeper Keeper) indexVoter(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress) {
+	if !sdk.IsUpgrade(sdk.GovIndex) {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyVoterProposal(voterAddr, proposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(proposalID))
+}
+
+func (keeper Keeper) indexDepositor(ctx sdk.Context, proposalID int64, depositorAddr sdk.AccAddress) {
+	if !sdk.IsUpgrade(sdk.GovIndex) {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyDepositorProposal(depositorAddr, proposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(proposalID))
+}
+
+// rebuildIndex indexes the proposals, votes and deposits in the store, it runs once at the upgrade height
+func (keeper Keeper) rebuildIndex(ctx sdk.Context) {
+	keeper.Iterate(ctx, nil, nil, StatusNil, 0, false, func(proposal Proposal) bool {
+		keeper.indexProposal(ctx, proposal)
+		proposalID := proposal.GetProposalID()
+
+		votesIterator := keeper.GetVotes(ctx, proposalID)
+		for ; votesIterator.Valid(); votesIterator.Next() {
+			var vote Vote
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
+			keeper.indexVoter(ctx, proposalID, vote.Voter)
+		}
+		votesIterator.Close()
+
+		depositsIterator := keeper.GetDeposits(ctx, proposalID)
+		for ; depositsIterator.Valid(); depositsIterator.Next() {
+			var deposit Deposit
+			keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), &deposit)
+			keeper.indexDepositor(ctx, proposalID, deposit.Depositer)
+		}
+		depositsIterator.Close()
+		return false
+	})
+}
+
+// IterateIndexedProposals iterates through the proposals matching the filter. The proposals are sorted by proposal id
+// if the voter or the depositor is set, otherwise by submit time.
+func (keeper Keeper) IterateIndexedProposals(ctx sdk.Context, filter ProposalFilter, reverse bool, fn func(proposal Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var start, end []byte
+	switch {
+	case !filter.Voter.Empty():
+		start = KeyVoterProposalsSubspace(filter.Voter)
+		end = sdk.PrefixEndBytes(start)
+	case !filter.Depositor.Empty():
+		start = KeyDepositorProposalsSubspace(filter.Depositor)
+		end = sdk.PrefixEndBytes(start)
+	default:
+		prefix := KeyProposalTimeIndexSubspace
+		if filter.ProposalType != ProposalTypeNil {
+			prefix = KeyProposalTypeIndexSubspace(filter.ProposalType)
+		}
+		start, end = prefix, sdk.PrefixEndBytes(prefix)
+		if !filter.SubmitAfter.IsZero() {
+			start = append(copyBytes(prefix), sdk.FormatTimeBytes(filter.SubmitAfter)...)
+		}
+		if !filter.SubmitBefore.IsZero() {
+			end = append(copyBytes(prefix), sdk.FormatTimeBytes(filter.SubmitBefore)...)
+		}
+		if bytes.Compare(start, end) >= 0 {
+			return
+		}
+	}
+
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposalID int64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposalID)
+		proposal := keeper.GetProposal(ctx, proposalID)
+		if proposal == nil || !filter.match(keeper, ctx, proposal) {
+			continue
+		}
+		if fn(proposal) {
+			break
+		}
+	}
+}
+
+func copyBytes(bz []byte) []byte {
+	return append([]byte{}, bz...)
+}
+
+// archiveVotes keeps the votes of a proposal before they are deleted by the tally
+func (keeper Keeper) archiveVotes(ctx sdk.Context, proposalID int64) {
+	if !sdk.IsUpgrade(sdk.GovIndex) {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	defer votesIterator.Close()
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		var vote Vote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
+		store.Set(KeyArchivedVote(proposalID, vote.Voter), votesIterator.Value())
+	}
+}
+
+// GetArchivedVotes returns the votes of a tallied proposal
+func (keeper Keeper) GetArchivedVotes(ctx sdk.Context, proposalID int64) []Vote {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyArchivedVotesSubspace(proposalID))
+	defer iterator.Close()
+
+	votes := make([]Vote, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var vote Vote
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// GetTallyBreakdown returns the votes of the validators counted in the final tally of a proposal
+func (keeper Keeper) GetTallyBreakdown(ctx sdk.Context, proposalID int64) (breakdown []ValidatorTally, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyTallyBreakdown(proposalID))
+	if bz == nil {
+		return nil, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &breakdown)
+	return breakdown, true
+}
+
+func (keeper Keeper) setTallyBreakdown(ctx sdk.Context, proposalID int64, breakdown []ValidatorTally) {
+	if !sdk.IsUpgrade(sdk.GovIndex) {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyTallyBreakdown(proposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(breakdown))
+}
@@ -0,0 +1,130 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestGovIndex(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 4)
+	for _, upgrade := range []string{sdk.TypedProposalContent, sdk.WeightedVote} {
+		sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 1)
+		defer sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 0)
+	}
+	// the index is built at height 2 from the proposals submitted before
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.GovIndex, 2)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.GovIndex, 0)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 10 * time.Second
+	startTime := ctx.BlockHeader().Time
+	advance := func(d time.Duration) {
+		header := ctx.BlockHeader()
+		header.Time = header.Time.Add(d)
+		ctx = ctx.WithBlockHeader(header)
+	}
+	submit := func(proposer sdk.AccAddress, proposalType gov.ProposalKind, content gov.Content) int64 {
+		msg := gov.NewMsgSubmitProposal("Test", "test", proposalType, proposer,
+			sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).WithContent(content)
+		res := govHandler(ctx, msg)
+		require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+		proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+		advance(time.Second)
+		return proposalID
+	}
+	vote := func(voter sdk.AccAddress, proposalID int64, option gov.VoteOption) {
+		res := govHandler(ctx, gov.NewMsgVote(voter, proposalID, option))
+		require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+	}
+	querier := gov.NewQuerier(keeper)
+	queryProposals := func(params gov.QueryIndexedProposalsParams) []int64 {
+		bz, err := querier(ctx, []string{gov.QueryIndexedProposals}, abci.RequestQuery{Data: mapp.Cdc.MustMarshalJSON(params)})
+		require.Nil(t, err)
+		var proposals []gov.Proposal
+		require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &proposals))
+		ids := make([]int64, 0, len(proposals))
+		for _, proposal := range proposals {
+			ids = append(ids, proposal.GetProposalID())
+		}
+		return ids
+	}
+
+	textID := submit(addrs[1], gov.ProposalTypeText, nil)
+	vote(addrs[0], textID, gov.OptionNo)
+	require.Empty(t, queryProposals(gov.QueryIndexedProposalsParams{}))
+
+	// the proposals and votes before the upgrade are indexed at the upgrade height
+	sdk.UpgradeMgr.SetHeight(2)
+	ctx = ctx.WithBlockHeight(2)
+	gov.EndBlocker(ctx, keeper)
+	require.Equal(t, []int64{textID}, queryProposals(gov.QueryIndexedProposalsParams{Voter: addrs[0]}))
+
+	upgradeID := submit(addrs[2], gov.ProposalTypeSoftwareUpgrade, nil)
+	otherTextID := submit(addrs[2], gov.ProposalTypeText, nil)
+	vote(addrs[0], upgradeID, gov.OptionNo)
+	vote(addrs[0], textID, gov.OptionYes)
+
+	require.Equal(t, []int64{textID, upgradeID, otherTextID}, queryProposals(gov.QueryIndexedProposalsParams{}))
+	require.Equal(t, []int64{otherTextID, upgradeID, textID}, queryProposals(gov.QueryIndexedProposalsParams{Reverse: true}))
+	require.Equal(t, []int64{textID, otherTextID}, queryProposals(gov.QueryIndexedProposalsParams{ProposalType: gov.ProposalTypeText}))
+	require.Equal(t, []int64{upgradeID, otherTextID}, queryProposals(gov.QueryIndexedProposalsParams{Depositor: addrs[2]}))
+	require.Equal(t, []int64{textID, upgradeID}, queryProposals(gov.QueryIndexedProposalsParams{Voter: addrs[0]}))
+	require.Equal(t, []int64{upgradeID}, queryProposals(gov.QueryIndexedProposalsParams{
+		SubmitAfter:  startTime.Add(time.Second),
+		SubmitBefore: startTime.Add(2 * time.Second),
+	}))
+	require.Equal(t, []int64{otherTextID}, queryProposals(gov.QueryIndexedProposalsParams{
+		PageParams: gov.PageParams{Page: 2, Limit: 2},
+	}))
+
+	_, err := querier(ctx, []string{gov.QueryIndexedProposals}, abci.RequestQuery{
+		Data: mapp.Cdc.MustMarshalJSON(gov.QueryIndexedProposalsParams{PageParams: gov.PageParams{Limit: 101}}),
+	})
+	require.NotNil(t, err)
+
+	// the votes and the votes of the validators are kept after the tally
+	advance(votingPeriod)
+	gov.EndBlocker(ctx, keeper)
+	require.Equal(t, gov.StatusPassed, keeper.GetProposal(ctx, textID).GetStatus())
+	require.Equal(t, []int64{upgradeID, otherTextID}, queryProposals(gov.QueryIndexedProposalsParams{Status: gov.StatusRejected, Depositor: addrs[2]}))
+	require.Equal(t, []int64{upgradeID}, queryProposals(gov.QueryIndexedProposalsParams{Status: gov.StatusRejected, Voter: addrs[0]}))
+
+	bz, err := querier(ctx, []string{gov.QueryArchivedVotes}, abci.RequestQuery{
+		Data: mapp.Cdc.MustMarshalJSON(gov.QueryArchivedVotesParams{ProposalID: textID}),
+	})
+	require.Nil(t, err)
+	var votes []gov.Vote
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &votes))
+	require.Len(t, votes, 1)
+	require.Equal(t, gov.OptionYes, votes[0].Option)
+
+	bz, err = querier(ctx, []string{gov.QueryTallyBreakdown}, abci.RequestQuery{
+		Data: mapp.Cdc.MustMarshalJSON(gov.QueryTallyParams{ProposalID: textID}),
+	})
+	require.Nil(t, err)
+	var breakdown []gov.ValidatorTally
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &breakdown))
+	require.Len(t, breakdown, 1)
+	require.Equal(t, validator.OperatorAddr, breakdown[0].Validator)
+	require.Equal(t, gov.NewNonSplitVoteOption(gov.OptionYes), breakdown[0].Options)
+	require.Equal(t, breakdown[0].Power, breakdown[0].VotingPower)
+}
@@ -19,8 +19,11 @@ const (
 
 // Parameter store key
 var (
-	ParamStoreKeyDepositParams = []byte("depositparams")
-	ParamStoreKeyTallyParams   = []byte("tallyparams")
+	ParamStoreKeyDepositParams  = []byte("depositparams")
+	ParamStoreKeyTallyParams    = []byte("tallyparams")
+	ParamStoreKeyTimelockParams = []byte("timelockparams")
+
+	ParamStoreKeyProposalTypeParams = []byte("proposaltypeparams")
 
 	// Will hold deposit of both BC chain and side chain.
 	DepositedCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainDepositedCoins")))
@@ -31,6 +34,8 @@ func ParamTypeTable() params.TypeTable {
 	return params.NewTypeTable(
 		ParamStoreKeyDepositParams, DepositParams{},
 		ParamStoreKeyTallyParams, TallyParams{},
+		ParamStoreKeyTimelockParams, TimelockParams{},
+		ParamStoreKeyProposalTypeParams, []ProposalTypeParams{},
 	)
 }
 
@@ -65,6 +70,9 @@ type Keeper struct {
 	// Hooks registered
 	hooks map[ProposalKind][]GovHooks
 
+	// Handlers of the proposal contents, run when the proposals pass
+	contentHandlers map[ProposalKind]ContentHandler
+
 	// Reserved codespace
 	codespace sdk.CodespaceType
 
@@ -81,18 +89,22 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace, ck bank.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType, pool *sdk.Pool) Keeper {
-	return Keeper{
-		storeKey:     key,
-		paramsKeeper: paramsKeeper,
-		paramSpace:   paramSpace.WithTypeTable(ParamTypeTable()),
-		ck:           ck,
-		ds:           ds,
-		hooks:        make(map[ProposalKind][]GovHooks),
-		vs:           ds.GetValidatorSet(),
-		cdc:          cdc,
-		codespace:    codespace,
-		pool:         pool,
-	}
+	keeper := Keeper{
+		storeKey:        key,
+		paramsKeeper:    paramsKeeper,
+		paramSpace:      paramSpace.WithTypeTable(ParamTypeTable()),
+		ck:              ck,
+		ds:              ds,
+		hooks:           make(map[ProposalKind][]GovHooks),
+		contentHandlers: make(map[ProposalKind]ContentHandler),
+		vs:              ds.GetValidatorSet(),
+		cdc:             cdc,
+		codespace:       codespace,
+		pool:            pool,
+	}
+	return keeper.AddContentHandler(ProposalTypeSoftwareUpgrade, handleSoftwareUpgradeContent(keeper)).
+		AddContentHandler(ProposalTypeEmergencyVeto, handleEmergencyVetoContent(keeper)).
+		AddContentHandler(ProposalTypeGovParamsChange, handleGovParamsChangeContent(keeper))
 }
 
 func (keeper *Keeper) SetupForSideChain(scKeeper SideChainKeeper) {
@@ -110,11 +122,25 @@ func (keeper Keeper) AddHooks(proposalType ProposalKind, hooks GovHooks) Keeper
 	return keeper
 }
 
+// AddContentHandler sets the handler of the contents of a proposal type
+func (keeper Keeper) AddContentHandler(proposalType ProposalKind, handler ContentHandler) Keeper {
+	if _, ok := keeper.contentHandlers[proposalType]; ok {
+		panic(fmt.Sprintf("content handler of proposal type %s has been registered", proposalType))
+	}
+	keeper.contentHandlers[proposalType] = handler
+	return keeper
+}
+
 // =====================================================
 // Proposals
 
 // Creates a NewProposal
 func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, votingPeriod time.Duration) Proposal {
+	return keeper.NewProposal(ctx, title, description, proposalType, nil, votingPeriod)
+}
+
+// Creates a NewProposal with typed content, the content could be nil
+func (keeper Keeper) NewProposal(ctx sdk.Context, title string, description string, proposalType ProposalKind, content Content, votingPeriod time.Duration) Proposal {
 	proposalID, err := keeper.getNewProposalID(ctx)
 	if err != nil {
 		return nil
@@ -129,9 +155,11 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 		TallyResult:  EmptyTallyResult(),
 		TotalDeposit: sdk.Coins{},
 		SubmitTime:   ctx.BlockHeader().Time,
+		Content:      content,
 	}
 	keeper.SetProposal(ctx, proposal)
 	keeper.InactiveProposalQueuePush(ctx, proposal)
+	keeper.indexProposal(ctx, proposal)
 	return proposal
 }
 
@@ -160,6 +188,7 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal Proposal) {
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposal Proposal) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(KeyProposal(proposal.GetProposalID()))
+	keeper.unindexProposal(ctx, proposal)
 }
 
 func (keeper Keeper) Iterate(ctx sdk.Context, voterAddr sdk.AccAddress, depositerAddr sdk.AccAddress, status ProposalStatus, numLatest int64, reverse bool, iter func(Proposal) bool) {
@@ -319,14 +348,6 @@ func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 
 // Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
-	proposal := keeper.GetProposal(ctx, proposalID)
-	if proposal == nil {
-		return ErrUnknownProposal(keeper.codespace, proposalID)
-	}
-	if proposal.GetStatus() != StatusVotingPeriod {
-		return ErrInactiveProposal(keeper.codespace, proposalID)
-	}
-
 	if !validVoteOption(option) {
 		return ErrInvalidVote(keeper.codespace, option)
 	}
@@ -336,9 +357,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID int64, voterAddr sdk.Ac
 		Voter:      voterAddr,
 		Option:     option,
 	}
-	keeper.setVote(ctx, proposalID, voterAddr, vote)
-
-	return nil
+	return keeper.addVote(ctx, vote)
 }
 
 // Gets the vote of a specific voter on a specific proposal
@@ -418,11 +437,12 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID int64, depositerAddr
 	// Update Proposal
 	proposal.SetTotalDeposit(proposal.GetTotalDeposit().Plus(depositAmount))
 	keeper.SetProposal(ctx, proposal)
+	keeper.indexDepositor(ctx, proposalID, depositerAddr)
 
 	// Check if deposit tipped proposal into voting period
 	// Active voting period if so
 	activatedVotingPeriod := false
-	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsGTE(keeper.GetDepositParamsByType(ctx, proposal.GetProposalType()).MinDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,8 +14,103 @@ var (
 	KeyNextProposalID        = []byte("newProposalID")
 	KeyActiveProposalQueue   = []byte("activeProposalQueue")
 	KeyInactiveProposalQueue = []byte("inactiveProposalQueue")
+
+	KeyUpgradePlan                 = []byte("upgradePlan")
+	KeyAppliedUpgradePlansSubspace = []byte("appliedUpgradePlans:")
+	KeyCancelledUpgradesSubspace   = []byte("cancelledUpgrades:")
+
+	KeyTimelockQueueSubspace = []byte("timelockQueue:")
+
+	KeyProposalTimeIndexSubspace = []byte("proposalTimeIndex:")
+
+	KeyVotingProxiesSubspace = []byte("votingProxy:")
 )
 
+// Key for indexing a proposal by submit time
+func KeyProposalTimeIndex(submitTime time.Time, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("proposalTimeIndex:%s:%d", sdk.FormatTimeBytes(submitTime), proposalID))
+}
+
+// Key for getting the proposals of a type from the index, sorted by submit time
+func KeyProposalTypeIndexSubspace(proposalType ProposalKind) []byte {
+	return []byte(fmt.Sprintf("proposalTypeIndex:%d:", proposalType))
+}
+
+// Key for indexing a proposal by type and submit time
+func KeyProposalTypeIndex(proposalType ProposalKind, submitTime time.Time, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("proposalTypeIndex:%d:%s:%d", proposalType, sdk.FormatTimeBytes(submitTime), proposalID))
+}
+
+// Key for getting the proposals a voter voted on from the index, sorted by proposal id
+func KeyVoterProposalsSubspace(voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("voterProposals:%x:", voterAddr.Bytes()))
+}
+
+// Key for indexing a proposal by voter
+func KeyVoterProposal(voterAddr sdk.AccAddress, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("voterProposals:%x:%020d", voterAddr.Bytes(), proposalID))
+}
+
+// Key for getting the proposals a depositor deposited on from the index, sorted by proposal id
+func KeyDepositorProposalsSubspace(depositorAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("depositorProposals:%x:", depositorAddr.Bytes()))
+}
+
+// Key for indexing a proposal by depositor
+func KeyDepositorProposal(depositorAddr sdk.AccAddress, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("depositorProposals:%x:%020d", depositorAddr.Bytes(), proposalID))
+}
+
+// Key for getting the votes kept after a proposal is tallied
+func KeyArchivedVotesSubspace(proposalID int64) []byte {
+	return []byte(fmt.Sprintf("archivedVotes:%d:", proposalID))
+}
+
+// Key for getting a vote kept after the proposal is tallied
+func KeyArchivedVote(proposalID int64, voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("archivedVotes:%d:%x", proposalID, voterAddr.Bytes()))
+}
+
+// Key for getting the tally breakdown of a proposal by validator
+func KeyTallyBreakdown(proposalID int64) []byte {
+	return []byte(fmt.Sprintf("tallyBreakdown:%d", proposalID))
+}
+
+// Key for getting a queued proposal from the timelock queue, the queue is sorted by execution time
+func KeyTimelockQueue(executionTime time.Time, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("timelockQueue:%s:%d", sdk.FormatTimeBytes(executionTime), proposalID))
+}
+
+// Key for getting the execution time of a queued proposal from the store
+func KeyQueuedProposal(proposalID int64) []byte {
+	return []byte(fmt.Sprintf("queuedProposals:%d", proposalID))
+}
+
+// Key for getting an applied upgrade plan from the store
+func KeyAppliedUpgradePlan(name string) []byte {
+	return []byte(fmt.Sprintf("appliedUpgradePlans:%s", name))
+}
+
+// Key for getting a cancelled upgrade from the store
+func KeyCancelledUpgrade(name string) []byte {
+	return []byte(fmt.Sprintf("cancelledUpgrades:%s", name))
+}
+
+// Key for getting the voting proxy of a delegator from the store
+func KeyVotingProxy(delegatorAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("votingProxy:%x", delegatorAddr.Bytes()))
+}
+
+// Key for getting all the delegators of a voting proxy from the store
+func KeyProxiedDelegatorsSubspace(proxyAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("proxiedDelegators:%x:", proxyAddr.Bytes()))
+}
+
+// Key for indexing a delegator by its voting proxy
+func KeyProxiedDelegator(proxyAddr sdk.AccAddress, delegatorAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("proxiedDelegators:%x:%x", proxyAddr.Bytes(), delegatorAddr.Bytes()))
+}
+
 // Key for getting a specific proposal from the store
 func KeyProposal(proposalID int64) []byte {
 	return []byte(fmt.Sprintf("proposals:%d", proposalID))
@@ -30,6 +126,21 @@ func KeyVote(proposalID int64, voterAddr sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("votes:%d:%d", proposalID, voterAddr))
 }
 
+// Key for getting all the vote records of a specific voter on a proposal from the store
+func KeyVoteHistorySubspace(proposalID int64, voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("voteHistory:%d:%x:", proposalID, voterAddr.Bytes()))
+}
+
+// Key for getting a vote record of a specific voter on a proposal, the records are sorted by sequence
+func KeyVoteRecord(proposalID int64, voterAddr sdk.AccAddress, sequence int64) []byte {
+	return []byte(fmt.Sprintf("voteHistory:%d:%x:%020d", proposalID, voterAddr.Bytes(), sequence))
+}
+
+// Key for getting the number of vote records of a specific voter on a proposal from the store
+func KeyVoteHistoryCount(proposalID int64, voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("voteHistoryCount:%d:%x", proposalID, voterAddr.Bytes()))
+}
+
 // Key for getting all deposits on a proposal from the store
 func KeyDepositsSubspace(proposalID int64) []byte {
 	return []byte(fmt.Sprintf("deposits:%d:", proposalID))
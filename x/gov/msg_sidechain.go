@@ -26,6 +26,7 @@ type MsgSideChainSubmitProposal struct {
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
 	VotingPeriod   time.Duration  `json:"voting_period"`   //  Length of the voting period (s)
 	SideChainId    string         `json:"side_chain_id"`
+	Content        Content        `json:"content,omitempty"` //  Typed payload of the proposal, nil for text proposals
 }
 
 func NewMsgSideChainSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins, votingPeriod time.Duration, sideChainId string) MsgSideChainSubmitProposal {
@@ -76,7 +77,13 @@ func (msg MsgSideChainSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.VotingPeriod <= 0 || msg.VotingPeriod > MaxVotingPeriod {
 		return ErrInvalidVotingPeriod(DefaultCodespace, msg.VotingPeriod)
 	}
-	return nil
+	return validateContent(msg.ProposalType, msg.Content)
+}
+
+// WithContent sets the typed content of the proposal
+func (msg MsgSideChainSubmitProposal) WithContent(content Content) MsgSideChainSubmitProposal {
+	msg.Content = content
+	return msg
 }
 
 func (msg MsgSideChainSubmitProposal) String() string {
@@ -173,10 +180,11 @@ func (msg MsgSideChainDeposit) GetInvolvedAddresses() []sdk.AccAddress {
 // MsgSideChainVote
 
 type MsgSideChainVote struct {
-	ProposalID  int64          `json:"proposal_id"` // ID of the proposal
-	Voter       sdk.AccAddress `json:"voter"`       //  address of the voter
-	Option      VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
-	SideChainId string         `json:"side_chain_id"`
+	ProposalID  int64               `json:"proposal_id"` // ID of the proposal
+	Voter       sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Option      VoteOption          `json:"option"`      //  option from OptionSet chosen by the voter
+	SideChainId string              `json:"side_chain_id"`
+	Options     WeightedVoteOptions `json:"options,omitempty"` //  weighted options of a weighted vote, Option is empty then
 }
 
 func NewMsgSideChainVote(voter sdk.AccAddress, proposalID int64, option VoteOption, sideChainId string) MsgSideChainVote {
@@ -188,6 +196,15 @@ func NewMsgSideChainVote(voter sdk.AccAddress, proposalID int64, option VoteOpti
 	}
 }
 
+func NewMsgSideChainWeightedVote(voter sdk.AccAddress, proposalID int64, options WeightedVoteOptions, sideChainId string) MsgSideChainVote {
+	return MsgSideChainVote{
+		ProposalID:  proposalID,
+		Voter:       voter,
+		Options:     options,
+		SideChainId: sideChainId,
+	}
+}
+
 func (msg MsgSideChainVote) Route() string { return MsgRoute }
 func (msg MsgSideChainVote) Type() string  { return MsgTypeSideVote }
 
@@ -202,13 +219,13 @@ func (msg MsgSideChainVote) ValidateBasic() sdk.Error {
 	if msg.ProposalID < 0 {
 		return ErrUnknownProposal(DefaultCodespace, msg.ProposalID)
 	}
-	if !validVoteOption(msg.Option) {
-		return ErrInvalidVote(DefaultCodespace, msg.Option)
-	}
-	return nil
+	return validateVoteOptions(msg.Option, msg.Options)
 }
 
 func (msg MsgSideChainVote) String() string {
+	if len(msg.Options) != 0 {
+		return fmt.Sprintf("MsgSideChainVote{%v - %s, %s}", msg.ProposalID, msg.Options, msg.SideChainId)
+	}
 	return fmt.Sprintf("MsgSideChainVote{%v - %s, %s}", msg.ProposalID, msg.Option, msg.SideChainId)
 }
 
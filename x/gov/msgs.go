@@ -38,12 +38,13 @@ type DelistTradingPairParams struct {
 //-----------------------------------------------------------
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
-	Title          string         `json:"title"`           //  Title of the proposal
-	Description    string         `json:"description"`     //  Description of the proposal
-	ProposalType   ProposalKind   `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
-	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
-	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
-	VotingPeriod   time.Duration  `json:"voting_period"`   //  Length of the voting period (s)
+	Title          string         `json:"title"`             //  Title of the proposal
+	Description    string         `json:"description"`       //  Description of the proposal
+	ProposalType   ProposalKind   `json:"proposal_type"`     //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	Proposer       sdk.AccAddress `json:"proposer"`          //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"`   //  Initial deposit paid by sender. Must be strictly positive.
+	VotingPeriod   time.Duration  `json:"voting_period"`     //  Length of the voting period (s)
+	Content        Content        `json:"content,omitempty"` //  Typed payload of the proposal, nil for text proposals
 }
 
 func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins, votingPeriod time.Duration) MsgSubmitProposal {
@@ -90,7 +91,13 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.VotingPeriod <= 0 || msg.VotingPeriod > MaxVotingPeriod {
 		return ErrInvalidVotingPeriod(DefaultCodespace, msg.VotingPeriod)
 	}
-	return nil
+	return validateContent(msg.ProposalType, msg.Content)
+}
+
+// WithContent sets the typed content of the proposal
+func (msg MsgSubmitProposal) WithContent(content Content) MsgSubmitProposal {
+	msg.Content = content
+	return msg
 }
 
 func (msg MsgSubmitProposal) String() string {
@@ -188,9 +195,10 @@ func (msg MsgDeposit) GetInvolvedAddresses() []sdk.AccAddress {
 //-----------------------------------------------------------
 // MsgVote
 type MsgVote struct {
-	ProposalID int64          `json:"proposal_id"` // ID of the proposal
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	ProposalID int64               `json:"proposal_id"`       // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option"`            //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty"` //  weighted options of a weighted vote, Option is empty then
 }
 
 func NewMsgVote(voter sdk.AccAddress, proposalID int64, option VoteOption) MsgVote {
@@ -201,6 +209,15 @@ func NewMsgVote(voter sdk.AccAddress, proposalID int64, option VoteOption) MsgVo
 	}
 }
 
+// NewMsgWeightedVote splits the voting power of the voter across the options by weight
+func NewMsgWeightedVote(voter sdk.AccAddress, proposalID int64, options WeightedVoteOptions) MsgVote {
+	return MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
 // Implements Msg.
 // nolint
 func (msg MsgVote) Route() string { return MsgRoute }
@@ -214,13 +231,13 @@ func (msg MsgVote) ValidateBasic() sdk.Error {
 	if msg.ProposalID < 0 {
 		return ErrUnknownProposal(DefaultCodespace, msg.ProposalID)
 	}
-	if !validVoteOption(msg.Option) {
-		return ErrInvalidVote(DefaultCodespace, msg.Option)
-	}
-	return nil
+	return validateVoteOptions(msg.Option, msg.Options)
 }
 
 func (msg MsgVote) String() string {
+	if len(msg.Options) != 0 {
+		return fmt.Sprintf("MsgVote{%v - %s}", msg.ProposalID, msg.Options)
+	}
 	return fmt.Sprintf("MsgVote{%v - %s}", msg.ProposalID, msg.Option)
 }
 
@@ -0,0 +1,138 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalTypeParams overrides the min deposit and the tally params for a kind of proposals, e.g. removing a
+// validator may require a larger deposit and a higher quorum than a text proposal. The max deposit period stays
+// global since the inactive proposals are queued by submit time.
+type ProposalTypeParams struct {
+	ProposalType ProposalKind `json:"proposal_type"`
+	MinDeposit   sdk.Coins    `json:"min_deposit"`  //  Minimum deposit for a proposal of the type to enter voting period.
+	TallyParams  TallyParams  `json:"tally_params"` //  Quorum, threshold and veto for the proposals of the type.
+}
+
+func (p ProposalTypeParams) ValidateBasic() sdk.Error {
+	if !validProposalType(p.ProposalType) {
+		return ErrInvalidProposalType(DefaultCodespace, p.ProposalType)
+	}
+	if !p.MinDeposit.IsValid() || !p.MinDeposit.IsPositive() {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("min deposit %s of %s proposals is invalid", p.MinDeposit, p.ProposalType))
+	}
+	tally := p.TallyParams
+	if tally.Quorum.LTE(sdk.ZeroDec()) || tally.Quorum.GT(sdk.OneDec()) {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("quorum of %s proposals should be in (0, 1]", p.ProposalType))
+	}
+	if tally.Threshold.LT(sdk.NewDecWithPrec(5, 1)) || tally.Threshold.GTE(sdk.OneDec()) {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("threshold of %s proposals should be in [0.5, 1)", p.ProposalType))
+	}
+	if tally.Veto.LTE(sdk.ZeroDec()) || tally.Veto.GT(sdk.OneDec()) {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("veto of %s proposals should be in (0, 1]", p.ProposalType))
+	}
+	return nil
+}
+
+// validateProposalTypeParams validates a list of params, each proposal type shows up once at most
+func validateProposalTypeParams(params []ProposalTypeParams) sdk.Error {
+	usedTypes := make(map[ProposalKind]bool)
+	for _, p := range params {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if usedTypes[p.ProposalType] {
+			return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("duplicated params of %s proposals", p.ProposalType))
+		}
+		usedTypes[p.ProposalType] = true
+	}
+	return nil
+}
+
+// Returns the params overriding the global ones by proposal type
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context) []ProposalTypeParams {
+	params := make([]ProposalTypeParams, 0)
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyProposalTypeParams, &params)
+	return params
+}
+
+// nolint: errcheck
+func (keeper Keeper) SetProposalTypeParams(ctx sdk.Context, params []ProposalTypeParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyProposalTypeParams, params)
+}
+
+func (keeper Keeper) getProposalTypeParams(ctx sdk.Context, proposalType ProposalKind) (ProposalTypeParams, bool) {
+	if !sdk.IsUpgrade(sdk.GovProposalTypeParams) {
+		return ProposalTypeParams{}, false
+	}
+	for _, p := range keeper.GetProposalTypeParams(ctx) {
+		if p.ProposalType == proposalType {
+			return p, true
+		}
+	}
+	return ProposalTypeParams{}, false
+}
+
+// Returns the deposit params for a proposal type, the global params are used if the type has no params of its own
+func (keeper Keeper) GetDepositParamsByType(ctx sdk.Context, proposalType ProposalKind) DepositParams {
+	depositParams := keeper.GetDepositParams(ctx)
+	if p, found := keeper.getProposalTypeParams(ctx, proposalType); found {
+		depositParams.MinDeposit = p.MinDeposit
+	}
+	return depositParams
+}
+
+// Returns the tally params for a proposal type, the global params are used if the type has no params of its own
+func (keeper Keeper) GetTallyParamsByType(ctx sdk.Context, proposalType ProposalKind) TallyParams {
+	if p, found := keeper.getProposalTypeParams(ctx, proposalType); found {
+		return p.TallyParams
+	}
+	return keeper.GetTallyParams(ctx)
+}
+
+// -----------------------------------------------------------
+// GovParamsChangeContent sets the params of some proposal types, the params of other types are left unchanged
+type GovParamsChangeContent struct {
+	Params []ProposalTypeParams `json:"params"`
+}
+
+var _ Content = GovParamsChangeContent{}
+
+func (c GovParamsChangeContent) ProposalType() ProposalKind { return ProposalTypeGovParamsChange }
+func (c GovParamsChangeContent) Payload() interface{}       { return c.Params }
+func (c GovParamsChangeContent) ValidateBasic() sdk.Error {
+	if len(c.Params) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "no proposal type params")
+	}
+	return validateProposalTypeParams(c.Params)
+}
+
+func handleGovParamsChangeContent(keeper Keeper) ContentHandler {
+	return func(ctx sdk.Context, proposal Proposal, content Content) sdk.Error {
+		if !sdk.IsUpgrade(sdk.GovProposalTypeParams) {
+			return ErrInvalidProposalContent(keeper.codespace, "proposal type params are not supported yet")
+		}
+		changes, ok := content.Payload().([]ProposalTypeParams)
+		if !ok {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("unexpected content %T", content))
+		}
+
+		params := keeper.GetProposalTypeParams(ctx)
+		for _, change := range changes {
+			updated := false
+			for i := range params {
+				if params[i].ProposalType == change.ProposalType {
+					params[i] = change
+					updated = true
+					break
+				}
+			}
+			if !updated {
+				params = append(params, change)
+			}
+		}
+		keeper.SetProposalTypeParams(ctx, params)
+		return nil
+	}
+}
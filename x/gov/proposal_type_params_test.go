@@ -0,0 +1,133 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestProposalTypeParamsValidateBasic(t *testing.T) {
+	params := gov.ProposalTypeParams{
+		ProposalType: gov.ProposalTypeRemoveValidator,
+		MinDeposit:   sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 5000e8)},
+		TallyParams: gov.TallyParams{
+			Quorum:    sdk.NewDecWithPrec(6, 1),
+			Threshold: sdk.NewDecWithPrec(66666667, 8),
+			Veto:      sdk.NewDecWithPrec(334, 3),
+		},
+	}
+	require.Nil(t, params.ValidateBasic())
+
+	invalid := params
+	invalid.ProposalType = gov.ProposalKind(0xff)
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = params
+	invalid.MinDeposit = sdk.Coins{}
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = params
+	invalid.TallyParams.Quorum = sdk.ZeroDec()
+	require.NotNil(t, invalid.ValidateBasic())
+	invalid = params
+	invalid.TallyParams.Threshold = sdk.NewDecWithPrec(4, 1)
+	require.NotNil(t, invalid.ValidateBasic())
+
+	content := gov.GovParamsChangeContent{Params: []gov.ProposalTypeParams{params, params}}
+	require.NotNil(t, content.ValidateBasic())
+	content.Params = content.Params[:1]
+	require.Nil(t, content.ValidateBasic())
+	require.NotNil(t, gov.GovParamsChangeContent{}.ValidateBasic())
+}
+
+func TestProposalTypeParams(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 4)
+	for _, upgrade := range []string{sdk.TypedProposalContent, sdk.WeightedVote, sdk.GovProposalTypeParams} {
+		sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 1)
+		defer sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 0)
+	}
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 10 * time.Second
+	submit := func(proposer sdk.AccAddress, proposalType gov.ProposalKind, content gov.Content, deposit int64) int64 {
+		msg := gov.NewMsgSubmitProposal("Test", "test", proposalType, proposer,
+			sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, deposit)}, votingPeriod).WithContent(content)
+		res := govHandler(ctx, msg)
+		require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+		proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+		return proposalID
+	}
+	vote := func(proposalID int64, options gov.WeightedVoteOptions) {
+		res := govHandler(ctx, gov.NewMsgWeightedVote(addrs[0], proposalID, options))
+		require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+	}
+	endBlock := func() {
+		header := ctx.BlockHeader()
+		header.Time = header.Time.Add(votingPeriod)
+		ctx = ctx.WithBlockHeader(header)
+		gov.EndBlocker(ctx, keeper)
+	}
+	status := func(proposalID int64) gov.ProposalStatus {
+		return keeper.GetProposal(ctx, proposalID).GetStatus()
+	}
+
+	// text proposals need a larger deposit and a supermajority
+	textParams := gov.ProposalTypeParams{
+		ProposalType: gov.ProposalTypeText,
+		MinDeposit:   sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 3000e8)},
+		TallyParams: gov.TallyParams{
+			Quorum:    sdk.NewDecWithPrec(5, 1),
+			Threshold: sdk.NewDecWithPrec(9, 1),
+			Veto:      sdk.NewDecWithPrec(334, 3),
+		},
+	}
+	changeID := submit(addrs[0], gov.ProposalTypeGovParamsChange, gov.GovParamsChangeContent{Params: []gov.ProposalTypeParams{textParams}}, 2000e8)
+	vote(changeID, gov.NewNonSplitVoteOption(gov.OptionYes))
+	endBlock()
+	require.Equal(t, gov.StatusPassed, status(changeID))
+	require.Equal(t, []gov.ProposalTypeParams{textParams}, keeper.GetProposalTypeParams(ctx))
+	require.Equal(t, textParams.TallyParams, keeper.GetTallyParamsByType(ctx, gov.ProposalTypeText))
+	require.Equal(t, keeper.GetTallyParams(ctx), keeper.GetTallyParamsByType(ctx, gov.ProposalTypeFeeChange))
+	require.Equal(t, keeper.GetDepositParams(ctx), keeper.GetDepositParamsByType(ctx, gov.ProposalTypeFeeChange))
+
+	// the global min deposit is not enough for text proposals any more
+	pendingID := submit(addrs[1], gov.ProposalTypeText, nil, 2000e8)
+	require.Equal(t, gov.StatusDepositPeriod, status(pendingID))
+
+	// 70% of Yes votes is below the threshold of text proposals
+	rejectedID := submit(addrs[2], gov.ProposalTypeText, nil, 3000e8)
+	require.Equal(t, gov.StatusVotingPeriod, status(rejectedID))
+	vote(rejectedID, gov.WeightedVoteOptions{
+		{Option: gov.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: gov.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+	})
+	endBlock()
+	require.Equal(t, gov.StatusRejected, status(rejectedID))
+
+	querier := gov.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{gov.QueryProposalTypeParams}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var queried []gov.ProposalTypeParams
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &queried))
+	require.Equal(t, []gov.ProposalTypeParams{textParams}, queried)
+
+	// the params are exported and imported with the genesis
+	genesis := gov.WriteGenesis(ctx, keeper)
+	require.Equal(t, []gov.ProposalTypeParams{textParams}, genesis.ProposalTypeParams)
+}
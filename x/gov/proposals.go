@@ -42,6 +42,9 @@ type Proposal interface {
 
 	GetVotingPeriod() time.Duration
 	SetVotingPeriod(time.Duration)
+
+	GetContent() Content
+	SetContent(Content)
 }
 
 // checks if two proposals are equal
@@ -77,6 +80,8 @@ type TextProposal struct {
 	TotalDeposit sdk.Coins `json:"total_deposit"` //  Current deposit on this proposal. Initial value is set at InitialDeposit
 
 	VotingStartTime time.Time `json:"voting_start_time"` //  Height of the block where MinDeposit was reached. -1 if MinDeposit is not reached
+
+	Content Content `json:"content,omitempty"` //  Typed payload of the proposal, nil for text proposals and legacy proposals with JSON description
 }
 
 // Implements Proposal Interface
@@ -107,6 +112,8 @@ func (tp TextProposal) GetVotingPeriod() time.Duration { return tp.VotingPeriod
 func (tp *TextProposal) SetVotingPeriod(votingPeriod time.Duration) {
 	tp.VotingPeriod = votingPeriod
 }
+func (tp TextProposal) GetContent() Content         { return tp.Content }
+func (tp *TextProposal) SetContent(content Content) { tp.Content = content }
 
 //-----------------------------------------------------------
 // ProposalQueue
@@ -131,6 +138,8 @@ const (
 	ProposalTypeRemoveValidator      ProposalKind = 0x07
 	ProposalTypeDelistTradingPair    ProposalKind = 0x08
 	ProposalTypeManageChanPermission ProposalKind = 0x09
+	ProposalTypeEmergencyVeto        ProposalKind = 0x0A
+	ProposalTypeGovParamsChange      ProposalKind = 0x0B
 )
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -158,6 +167,10 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeCSCParamsChange, nil
 	case "ManageChanPermission":
 		return ProposalTypeManageChanPermission, nil
+	case "EmergencyVeto":
+		return ProposalTypeEmergencyVeto, nil
+	case "GovParamsChange":
+		return ProposalTypeGovParamsChange, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -173,7 +186,9 @@ func validProposalType(pt ProposalKind) bool {
 		pt == ProposalTypeCreateValidator ||
 		pt == ProposalTypeRemoveValidator ||
 		pt == ProposalTypeDelistTradingPair ||
-		pt == ProposalTypeManageChanPermission {
+		pt == ProposalTypeManageChanPermission ||
+		pt == ProposalTypeEmergencyVeto ||
+		pt == ProposalTypeGovParamsChange {
 		return true
 	}
 	return false
@@ -236,6 +251,10 @@ func (pt ProposalKind) String() string {
 		return "CSCParamsChange"
 	case ProposalTypeManageChanPermission:
 		return "ManageChanPermission"
+	case ProposalTypeEmergencyVeto:
+		return "EmergencyVeto"
+	case ProposalTypeGovParamsChange:
+		return "GovParamsChange"
 	default:
 		return ""
 	}
@@ -267,6 +286,9 @@ const (
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
 	StatusExecuted      ProposalStatus = 0x05
+	StatusFailed        ProposalStatus = 0x06
+	StatusQueued        ProposalStatus = 0x07
+	StatusCancelled     ProposalStatus = 0x08
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -282,6 +304,12 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusRejected, nil
 	case "Executed":
 		return StatusExecuted, nil
+	case "Failed":
+		return StatusFailed, nil
+	case "Queued":
+		return StatusQueued, nil
+	case "Cancelled":
+		return StatusCancelled, nil
 	case "":
 		return StatusNil, nil
 	default:
@@ -295,7 +323,10 @@ func validProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusExecuted {
+		status == StatusExecuted ||
+		status == StatusFailed ||
+		status == StatusQueued ||
+		status == StatusCancelled {
 		return true
 	}
 	return false
@@ -346,6 +377,12 @@ func (status ProposalStatus) String() string {
 		return "Rejected"
 	case StatusExecuted:
 		return "Executed"
+	case StatusFailed:
+		return "Failed"
+	case StatusQueued:
+		return "Queued"
+	case StatusCancelled:
+		return "Cancelled"
 	default:
 		return ""
 	}
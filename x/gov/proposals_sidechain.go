@@ -11,7 +11,8 @@ const (
 func validSideProposalType(pt ProposalKind) bool {
 	if pt == ProposalTypeText ||
 		pt == ProposalTypeSCParamsChange ||
-		pt == ProposalTypeCSCParamsChange {
+		pt == ProposalTypeCSCParamsChange ||
+		pt == ProposalTypeEmergencyVeto {
 		return true
 	}
 	return false
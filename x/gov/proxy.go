@@ -0,0 +1,287 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/events"
+	"github.com/cosmos/cosmos-sdk/x/gov/tags"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+// MaxVotingProxyDepth bounds the number of proxies between a delegator and the one voting for it
+const MaxVotingProxyDepth = 3
+
+// VotingProxy assigns the governance voting power of a delegator to another address, the delegations are not touched.
+// The proxies set for a side chain take precedence over the global ones when tallying the proposals of the side chain.
+type VotingProxy struct {
+	Delegator sdk.AccAddress `json:"delegator"`
+	Proxy     sdk.AccAddress `json:"proxy"`
+}
+
+func (p VotingProxy) String() string {
+	return fmt.Sprintf("VotingProxy{%s -> %s}", p.Delegator, p.Proxy)
+}
+
+//-----------------------------------------------------------
+// MsgSetVotingProxy sets the voting proxy of the delegator, the proxy is removed if it is empty.
+// The proxy is global if the side chain id is empty.
+type MsgSetVotingProxy struct {
+	Delegator   sdk.AccAddress `json:"delegator"`
+	Proxy       sdk.AccAddress `json:"proxy"`
+	SideChainId string         `json:"side_chain_id"`
+}
+
+func NewMsgSetVotingProxy(delegator, proxy sdk.AccAddress, sideChainId string) MsgSetVotingProxy {
+	return MsgSetVotingProxy{
+		Delegator:   delegator,
+		Proxy:       proxy,
+		SideChainId: sideChainId,
+	}
+}
+
+// nolint
+func (msg MsgSetVotingProxy) Route() string { return MsgRoute }
+func (msg MsgSetVotingProxy) Type() string  { return "set_voting_proxy" }
+
+func (msg MsgSetVotingProxy) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("length of address(%s) should be %d", string(msg.Delegator), sdk.AddrLen))
+	}
+	if len(msg.Proxy) != 0 && len(msg.Proxy) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("length of address(%s) should be %d", string(msg.Proxy), sdk.AddrLen))
+	}
+	if msg.Delegator.Equals(msg.Proxy) {
+		return ErrInvalidVotingProxy(DefaultCodespace, "a delegator can not be its own proxy")
+	}
+	if len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidSideChainId(DefaultCodespace, msg.SideChainId)
+	}
+	return nil
+}
+
+func (msg MsgSetVotingProxy) String() string {
+	return fmt.Sprintf("MsgSetVotingProxy{%s -> %s, %s}", msg.Delegator, msg.Proxy, msg.SideChainId)
+}
+
+func (msg MsgSetVotingProxy) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+func (msg MsgSetVotingProxy) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSetVotingProxy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+func (msg MsgSetVotingProxy) GetInvolvedAddresses() []sdk.AccAddress {
+	if len(msg.Proxy) == 0 {
+		return msg.GetSigners()
+	}
+	return []sdk.AccAddress{msg.Delegator, msg.Proxy}
+}
+
+func handleMsgSetVotingProxy(ctx sdk.Context, keeper Keeper, msg MsgSetVotingProxy) sdk.Result {
+	if !sdk.IsUpgrade(sdk.GovVotingProxy) {
+		return sdk.ErrMsgNotSupported("voting proxy is not supported yet").Result()
+	}
+	if len(msg.SideChainId) != 0 {
+		scCtx, err := keeper.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId)
+		if err != nil {
+			return ErrInvalidSideChainId(keeper.codespace, msg.SideChainId).Result()
+		}
+		ctx = scCtx
+	}
+
+	if len(msg.Proxy) == 0 {
+		keeper.DeleteVotingProxy(ctx, msg.Delegator)
+	} else if err := keeper.SetVotingProxy(ctx, msg.Delegator, msg.Proxy); err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionSetVotingProxy,
+		tags.Delegator, []byte(msg.Delegator.String()),
+		tags.VotingProxy, []byte(msg.Proxy.String()),
+	)
+	if len(msg.SideChainId) != 0 {
+		resTags = resTags.AppendTag(events.SideChainID, []byte(msg.SideChainId))
+	}
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+//-----------------------------------------------------------
+// GetVotingProxy returns the voting proxy of the delegator set in the store of the context
+func (keeper Keeper) GetVotingProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) (proxyAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyVotingProxy(delegatorAddr))
+	if bz == nil {
+		return nil, false
+	}
+	var proxy VotingProxy
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proxy)
+	return proxy.Proxy, true
+}
+
+// getEffectiveVotingProxy returns the proxy of the side chain of the context if it is set, otherwise the global one
+func (keeper Keeper) getEffectiveVotingProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) (sdk.AccAddress, bool) {
+	if proxyAddr, found := keeper.GetVotingProxy(ctx, delegatorAddr); found {
+		return proxyAddr, true
+	}
+	if ctx.SideChainKeyPrefix() == nil {
+		return nil, false
+	}
+	return keeper.GetVotingProxy(ctx.DepriveSideChainKeyPrefix(), delegatorAddr)
+}
+
+// GetVotingProxyChain returns the proxies a delegator is represented by, the nearest first
+func (keeper Keeper) GetVotingProxyChain(ctx sdk.Context, delegatorAddr sdk.AccAddress) []VotingProxy {
+	chain := make([]VotingProxy, 0)
+	visited := map[string]bool{delegatorAddr.String(): true}
+	addr := delegatorAddr
+	for len(chain) < MaxVotingProxyDepth {
+		proxyAddr, found := keeper.getEffectiveVotingProxy(ctx, addr)
+		if !found || visited[proxyAddr.String()] {
+			break
+		}
+		chain = append(chain, VotingProxy{Delegator: addr, Proxy: proxyAddr})
+		visited[proxyAddr.String()] = true
+		addr = proxyAddr
+	}
+	return chain
+}
+
+// SetVotingProxy sets the voting proxy of the delegator in the store of the context, the proxies can not form a cycle
+// and no delegator may be represented through more than MaxVotingProxyDepth proxies.
+func (keeper Keeper) SetVotingProxy(ctx sdk.Context, delegatorAddr, proxyAddr sdk.AccAddress) sdk.Error {
+	depth := 1
+	for addr := proxyAddr; ; depth++ {
+		if addr.Equals(delegatorAddr) {
+			return ErrInvalidVotingProxy(keeper.codespace, fmt.Sprintf("%s is already represented by %s", proxyAddr, delegatorAddr))
+		}
+		next, found := keeper.getEffectiveVotingProxy(ctx, addr)
+		if !found {
+			break
+		}
+		if depth >= MaxVotingProxyDepth {
+			return ErrInvalidVotingProxy(keeper.codespace, fmt.Sprintf("proxy chain is longer than %d", MaxVotingProxyDepth))
+		}
+		addr = next
+	}
+	if depth+keeper.proxiedDepth(ctx, delegatorAddr, MaxVotingProxyDepth) > MaxVotingProxyDepth {
+		return ErrInvalidVotingProxy(keeper.codespace, fmt.Sprintf("proxy chain is longer than %d", MaxVotingProxyDepth))
+	}
+
+	keeper.DeleteVotingProxy(ctx, delegatorAddr)
+	store := ctx.KVStore(keeper.storeKey)
+	proxy := VotingProxy{Delegator: delegatorAddr, Proxy: proxyAddr}
+	store.Set(KeyVotingProxy(delegatorAddr), keeper.cdc.MustMarshalBinaryLengthPrefixed(proxy))
+	store.Set(KeyProxiedDelegator(proxyAddr, delegatorAddr), keeper.cdc.MustMarshalBinaryLengthPrefixed(delegatorAddr))
+	return nil
+}
+
+// proxiedDepth returns the length of the longest chain of delegators represented by the address in the store of the context
+func (keeper Keeper) proxiedDepth(ctx sdk.Context, proxyAddr sdk.AccAddress, limit int) int {
+	if limit == 0 {
+		return 0
+	}
+	depth := 0
+	for _, delegatorAddr := range keeper.GetProxiedDelegators(ctx, proxyAddr) {
+		if d := 1 + keeper.proxiedDepth(ctx, delegatorAddr, limit-1); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// DeleteVotingProxy removes the voting proxy of the delegator from the store of the context
+func (keeper Keeper) DeleteVotingProxy(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	proxyAddr, found := keeper.GetVotingProxy(ctx, delegatorAddr)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyVotingProxy(delegatorAddr))
+	store.Delete(KeyProxiedDelegator(proxyAddr, delegatorAddr))
+}
+
+// GetProxiedDelegators returns the delegators represented by the proxy in the store of the context
+func (keeper Keeper) GetProxiedDelegators(ctx sdk.Context, proxyAddr sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyProxiedDelegatorsSubspace(proxyAddr))
+	defer iterator.Close()
+
+	delegators := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var delegatorAddr sdk.AccAddress
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &delegatorAddr)
+		delegators = append(delegators, delegatorAddr)
+	}
+	return delegators
+}
+
+// IsVotingProxy returns whether the address represents any delegator in the side chain of the context or globally
+func (keeper Keeper) IsVotingProxy(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if keeper.hasProxiedDelegators(ctx, addr) {
+		return true
+	}
+	return ctx.SideChainKeyPrefix() != nil && keeper.hasProxiedDelegators(ctx.DepriveSideChainKeyPrefix(), addr)
+}
+
+func (keeper Keeper) hasProxiedDelegators(ctx sdk.Context, proxyAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyProxiedDelegatorsSubspace(proxyAddr))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// IterateVotingProxies iterates through the voting proxies in the store of the context
+func (keeper Keeper) IterateVotingProxies(ctx sdk.Context, fn func(proxy VotingProxy) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyVotingProxiesSubspace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proxy VotingProxy
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proxy)
+		if fn(proxy) {
+			break
+		}
+	}
+}
+
+// iterateProxiedDelegators iterates through the delegators having a voting proxy in the side chain of the context or
+// globally, each delegator once
+func (keeper Keeper) iterateProxiedDelegators(ctx sdk.Context, fn func(delegatorAddr sdk.AccAddress)) {
+	keeper.IterateVotingProxies(ctx, func(proxy VotingProxy) bool {
+		fn(proxy.Delegator)
+		return false
+	})
+	if ctx.SideChainKeyPrefix() == nil {
+		return
+	}
+	keeper.IterateVotingProxies(ctx.DepriveSideChainKeyPrefix(), func(proxy VotingProxy) bool {
+		if _, found := keeper.GetVotingProxy(ctx, proxy.Delegator); !found {
+			fn(proxy.Delegator)
+		}
+		return false
+	})
+}
+
+// resolveProxyVote returns the vote of the nearest proxy of the delegator who voted
+func (keeper Keeper) resolveProxyVote(ctx sdk.Context, delegatorAddr sdk.AccAddress, votes map[string]Vote) (Vote, bool) {
+	for _, proxy := range keeper.GetVotingProxyChain(ctx, delegatorAddr) {
+		if vote, ok := votes[proxy.Proxy.String()]; ok {
+			return vote, true
+		}
+	}
+	return Vote{}, false
+}
@@ -0,0 +1,106 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestMsgSetVotingProxyValidateBasic(t *testing.T) {
+	_, addrs := mock.GeneratePrivKeyAddressPairs(2)
+	require.Nil(t, gov.NewMsgSetVotingProxy(addrs[0], addrs[1], "").ValidateBasic())
+	require.Nil(t, gov.NewMsgSetVotingProxy(addrs[0], nil, "bsc").ValidateBasic())
+	require.NotNil(t, gov.NewMsgSetVotingProxy(addrs[0], addrs[0], "").ValidateBasic())
+	require.NotNil(t, gov.NewMsgSetVotingProxy(nil, addrs[1], "").ValidateBasic())
+	require.NotNil(t, gov.NewMsgSetVotingProxy(addrs[0], addrs[1], "a-side-chain-id-too-long").ValidateBasic())
+}
+
+func TestVotingProxy(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 8)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	validator, _ = stakeKeeper.GetValidator(ctx, validator.OperatorAddr)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[2]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	govHandler := gov.NewHandler(keeper)
+	setProxy := func(delegator, proxy sdk.AccAddress) sdk.Result {
+		return govHandler(ctx, gov.NewMsgSetVotingProxy(delegator, proxy, ""))
+	}
+
+	// voting proxies are not accepted before the upgrade
+	require.False(t, setProxy(addrs[1], addrs[3]).IsOK())
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.GovVotingProxy, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.GovVotingProxy, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	res := setProxy(addrs[1], addrs[3])
+	require.True(t, res.IsOK(), "expected set voting proxy msg to be ok, got: %v", res)
+
+	// the proxies can not form a cycle, and the chains are bounded
+	require.Equal(t, sdk.ToABCICode(gov.DefaultCodespace, gov.CodeInvalidVotingProxy), setProxy(addrs[3], addrs[1]).Code)
+	require.True(t, setProxy(addrs[3], addrs[4]).IsOK())
+	require.True(t, setProxy(addrs[4], addrs[5]).IsOK())
+	require.Equal(t, sdk.ToABCICode(gov.DefaultCodespace, gov.CodeInvalidVotingProxy), setProxy(addrs[5], addrs[6]).Code)
+	require.Equal(t, sdk.ToABCICode(gov.DefaultCodespace, gov.CodeInvalidVotingProxy), setProxy(addrs[7], addrs[1]).Code)
+	require.True(t, setProxy(addrs[4], nil).IsOK())
+	require.True(t, setProxy(addrs[7], addrs[1]).IsOK())
+
+	querier := gov.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{gov.QueryVotingProxy}, abci.RequestQuery{
+		Data: mapp.Cdc.MustMarshalJSON(gov.QueryVotingProxyParams{Address: addrs[7]}),
+	})
+	require.Nil(t, err)
+	var chain []gov.VotingProxy
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &chain))
+	require.Equal(t, []gov.VotingProxy{
+		{Delegator: addrs[7], Proxy: addrs[1]},
+		{Delegator: addrs[1], Proxy: addrs[3]},
+		{Delegator: addrs[3], Proxy: addrs[4]},
+	}, chain)
+
+	msg := gov.NewMsgSubmitProposal("Test", "test", gov.ProposalTypeText, addrs[0],
+		sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, 1000*time.Second)
+	res = govHandler(ctx, msg)
+	require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+	proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+
+	// only the validators and the proxies can vote
+	require.False(t, govHandler(ctx, gov.NewMsgVote(addrs[6], proposalID, gov.OptionNo)).IsOK())
+	require.True(t, govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionYes)).IsOK())
+	require.True(t, govHandler(ctx, gov.NewMsgVote(addrs[4], proposalID, gov.OptionNo)).IsOK())
+
+	// addrs[1] is represented by addrs[4] through addrs[3], addrs[2] inherits the vote of the validator
+	_, _, tallyResults := gov.Tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	power := validatorPower(ctx, stakeKeeper, validator)
+	require.True(t, power.GT(sdk.ZeroDec()))
+	require.Equal(t, power.Mul(sdk.NewDecWithPrec(5, 1)), tallyResults.Yes)
+	require.Equal(t, power.Mul(sdk.NewDecWithPrec(5, 1)), tallyResults.No)
+
+	// the nearest proxy who voted represents the delegator
+	require.True(t, govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionYes)).IsOK())
+	require.True(t, govHandler(ctx, gov.NewMsgVote(addrs[4], proposalID, gov.OptionNo)).IsOK())
+	require.True(t, govHandler(ctx, gov.NewMsgVote(addrs[3], proposalID, gov.OptionAbstain)).IsOK())
+	_, _, tallyResults = gov.Tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	require.Equal(t, power.Mul(sdk.NewDecWithPrec(5, 1)), tallyResults.Abstain)
+	require.True(t, tallyResults.No.IsZero())
+
+	// the global proxies are exported with the genesis
+	genesis := gov.WriteGenesis(ctx, keeper)
+	require.Len(t, genesis.VotingProxies, 3)
+}
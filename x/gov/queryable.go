@@ -1,6 +1,9 @@
 package gov
 
 import (
+	"fmt"
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +20,21 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+
+	QueryVoteHistory   = "voteHistory"
+	QueryTimelockQueue = "timelockQueue"
+
+	QueryProposalTypeParams = "proposalTypeParams"
+
+	QueryIndexedProposals = "indexedProposals"
+	QueryArchivedVotes    = "archivedVotes"
+	QueryTallyBreakdown   = "tallyBreakdown"
+
+	QueryVotingProxy       = "votingProxy"
+	QueryProxiedDelegators = "proxiedDelegators"
+
+	QueryUpgradePlan         = "upgradePlan"
+	QueryAppliedUpgradePlans = "appliedUpgradePlans"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -64,6 +82,27 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 				return res, err
 			}
 			return queryVote(ctx, path[1:], req, p, keeper)
+		case QueryTimelockQueue:
+			p := new(BaseParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryTimelockQueue(ctx, keeper)
+		case QueryProposalTypeParams:
+			p := new(BaseParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryProposalTypeParams(ctx, keeper)
+		case QueryVoteHistory:
+			p := new(QueryVoteParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryVoteHistory(ctx, p, keeper)
 		case QueryTally:
 			p := new(QueryTallyParams)
 			ctx, err = RequestPrepare(ctx, keeper, req, p)
@@ -71,6 +110,51 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 				return res, err
 			}
 			return queryTally(ctx, path[1:], req, p, keeper)
+		case QueryIndexedProposals:
+			p := new(QueryIndexedProposalsParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryIndexedProposals(ctx, p, keeper)
+		case QueryArchivedVotes:
+			p := new(QueryArchivedVotesParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryArchivedVotes(ctx, p, keeper)
+		case QueryTallyBreakdown:
+			p := new(QueryTallyParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryTallyBreakdown(ctx, p, keeper)
+		case QueryVotingProxy:
+			p := new(QueryVotingProxyParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryVotingProxy(ctx, p, keeper)
+		case QueryProxiedDelegators:
+			p := new(QueryVotingProxyParams)
+			ctx, err = RequestPrepare(ctx, keeper, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryProxiedDelegators(ctx, p, keeper)
+		case QueryUpgradePlan:
+			return queryUpgradePlan(ctx, keeper)
+		case QueryAppliedUpgradePlans:
+			p := new(QueryAppliedUpgradePlansParams)
+			if len(req.Data) != 0 {
+				if errRes := keeper.cdc.UnmarshalJSON(req.Data, p); errRes != nil {
+					return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("can not unmarshal request", errRes.Error()))
+				}
+			}
+			return queryAppliedUpgradePlans(ctx, p, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -132,6 +216,36 @@ func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, params *Qu
 	return bz, nil
 }
 
+func queryVoteHistory(ctx sdk.Context, params *QueryVoteParams, keeper Keeper) (res []byte, err sdk.Error) {
+	records := keeper.GetVoteHistory(ctx, params.ProposalID, params.Voter)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryTimelockQueue(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	queue := make([]QueuedProposal, 0)
+	keeper.IterateQueuedProposals(ctx, func(queued QueuedProposal) bool {
+		queue = append(queue, queued)
+		return false
+	})
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, queue)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryProposalTypeParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, keeper.GetProposalTypeParams(ctx))
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
 // Params for query 'custom/gov/deposits'
 type QueryDepositsParams struct {
 	BaseParams
@@ -219,7 +333,8 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, params *Q
 
 	if proposal.GetStatus() == StatusDepositPeriod {
 		tallyResult = EmptyTallyResult()
-	} else if proposal.GetStatus() == StatusPassed || proposal.GetStatus() == StatusRejected {
+	} else if proposal.GetStatus() == StatusPassed || proposal.GetStatus() == StatusRejected || proposal.GetStatus() == StatusFailed ||
+		proposal.GetStatus() == StatusQueued || proposal.GetStatus() == StatusCancelled {
 		tallyResult = proposal.GetTallyResult()
 	} else {
 		_, _, tallyResult = Tally(ctx, keeper, proposal)
@@ -258,6 +373,187 @@ func prepareSideChainCtx(ctx sdk.Context, k Keeper, sideChainId string) (sdk.Con
 	return scCtx, nil
 }
 
+func queryUpgradePlan(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
+		return nil, nil
+	}
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, plan)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/appliedUpgradePlans'
+type QueryAppliedUpgradePlansParams struct {
+	Name string // optional, all the applied plans are returned if empty
+}
+
+func queryAppliedUpgradePlans(ctx sdk.Context, params *QueryAppliedUpgradePlansParams, keeper Keeper) (res []byte, err sdk.Error) {
+	plans := make([]UpgradePlan, 0)
+	if len(params.Name) != 0 {
+		if plan, found := keeper.GetAppliedUpgradePlan(ctx, params.Name); found {
+			plans = append(plans, plan)
+		}
+	} else {
+		keeper.IterateAppliedUpgradePlans(ctx, func(plan UpgradePlan) bool {
+			plans = append(plans, plan)
+			return false
+		})
+	}
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, plans)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+const (
+	defaultIndexQueryLimit = 30
+	maxIndexQueryLimit     = 100
+)
+
+// defines the pagination of the indexed queries, Page starts from 1
+type PageParams struct {
+	Page  int
+	Limit int
+}
+
+// returns the number of elements to skip and the size of the requested page
+func (p PageParams) window() (offset, limit int, err sdk.Error) {
+	page, limit := p.Page, p.Limit
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultIndexQueryLimit
+	}
+	if page < 0 {
+		return 0, 0, sdk.ErrUnknownRequest("page must be positive")
+	}
+	if limit < 0 || limit > maxIndexQueryLimit {
+		return 0, 0, sdk.ErrUnknownRequest(fmt.Sprintf("limit must be between 1 and %d", maxIndexQueryLimit))
+	}
+	return (page - 1) * limit, limit, nil
+}
+
+// Params for query 'custom/gov/indexedProposals', the zero fields match all proposals
+type QueryIndexedProposalsParams struct {
+	BaseParams
+	PageParams
+	ProposalType ProposalKind `json:",omitempty"` // the nil proposal type can not be decoded
+	Status       ProposalStatus
+	Voter        sdk.AccAddress
+	Depositor    sdk.AccAddress
+	SubmitAfter  time.Time // inclusive
+	SubmitBefore time.Time // exclusive
+	Reverse      bool      // the latest proposals first
+}
+
+func queryIndexedProposals(ctx sdk.Context, params *QueryIndexedProposalsParams, keeper Keeper) (res []byte, err sdk.Error) {
+	offset, limit, err := params.window()
+	if err != nil {
+		return nil, err
+	}
+	filter := ProposalFilter{
+		ProposalType: params.ProposalType,
+		Status:       params.Status,
+		Voter:        params.Voter,
+		Depositor:    params.Depositor,
+		SubmitAfter:  params.SubmitAfter,
+		SubmitBefore: params.SubmitBefore,
+	}
+
+	proposals := make([]Proposal, 0, limit)
+	keeper.IterateIndexedProposals(ctx, filter, params.Reverse, func(proposal Proposal) bool {
+		if offset > 0 {
+			offset--
+			return false
+		}
+		proposals = append(proposals, proposal)
+		return len(proposals) >= limit
+	})
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, proposals)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/gov/archivedVotes'
+type QueryArchivedVotesParams struct {
+	BaseParams
+	PageParams
+	ProposalID int64
+}
+
+func queryArchivedVotes(ctx sdk.Context, params *QueryArchivedVotesParams, keeper Keeper) (res []byte, err sdk.Error) {
+	offset, limit, err := params.window()
+	if err != nil {
+		return nil, err
+	}
+	votes := keeper.GetArchivedVotes(ctx, params.ProposalID)
+	start, end := offset, offset+limit
+	if start > len(votes) {
+		start = len(votes)
+	}
+	if end > len(votes) {
+		end = len(votes)
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, votes[start:end])
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryTallyBreakdown(ctx sdk.Context, params *QueryTallyParams, keeper Keeper) (res []byte, err sdk.Error) {
+	if keeper.GetProposal(ctx, params.ProposalID) == nil {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+	breakdown, found := keeper.GetTallyBreakdown(ctx, params.ProposalID)
+	if !found {
+		return nil, nil
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, breakdown)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// Params for the following queries:
+// - 'custom/gov/votingProxy', the proxies representing the address, the global ones are included for side chains
+// - 'custom/gov/proxiedDelegators', the delegators represented by the address in the side chain or globally
+type QueryVotingProxyParams struct {
+	BaseParams
+	Address sdk.AccAddress
+}
+
+func queryVotingProxy(ctx sdk.Context, params *QueryVotingProxyParams, keeper Keeper) (res []byte, err sdk.Error) {
+	chain := keeper.GetVotingProxyChain(ctx, params.Address)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, chain)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+func queryProxiedDelegators(ctx sdk.Context, params *QueryVotingProxyParams, keeper Keeper) (res []byte, err sdk.Error) {
+	delegators := keeper.GetProxiedDelegators(ctx, params.Address)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, delegators)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
 type BaseParams struct {
 	SideChainId string
 }
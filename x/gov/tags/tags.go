@@ -8,6 +8,7 @@ var (
 	ActionSubmitProposal = []byte("submit-proposal")
 	ActionDeposit        = []byte("deposit")
 	ActionVote           = []byte("vote")
+	ActionSetVotingProxy = []byte("set-voting-proxy")
 
 	Action            = sdk.TagAction
 	Proposer          = "proposer"
@@ -15,4 +16,7 @@ var (
 	VotingPeriodStart = "voting-period-start"
 	Depositer         = "depositer"
 	Voter             = "voter"
+	VoteChanged       = "vote-changed"
+	Delegator         = "delegator"
+	VotingProxy       = "voting-proxy"
 )
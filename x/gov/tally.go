@@ -1,19 +1,28 @@
 package gov
 
 import (
+	"bytes"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	Power               sdk.Dec        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	Power               sdk.Dec             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 func Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, refundDeposits bool, tallyResults TallyResult) {
+	passes, refundDeposits, tallyResults, _ = tally(ctx, keeper, proposal)
+	return
+}
+
+// tally counts the votes of a proposal, it also returns the votes of the validators sorted by address
+func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, refundDeposits bool, tallyResults TallyResult, breakdown []ValidatorTally) {
 	results := make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
@@ -29,52 +38,71 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, refu
 			Power:               validator.GetPower(),
 			DelegatorShares:     validator.GetDelegatorShares(),
 			DelegatorDeductions: sdk.ZeroDec(),
-			Vote:                OptionEmpty,
 		}
 		return false
 	})
 
+	// tallyDelegations counts the delegations of a delegator voting independently from its validators
+	tallyDelegations := func(delegatorAddr sdk.AccAddress, options WeightedVoteOptions) {
+		keeper.ds.IterateDelegations(ctx, delegatorAddr, func(index int64, delegation sdk.Delegation) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+				votingPower := val.Power.Mul(delegatorShare)
+
+				for _, option := range options {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+	}
+
 	// iterate over all the votes
+	votes := make(map[string]Vote)
 	votesIterator := keeper.GetVotes(ctx, proposal.GetProposalID())
 	defer votesIterator.Close()
 	for ; votesIterator.Valid(); votesIterator.Next() {
 		vote := &Vote{}
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), vote)
+		votes[vote.Voter.String()] = *vote
 
 		// if validator, just record it in the map
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
 			if val.DelegatorShares.GT(sdk.ZeroDec()) {
-				val.Vote = vote.Option
+				val.Vote = vote.WeightedOptions()
 				currValidators[valAddrStr] = val
 			}
 		} else {
-
-			keeper.ds.IterateDelegations(ctx, vote.Voter, func(index int64, delegation sdk.Delegation) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr().String()
-
-				if val, ok := currValidators[valAddrStr]; ok {
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					currValidators[valAddrStr] = val
-
-					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-					votingPower := val.Power.Mul(delegatorShare)
-
-					results[vote.Option] = results[vote.Option].Add(votingPower)
-					totalVotingPower = totalVotingPower.Add(votingPower)
-				}
-
-				return false
-			})
+			tallyDelegations(vote.Voter, vote.WeightedOptions())
 		}
 
 		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 	}
 
+	// the delegators not voting are represented by the nearest proxy who voted before falling back to their validators
+	if sdk.IsUpgrade(sdk.GovVotingProxy) && len(votes) > 0 {
+		keeper.iterateProxiedDelegators(ctx, func(delegatorAddr sdk.AccAddress) {
+			if _, voted := votes[delegatorAddr.String()]; voted {
+				return
+			}
+			if vote, found := keeper.resolveProxyVote(ctx, delegatorAddr, votes); found {
+				tallyDelegations(delegatorAddr, vote.WeightedOptions())
+			}
+		})
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -82,11 +110,17 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, refu
 		percentAfterMinus := sharesAfterMinus.Quo(val.DelegatorShares)
 		votingPower := val.Power.Mul(percentAfterMinus)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+		breakdown = append(breakdown, ValidatorTally{Validator: val.Address, Power: val.Power, VotingPower: votingPower, Options: val.Vote})
 	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		return bytes.Compare(breakdown[i].Validator, breakdown[j].Validator) < 0
+	})
 
-	tallyingParams := keeper.GetTallyParams(ctx)
+	tallyingParams := keeper.GetTallyParamsByType(ctx, proposal.GetProposalType())
 	totalPower := keeper.vs.TotalPower(ctx)
 	tallyResults = TallyResult{
 		Yes:        results[OptionYes],
@@ -98,26 +132,30 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, refu
 
 	// If there is no staked coins, the proposal fails
 	if keeper.vs.TotalPower(ctx).IsZero() {
-		return false, true, tallyResults
+		return false, true, tallyResults, breakdown
 	}
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(totalPower)
 	if percentVoting.LT(tallyingParams.Quorum) {
-		return false, true, tallyResults
+		return false, true, tallyResults, breakdown
 	}
 	// If no one votes, proposal fails
 	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, true, tallyResults
+		return false, true, tallyResults, breakdown
 	}
 	// If more than 1/3 of voters veto, proposal fails
 	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyingParams.Veto) {
-		return false, false, tallyResults
+		return false, false, tallyResults, breakdown
+	}
+	// If more than 1/2 (2/3 for emergency veto proposals) of non-abstaining voters vote Yes, proposal passes
+	threshold := tallyingParams.Threshold
+	if proposal.GetProposalType() == ProposalTypeEmergencyVeto {
+		threshold = keeper.GetTimelockParams(ctx).EmergencyThreshold
 	}
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyingParams.Threshold) {
-		return true, true, tallyResults
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(threshold) {
+		return true, true, tallyResults, breakdown
 	}
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 
-	return false, false, tallyResults
+	return false, false, tallyResults, breakdown
 }
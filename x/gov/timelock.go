@@ -0,0 +1,186 @@
+package gov
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultTimelock = 24 * time.Hour
+	// the changes made by the queued proposals are picked up by looking back a limited period since the voting starts,
+	// the timelock should be well within the period.
+	MaxTimelock = 7 * 24 * time.Hour
+)
+
+var DefaultEmergencyThreshold = sdk.NewDecWithPrec(66666667, 8) // 2/3
+
+// Param around the timelock of the passed proposals
+type TimelockParams struct {
+	Timelock           time.Duration `json:"timelock"`            //  Delay between passing and execution of the proposals changing params, fees or channel permissions. Initial value: 1 day
+	EmergencyThreshold sdk.Dec       `json:"emergency_threshold"` //  Minimum proportion of Yes votes for an emergency veto proposal to pass. Initial value: 2/3
+}
+
+func DefaultTimelockParams() TimelockParams {
+	return TimelockParams{
+		Timelock:           DefaultTimelock,
+		EmergencyThreshold: DefaultEmergencyThreshold,
+	}
+}
+
+func (p TimelockParams) ValidateBasic() error {
+	if p.Timelock < 0 || p.Timelock > MaxTimelock {
+		return fmt.Errorf("timelock should be between 0 and %s", MaxTimelock)
+	}
+	if p.EmergencyThreshold.LT(sdk.NewDecWithPrec(5, 1)) || p.EmergencyThreshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("emergency threshold should be in [0.5, 1)")
+	}
+	return nil
+}
+
+// Returns the current Timelock Params from the global param store, the default params are used if they are not set
+func (keeper Keeper) GetTimelockParams(ctx sdk.Context) TimelockParams {
+	if !keeper.paramSpace.Has(ctx, ParamStoreKeyTimelockParams) {
+		return DefaultTimelockParams()
+	}
+	var timelockParams TimelockParams
+	keeper.paramSpace.Get(ctx, ParamStoreKeyTimelockParams, &timelockParams)
+	return timelockParams
+}
+
+// nolint: errcheck
+func (keeper Keeper) SetTimelockParams(ctx sdk.Context, timelockParams TimelockParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyTimelockParams, &timelockParams)
+}
+
+// the proposals changing params, fees or channel permissions are queued for the timelock after they pass
+func isTimelocked(proposalType ProposalKind) bool {
+	return proposalType == ProposalTypeParameterChange ||
+		proposalType == ProposalTypeFeeChange ||
+		proposalType == ProposalTypeSCParamsChange ||
+		proposalType == ProposalTypeCSCParamsChange ||
+		proposalType == ProposalTypeManageChanPermission ||
+		proposalType == ProposalTypeGovParamsChange
+}
+
+// -----------------------------------------------------------
+// EmergencyVetoContent cancels a queued proposal before it is executed, the emergency veto proposal passes with a
+// supermajority of Yes votes.
+type EmergencyVetoContent struct {
+	ProposalID int64 `json:"proposal_id"`
+}
+
+var _ Content = EmergencyVetoContent{}
+
+func (c EmergencyVetoContent) ProposalType() ProposalKind { return ProposalTypeEmergencyVeto }
+func (c EmergencyVetoContent) Payload() interface{}       { return c.ProposalID }
+func (c EmergencyVetoContent) ValidateBasic() sdk.Error {
+	if c.ProposalID < 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("invalid proposal id %d", c.ProposalID))
+	}
+	return nil
+}
+
+func handleEmergencyVetoContent(keeper Keeper) ContentHandler {
+	return func(ctx sdk.Context, proposal Proposal, content Content) sdk.Error {
+		proposalID, ok := content.Payload().(int64)
+		if !ok {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("unexpected content %T", content))
+		}
+		queued := keeper.GetProposal(ctx, proposalID)
+		if queued == nil {
+			return ErrUnknownProposal(keeper.codespace, proposalID)
+		}
+		if queued.GetStatus() != StatusQueued {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("proposal %d is not queued", proposalID))
+		}
+
+		queued.SetStatus(StatusCancelled)
+		keeper.SetProposal(ctx, queued)
+		keeper.deleteQueuedProposal(ctx, proposalID)
+		return nil
+	}
+}
+
+// QueuedProposal is a passed proposal waiting for the timelock
+type QueuedProposal struct {
+	ProposalID    int64     `json:"proposal_id"`
+	ExecutionTime time.Time `json:"execution_time"`
+}
+
+// queueProposal queues a passed proposal if it is timelocked, it returns false if the proposal should be executed now
+func (keeper Keeper) queueProposal(ctx sdk.Context, proposal Proposal) (QueuedProposal, bool) {
+	if !sdk.IsUpgrade(sdk.GovTimelock) || !isTimelocked(proposal.GetProposalType()) {
+		return QueuedProposal{}, false
+	}
+	timelock := keeper.GetTimelockParams(ctx).Timelock
+	if timelock <= 0 {
+		return QueuedProposal{}, false
+	}
+
+	queued := QueuedProposal{ProposalID: proposal.GetProposalID(), ExecutionTime: ctx.BlockHeader().Time.Add(timelock)}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyTimelockQueue(queued.ExecutionTime, queued.ProposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(queued))
+	store.Set(KeyQueuedProposal(queued.ProposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(queued.ExecutionTime))
+	proposal.SetStatus(StatusQueued)
+	return queued, true
+}
+
+// GetQueuedProposal returns the queued proposal by id
+func (keeper Keeper) GetQueuedProposal(ctx sdk.Context, proposalID int64) (queued QueuedProposal, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyQueuedProposal(proposalID))
+	if bz == nil {
+		return queued, false
+	}
+	var executionTime time.Time
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &executionTime)
+	return QueuedProposal{ProposalID: proposalID, ExecutionTime: executionTime}, true
+}
+
+func (keeper Keeper) deleteQueuedProposal(ctx sdk.Context, proposalID int64) {
+	queued, found := keeper.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyTimelockQueue(queued.ExecutionTime, proposalID))
+	store.Delete(KeyQueuedProposal(proposalID))
+}
+
+// IterateQueuedProposals iterates through the queued proposals in the order of execution time
+func (keeper Keeper) IterateQueuedProposals(ctx sdk.Context, fn func(queued QueuedProposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyTimelockQueueSubspace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var queued QueuedProposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &queued)
+		if fn(queued) {
+			break
+		}
+	}
+}
+
+// popMaturedQueuedProposals removes the queued proposals whose timelock has expired from the queue
+func (keeper Keeper) popMaturedQueuedProposals(ctx sdk.Context) []Proposal {
+	var matured []QueuedProposal
+	keeper.IterateQueuedProposals(ctx, func(queued QueuedProposal) bool {
+		if ctx.BlockHeader().Time.Before(queued.ExecutionTime) {
+			return true
+		}
+		matured = append(matured, queued)
+		return false
+	})
+
+	proposals := make([]Proposal, 0, len(matured))
+	for _, queued := range matured {
+		keeper.deleteQueuedProposal(ctx, queued.ProposalID)
+		if proposal := keeper.GetProposal(ctx, queued.ProposalID); proposal != nil && proposal.GetStatus() == StatusQueued {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
@@ -0,0 +1,136 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/sidechain/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestTimelockParams(t *testing.T) {
+	require.NoError(t, gov.DefaultTimelockParams().ValidateBasic())
+	require.Error(t, gov.TimelockParams{Timelock: gov.MaxTimelock + 1, EmergencyThreshold: gov.DefaultEmergencyThreshold}.ValidateBasic())
+	require.Error(t, gov.TimelockParams{Timelock: time.Hour, EmergencyThreshold: sdk.NewDecWithPrec(4, 1)}.ValidateBasic())
+	require.Error(t, gov.TimelockParams{Timelock: time.Hour, EmergencyThreshold: sdk.OneDec()}.ValidateBasic())
+
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	msg := gov.NewMsgSubmitProposal("Veto", "veto", gov.ProposalTypeEmergencyVeto, addrs[0], coinsPos, time.Hour)
+	require.NotNil(t, msg.ValidateBasic())
+	require.Nil(t, msg.WithContent(gov.EmergencyVetoContent{ProposalID: 1}).ValidateBasic())
+}
+
+func TestTimelockAndEmergencyVeto(t *testing.T) {
+	mapp, ck, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+	for _, upgrade := range []string{sdk.TypedProposalContent, sdk.WeightedVote, sdk.GovTimelock} {
+		sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 1)
+		defer sdk.UpgradeMgr.AddUpgradeHeight(upgrade, 0)
+	}
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	_, _, err := ck.AddCoins(ctx, addrs[0], sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 20000e8)})
+	require.Nil(t, err)
+
+	timelock := time.Hour
+	keeper.SetTimelockParams(ctx, gov.TimelockParams{Timelock: timelock, EmergencyThreshold: gov.DefaultEmergencyThreshold})
+
+	// the handler pays a coin to the executed account for every executed proposal
+	_, executed := mock.GeneratePrivKeyAddressPairs(1)
+	keeper.AddContentHandler(gov.ProposalTypeManageChanPermission, func(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+		_, _, err := ck.AddCoins(ctx, executed[0], sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 1)})
+		return err
+	})
+
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 10 * time.Second
+	submit := func(ctx sdk.Context, proposalType gov.ProposalKind, content gov.Content, option gov.WeightedVoteOptions) int64 {
+		msg := gov.NewMsgSubmitProposal("Test", "test", proposalType, addrs[0],
+			sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).WithContent(content)
+		res := govHandler(ctx, msg)
+		require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+		proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+		res = govHandler(ctx, gov.NewMsgWeightedVote(addrs[0], proposalID, option))
+		require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+		return proposalID
+	}
+	endBlock := func(ctx sdk.Context, elapsed time.Duration) sdk.Context {
+		header := ctx.BlockHeader()
+		header.Time = header.Time.Add(elapsed)
+		ctx = ctx.WithBlockHeader(header)
+		gov.EndBlocker(ctx, keeper)
+		return ctx
+	}
+	status := func(proposalID int64) gov.ProposalStatus {
+		return keeper.GetProposal(ctx, proposalID).GetStatus()
+	}
+
+	yes := gov.NewNonSplitVoteOption(gov.OptionYes)
+	content := gov.ManageChanPermissionContent{
+		Setting: types.ChanPermissionSetting{SideChainId: "bsc", ChannelId: 8, Permission: sdk.ChannelForbidden},
+	}
+	executedID := submit(ctx, gov.ProposalTypeManageChanPermission, content, yes)
+	cancelledID := submit(ctx, gov.ProposalTypeManageChanPermission, content, yes)
+	textID := submit(ctx, gov.ProposalTypeText, nil, yes)
+
+	// the passed proposals are queued, except text proposals
+	ctx = endBlock(ctx, votingPeriod)
+	require.Equal(t, gov.StatusQueued, status(executedID))
+	require.Equal(t, gov.StatusQueued, status(cancelledID))
+	require.Equal(t, gov.StatusPassed, status(textID))
+	require.True(t, ck.GetCoins(ctx, executed[0]).IsZero())
+
+	queued, found := keeper.GetQueuedProposal(ctx, executedID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time.Add(timelock).UTC(), queued.ExecutionTime.UTC())
+
+	querier := gov.NewQuerier(keeper)
+	bz, queryErr := querier(ctx, []string{gov.QueryTimelockQueue}, abci.RequestQuery{})
+	require.Nil(t, queryErr)
+	var queue []gov.QueuedProposal
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &queue))
+	require.Len(t, queue, 2)
+
+	// the emergency veto needs a supermajority, 60% of Yes votes is not enough
+	split := gov.WeightedVoteOptions{
+		{Option: gov.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: gov.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+	}
+	rejectedVetoID := submit(ctx, gov.ProposalTypeEmergencyVeto, gov.EmergencyVetoContent{ProposalID: cancelledID}, split)
+	vetoID := submit(ctx, gov.ProposalTypeEmergencyVeto, gov.EmergencyVetoContent{ProposalID: cancelledID}, yes)
+	failedVetoID := submit(ctx, gov.ProposalTypeEmergencyVeto, gov.EmergencyVetoContent{ProposalID: textID}, yes)
+	ctx = endBlock(ctx, votingPeriod)
+	require.Equal(t, gov.StatusRejected, status(rejectedVetoID))
+	require.Equal(t, gov.StatusPassed, status(vetoID))
+	require.Equal(t, gov.StatusFailed, status(failedVetoID))
+	require.Equal(t, gov.StatusCancelled, status(cancelledID))
+	_, found = keeper.GetQueuedProposal(ctx, cancelledID)
+	require.False(t, found)
+
+	// the queued proposal is executed after the timelock
+	ctx = endBlock(ctx, timelock-2*votingPeriod)
+	require.Equal(t, gov.StatusQueued, status(executedID))
+	ctx = endBlock(ctx, votingPeriod)
+	require.Equal(t, gov.StatusPassed, status(executedID))
+	require.Equal(t, gov.StatusCancelled, status(cancelledID))
+	require.EqualValues(t, 1, ck.GetCoins(ctx, executed[0]).AmountOf(gov.DefaultDepositDenom))
+
+	bz, queryErr = querier(ctx, []string{gov.QueryTimelockQueue}, abci.RequestQuery{})
+	require.Nil(t, queryErr)
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &queue))
+	require.Len(t, queue, 0)
+}
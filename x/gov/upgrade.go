@@ -0,0 +1,197 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const MaxUpgradeNameLength = 64
+
+// UpgradePlan schedules a named software upgrade at a height. The plan is stored when the SoftwareUpgrade proposal
+// passes and fed into sdk.UpgradeMgr, a node whose binary does not know the upgrade halts at the height.
+type UpgradePlan struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"` // e.g. the release of the binary
+}
+
+func (p UpgradePlan) ValidateBasic() sdk.Error {
+	if len(p.Name) == 0 || len(p.Name) > MaxUpgradeNameLength {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("the length of upgrade name should be between 1 and %d", MaxUpgradeNameLength))
+	}
+	if p.Height <= 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "upgrade height should be positive")
+	}
+	if len(p.Info) > MaxDescriptionLength {
+		return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("upgrade info is longer than max length of %d", MaxDescriptionLength))
+	}
+	return nil
+}
+
+func (p UpgradePlan) String() string {
+	return fmt.Sprintf("UpgradePlan{%s, %d, %s}", p.Name, p.Height, p.Info)
+}
+
+// -----------------------------------------------------------
+// SoftwareUpgradeContent schedules a software upgrade, it replaces the pending plan if there is one
+type SoftwareUpgradeContent struct {
+	Plan UpgradePlan `json:"plan"`
+}
+
+var _ Content = SoftwareUpgradeContent{}
+
+func (c SoftwareUpgradeContent) ProposalType() ProposalKind { return ProposalTypeSoftwareUpgrade }
+func (c SoftwareUpgradeContent) Payload() interface{}       { return c.Plan }
+func (c SoftwareUpgradeContent) ValidateBasic() sdk.Error   { return c.Plan.ValidateBasic() }
+
+// handleSoftwareUpgradeContent only stores the plan, sdk.UpgradeMgr follows the committed plans in BeginBlocker
+func handleSoftwareUpgradeContent(keeper Keeper) ContentHandler {
+	return func(ctx sdk.Context, proposal Proposal, content Content) sdk.Error {
+		if !sdk.IsUpgrade(sdk.SoftwareUpgradePlan) {
+			return ErrInvalidProposalContent(keeper.codespace, "software upgrade plan is not supported yet")
+		}
+		plan, ok := content.Payload().(UpgradePlan)
+		if !ok {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("unexpected content %T", content))
+		}
+		// the plan is fed into sdk.UpgradeMgr in the BeginBlocker of the next block at the earliest
+		if plan.Height <= ctx.BlockHeight()+1 {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("upgrade height %d is too close", plan.Height))
+		}
+		if _, found := keeper.GetAppliedUpgradePlan(ctx, plan.Name); found {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("upgrade %s has been applied", plan.Name))
+		}
+		if height := sdk.UpgradeMgr.GetUpgradeHeight(plan.Name); height != 0 && height <= ctx.BlockHeight() {
+			return ErrInvalidProposalContent(keeper.codespace, fmt.Sprintf("upgrade %s has been activated at height %d", plan.Name, height))
+		}
+
+		// the pending plan is cancelled
+		if pending, found := keeper.GetUpgradePlan(ctx); found && pending.Name != plan.Name {
+			keeper.setCancelledUpgrade(ctx, pending.Name)
+		}
+		keeper.deleteCancelledUpgrade(ctx, plan.Name)
+		keeper.setUpgradePlan(ctx, plan)
+		return nil
+	}
+}
+
+// ApplyUpgradePlans feeds the committed upgrade plans into sdk.UpgradeMgr, the plans stored on chain override the
+// heights configured off chain. It is called when the app loads and in every BeginBlocker.
+func (keeper Keeper) ApplyUpgradePlans(ctx sdk.Context) {
+	setUpgradeHeight := func(name string, height int64) {
+		if sdk.UpgradeMgr.GetUpgradeHeight(name) != height {
+			sdk.UpgradeMgr.SetUpgradeHeight(name, height)
+		}
+	}
+
+	keeper.IterateCancelledUpgrades(ctx, func(name string) bool {
+		setUpgradeHeight(name, 0)
+		return false
+	})
+	keeper.IterateAppliedUpgradePlans(ctx, func(plan UpgradePlan) bool {
+		setUpgradeHeight(plan.Name, plan.Height)
+		return false
+	})
+	if plan, found := keeper.GetUpgradePlan(ctx); found {
+		setUpgradeHeight(plan.Name, plan.Height)
+	}
+}
+
+// BeginBlocker feeds the upgrade plans committed in the previous blocks into sdk.UpgradeMgr.
+// It halts the node at the height of the pending plan if the binary does not know the upgrade.
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	if !sdk.IsUpgrade(sdk.SoftwareUpgradePlan) {
+		return
+	}
+	keeper.ApplyUpgradePlans(ctx)
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found || ctx.BlockHeight() < plan.Height {
+		return
+	}
+	if !sdk.UpgradeMgr.IsKnownUpgrade(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		ctx.Logger().With("module", "x/gov").Error(msg)
+		panic(msg)
+	}
+
+	keeper.setAppliedUpgradePlan(ctx, plan)
+	keeper.deleteUpgradePlan(ctx)
+	ctx.Logger().With("module", "x/gov").Info(fmt.Sprintf("applied upgrade %q at height %d", plan.Name, plan.Height))
+}
+
+// GetUpgradePlan returns the pending upgrade plan
+func (keeper Keeper) GetUpgradePlan(ctx sdk.Context) (plan UpgradePlan, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyUpgradePlan)
+	if bz == nil {
+		return plan, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+func (keeper Keeper) setUpgradePlan(ctx sdk.Context, plan UpgradePlan) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyUpgradePlan, keeper.cdc.MustMarshalBinaryLengthPrefixed(plan))
+}
+
+func (keeper Keeper) deleteUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyUpgradePlan)
+}
+
+// GetAppliedUpgradePlan returns the plan of an applied upgrade by name
+func (keeper Keeper) GetAppliedUpgradePlan(ctx sdk.Context, name string) (plan UpgradePlan, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyAppliedUpgradePlan(name))
+	if bz == nil {
+		return plan, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+func (keeper Keeper) setAppliedUpgradePlan(ctx sdk.Context, plan UpgradePlan) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyAppliedUpgradePlan(plan.Name), keeper.cdc.MustMarshalBinaryLengthPrefixed(plan))
+}
+
+// IterateAppliedUpgradePlans iterates through the applied upgrade plans in the order of names
+func (keeper Keeper) IterateAppliedUpgradePlans(ctx sdk.Context, fn func(plan UpgradePlan) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyAppliedUpgradePlansSubspace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var plan UpgradePlan
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &plan)
+		if fn(plan) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) setCancelledUpgrade(ctx sdk.Context, name string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyCancelledUpgrade(name), []byte(name))
+}
+
+func (keeper Keeper) deleteCancelledUpgrade(ctx sdk.Context, name string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyCancelledUpgrade(name))
+}
+
+// IterateCancelledUpgrades iterates through the names of the upgrades cancelled by a later plan
+func (keeper Keeper) IterateCancelledUpgrades(ctx sdk.Context, fn func(name string) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyCancelledUpgradesSubspace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(string(iterator.Value())) {
+			break
+		}
+	}
+}
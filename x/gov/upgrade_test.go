@@ -0,0 +1,161 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestSoftwareUpgradePlan(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 1)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SoftwareUpgradePlan, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 0)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SoftwareUpgradePlan, 0)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	plan := gov.UpgradePlan{Name: "TestUpgrade", Height: 10, Info: "v1.0.0"}
+	defer sdk.UpgradeMgr.AddUpgradeHeight(plan.Name, 0)
+	govHandler := gov.NewHandler(keeper)
+	votingPeriod := 1000 * time.Second
+	msg := gov.NewMsgSubmitProposal("Upgrade", "upgrade the binary", gov.ProposalTypeSoftwareUpgrade, addrs[0],
+		sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).WithContent(gov.SoftwareUpgradeContent{Plan: plan})
+	require.NotNil(t, msg.WithContent(gov.SoftwareUpgradeContent{Plan: gov.UpgradePlan{Name: plan.Name}}).ValidateBasic())
+	res := govHandler(ctx, msg)
+	require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+	proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+	res = govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionYes))
+	require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, keeper)
+
+	// the plan is stored and fed into the upgrade manager once committed
+	require.Equal(t, gov.StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	stored, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, stored)
+	require.EqualValues(t, 0, sdk.UpgradeMgr.GetUpgradeHeight(plan.Name))
+	gov.BeginBlocker(ctx.WithBlockHeight(2), keeper)
+	require.EqualValues(t, plan.Height, sdk.UpgradeMgr.GetUpgradeHeight(plan.Name))
+
+	querier := gov.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{gov.QueryUpgradePlan}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var queried gov.UpgradePlan
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &queried))
+	require.Equal(t, plan, queried)
+
+	// the plan is fed again after the restart of the node
+	sdk.UpgradeMgr.AddUpgradeHeight(plan.Name, 0)
+	gov.BeginBlocker(ctx.WithBlockHeight(9), keeper)
+	require.EqualValues(t, plan.Height, sdk.UpgradeMgr.GetUpgradeHeight(plan.Name))
+
+	// the node halts at the height if the binary does not know the upgrade
+	require.Panics(t, func() { gov.BeginBlocker(ctx.WithBlockHeight(plan.Height), keeper) })
+
+	sdk.UpgradeMgr.AddKnownUpgrades(plan.Name)
+	gov.BeginBlocker(ctx.WithBlockHeight(plan.Height), keeper)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	applied, found := keeper.GetAppliedUpgradePlan(ctx, plan.Name)
+	require.True(t, found)
+	require.Equal(t, plan, applied)
+
+	bz, err = querier(ctx, []string{gov.QueryAppliedUpgradePlans}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var plans []gov.UpgradePlan
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &plans))
+	require.Equal(t, []gov.UpgradePlan{plan}, plans)
+
+	// the applied plan is fed again after the restart of the node as well
+	sdk.UpgradeMgr.AddUpgradeHeight(plan.Name, 0)
+	gov.BeginBlocker(ctx.WithBlockHeight(plan.Height+1), keeper)
+	require.EqualValues(t, plan.Height, sdk.UpgradeMgr.GetUpgradeHeight(plan.Name))
+}
+
+// passUpgradePlan submits the plan and passes the proposal in the EndBlocker of the returned context
+func passUpgradePlan(t *testing.T, ctx sdk.Context, keeper gov.Keeper, proposer sdk.AccAddress, plan gov.UpgradePlan) sdk.Context {
+	votingPeriod := 1000 * time.Second
+	govHandler := gov.NewHandler(keeper)
+	msg := gov.NewMsgSubmitProposal("Upgrade", "upgrade the binary", gov.ProposalTypeSoftwareUpgrade, proposer,
+		sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, votingPeriod).WithContent(gov.SoftwareUpgradeContent{Plan: plan})
+	res := govHandler(ctx, msg)
+	require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+	proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+	res = govHandler(ctx, gov.NewMsgVote(proposer, proposalID, gov.OptionYes))
+	require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, keeper)
+	require.Equal(t, gov.StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	return ctx
+}
+
+func TestCancelSoftwareUpgradePlan(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 1)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SoftwareUpgradePlan, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TypedProposalContent, 0)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SoftwareUpgradePlan, 0)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	sdk.UpgradeMgr.SetHeight(1)
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// the store keys registered for an upgrade follow its plan
+	first := gov.UpgradePlan{Name: "TestFirstUpgrade", Height: 10}
+	second := gov.UpgradePlan{Name: "TestSecondUpgrade", Height: 20}
+	sdk.UpgradeMgr.AddKnownUpgrades(first.Name, second.Name)
+	sdk.UpgradeMgr.RegisterStoreKeys(first.Name, "testFirstStore")
+	defer sdk.UpgradeMgr.SetUpgradeHeight(first.Name, 0)
+	defer sdk.UpgradeMgr.SetUpgradeHeight(second.Name, 0)
+	require.False(t, sdk.ShouldCommitStore("testFirstStore"))
+
+	ctx = passUpgradePlan(t, ctx, keeper, addrs[0], first)
+	gov.BeginBlocker(ctx.WithBlockHeight(2), keeper)
+	require.EqualValues(t, first.Height, sdk.UpgradeMgr.GetUpgradeHeight(first.Name))
+	require.EqualValues(t, first.Height, sdk.UpgradeMgr.GetStoreKeyHeight("testFirstStore"))
+
+	// the second plan cancels the first one
+	ctx = passUpgradePlan(t, ctx, keeper, addrs[0], second)
+	gov.BeginBlocker(ctx.WithBlockHeight(3), keeper)
+	require.EqualValues(t, 0, sdk.UpgradeMgr.GetUpgradeHeight(first.Name))
+	require.EqualValues(t, second.Height, sdk.UpgradeMgr.GetUpgradeHeight(second.Name))
+	sdk.UpgradeMgr.SetHeight(first.Height)
+	require.False(t, sdk.ShouldCommitStore("testFirstStore"))
+
+	// the cancellation is applied again after the restart of the node
+	sdk.UpgradeMgr.SetUpgradeHeight(first.Name, first.Height)
+	sdk.UpgradeMgr.SetUpgradeHeight(second.Name, 0)
+	keeper.ApplyUpgradePlans(ctx)
+	require.EqualValues(t, 0, sdk.UpgradeMgr.GetUpgradeHeight(first.Name))
+	require.EqualValues(t, second.Height, sdk.UpgradeMgr.GetUpgradeHeight(second.Name))
+}
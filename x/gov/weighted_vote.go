@@ -0,0 +1,216 @@
+package gov
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WeightedVoteOption is a part of a weighted vote, the voting power of the voter is split across the options by weight
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, formatWeight(o.Weight))
+}
+
+// WeightedVoteOptions is the options of a weighted vote, the weights add up to 1
+type WeightedVoteOptions []WeightedVoteOption
+
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+func (options WeightedVoteOptions) ValidateBasic() sdk.Error {
+	if len(options) == 0 {
+		return ErrInvalidWeightedVote(DefaultCodespace, "no vote options")
+	}
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !validVoteOption(option.Option) {
+			return ErrInvalidVote(DefaultCodespace, option.Option)
+		}
+		if usedOptions[option.Option] {
+			return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("duplicated vote option %s", option.Option))
+		}
+		if option.Weight.LTE(sdk.ZeroDec()) || option.Weight.GT(sdk.OneDec()) {
+			return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("weight of %s should be in (0, 1]", option.Option))
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return ErrInvalidWeightedVote(DefaultCodespace, fmt.Sprintf("total weight %s should be 1", formatWeight(totalWeight)))
+	}
+	return nil
+}
+
+// Returns whether 2 weighted vote options are equal
+func (options WeightedVoteOptions) Equals(other WeightedVoteOptions) bool {
+	if len(options) != len(other) {
+		return false
+	}
+	for i := range options {
+		if options[i].Option != other[i].Option || !options[i].Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+func (options WeightedVoteOptions) String() string {
+	strs := make([]string, 0, len(options))
+	for _, option := range options {
+		strs = append(strs, option.String())
+	}
+	return strings.Join(strs, ",")
+}
+
+// validateVoteOptions validates the options of a vote msg, which has either a single option or weighted options
+func validateVoteOptions(option VoteOption, options WeightedVoteOptions) sdk.Error {
+	if len(options) == 0 {
+		if !validVoteOption(option) {
+			return ErrInvalidVote(DefaultCodespace, option)
+		}
+		return nil
+	}
+	if option != OptionEmpty {
+		return ErrInvalidWeightedVote(DefaultCodespace, "option should be empty for a weighted vote")
+	}
+	return options.ValidateBasic()
+}
+
+// WeightedVoteOptionsFromString parses weighted vote options like "Yes=0.6,No=0.4", an option without weight gets
+// all the voting power, e.g. "Yes" is identical to "Yes=1".
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, part := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(part), "=")
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", part)
+		}
+		option, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+		weight := sdk.OneDec()
+		if len(fields) == 2 {
+			weight, err = parseWeight(strings.TrimSpace(fields[1]))
+			if err != nil {
+				return nil, err
+			}
+		}
+		options = append(options, WeightedVoteOption{Option: option, Weight: weight})
+	}
+	return options, nil
+}
+
+// parseWeight parses a decimal like "0.25" into sdk.Dec
+func parseWeight(str string) (sdk.Dec, error) {
+	parts := strings.Split(str, ".")
+	if len(parts) > 2 || len(parts[0]) == 0 || strings.IndexFunc(str, isNotDigitOrDot) != -1 {
+		return sdk.Dec{}, fmt.Errorf("'%s' is not a valid weight", str)
+	}
+	integer, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || integer > 1 {
+		return sdk.Dec{}, fmt.Errorf("'%s' is not a valid weight", str)
+	}
+	var fraction int64
+	if len(parts) == 2 {
+		if len(parts[1]) == 0 || len(parts[1]) > sdk.Precision {
+			return sdk.Dec{}, fmt.Errorf("'%s' is not a valid weight, at most %d decimal places", str, sdk.Precision)
+		}
+		fraction, err = strconv.ParseInt(parts[1]+strings.Repeat("0", sdk.Precision-len(parts[1])), 10, 64)
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("'%s' is not a valid weight", str)
+		}
+	}
+	return sdk.NewDecWithoutFra(integer).Add(sdk.NewDecWithPrec(fraction, sdk.Precision)), nil
+}
+
+func isNotDigitOrDot(r rune) bool {
+	return (r < '0' || r > '9') && r != '.'
+}
+
+func formatWeight(weight sdk.Dec) string {
+	one := sdk.OneDec().RawInt()
+	return fmt.Sprintf("%d.%0*d", weight.RawInt()/one, sdk.Precision, weight.RawInt()%one)
+}
+
+// MaxVoteHistory is the max number of vote records kept for a voter on a proposal
+const MaxVoteHistory = 100
+
+// VoteRecord is an entry of the audit trail of the votes, every vote or change of a vote during the voting period
+// is recorded with the block it happened in.
+type VoteRecord struct {
+	Vote   Vote      `json:"vote"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// AddWeightedVote adds a weighted vote on a specific proposal, it replaces the previous vote of the voter
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
+	if !sdk.IsUpgrade(sdk.WeightedVote) {
+		return ErrInvalidWeightedVote(keeper.codespace, "weighted vote is not supported yet")
+	}
+	if err := options.ValidateBasic(); err != nil {
+		return err
+	}
+	return keeper.addVote(ctx, Vote{ProposalID: proposalID, Voter: voterAddr, Options: options})
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote Vote) sdk.Error {
+	proposal := keeper.GetProposal(ctx, vote.ProposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, vote.ProposalID)
+	}
+	if proposal.GetStatus() != StatusVotingPeriod {
+		return ErrInactiveProposal(keeper.codespace, vote.ProposalID)
+	}
+
+	keeper.setVote(ctx, vote.ProposalID, vote.Voter, vote)
+	keeper.indexVoter(ctx, vote.ProposalID, vote.Voter)
+	if sdk.IsUpgrade(sdk.WeightedVote) {
+		keeper.appendVoteRecord(ctx, VoteRecord{Vote: vote, Height: ctx.BlockHeight(), Time: ctx.BlockHeader().Time})
+	}
+	return nil
+}
+
+// GetVoteHistory returns the votes of a voter on a proposal in the order they were cast, the history is kept after
+// the voting period ends. Only the last MaxVoteHistory records are kept.
+func (keeper Keeper) GetVoteHistory(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress) []VoteRecord {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyVoteHistorySubspace(proposalID, voterAddr))
+	defer iterator.Close()
+
+	var records []VoteRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record VoteRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// appendVoteRecord stores the record under its own key and prunes the oldest record once the voter has more than
+// MaxVoteHistory records on the proposal
+func (keeper Keeper) appendVoteRecord(ctx sdk.Context, record VoteRecord) {
+	proposalID, voterAddr := record.Vote.ProposalID, record.Vote.Voter
+	store := ctx.KVStore(keeper.storeKey)
+
+	var count int64
+	if bz := store.Get(KeyVoteHistoryCount(proposalID, voterAddr)); bz != nil {
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &count)
+	}
+	store.Set(KeyVoteRecord(proposalID, voterAddr, count), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+	if count >= MaxVoteHistory {
+		store.Delete(KeyVoteRecord(proposalID, voterAddr, count-MaxVoteHistory))
+	}
+	store.Set(KeyVoteHistoryCount(proposalID, voterAddr), keeper.cdc.MustMarshalBinaryLengthPrefixed(count+1))
+}
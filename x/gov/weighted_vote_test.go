@@ -0,0 +1,151 @@
+package gov_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/tags"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestWeightedVoteOptions(t *testing.T) {
+	options, err := gov.WeightedVoteOptionsFromString("Yes=0.6, No=0.3,Abstain=0.1")
+	require.NoError(t, err)
+	require.Equal(t, gov.WeightedVoteOptions{
+		{Option: gov.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+		{Option: gov.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+		{Option: gov.OptionAbstain, Weight: sdk.NewDecWithPrec(1, 1)},
+	}, options)
+	require.Nil(t, options.ValidateBasic())
+	require.Equal(t, "Yes=0.60000000,No=0.30000000,Abstain=0.10000000", options.String())
+
+	options, err = gov.WeightedVoteOptionsFromString("NoWithVeto")
+	require.NoError(t, err)
+	require.Equal(t, gov.NewNonSplitVoteOption(gov.OptionNoWithVeto), options)
+
+	for _, str := range []string{"Yes=0.5=1", "Maybe=1", "Yes=-0.5", "Yes=0.123456789", "Yes=.5"} {
+		_, err = gov.WeightedVoteOptionsFromString(str)
+		require.Error(t, err, str)
+	}
+	for _, str := range []string{"Yes=0.6,No=0.3", "Yes=0.6,Yes=0.4", "Yes=1,No=0"} {
+		options, err = gov.WeightedVoteOptionsFromString(str)
+		require.NoError(t, err, str)
+		require.NotNil(t, options.ValidateBasic(), str)
+	}
+
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	msg := gov.NewMsgWeightedVote(addrs[0], 1, options)
+	msg.Option = gov.OptionYes
+	require.NotNil(t, msg.ValidateBasic())
+	msg.Option = gov.OptionEmpty
+	msg.Options = gov.NewNonSplitVoteOption(gov.OptionYes)
+	require.Nil(t, msg.ValidateBasic())
+}
+
+func TestWeightedVote(t *testing.T) {
+	mapp, _, keeper, stakeKeeper, addrs, pubKeys, _ := getMockApp(t, 2)
+
+	_, feeAccount := mock.GeneratePrivKeyAddressPairs(1)
+	validator := stake.NewValidatorWithFeeAddr(feeAccount[0], sdk.ValAddress(addrs[0]), pubKeys[0], stake.Description{})
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(sdk.RunTxModeDeliver, abci.Header{ProposerAddress: pubKeys[0].Address()}).WithBlockHeight(1)
+	stakeKeeper.SetValidator(ctx, validator)
+	stakeKeeper.SetValidatorByConsAddr(ctx, validator)
+	stakeKeeper.Delegate(ctx, sdk.AccAddress(addrs[1]), sdk.NewCoin(gov.DefaultDepositDenom, 1000e8), validator, true)
+	stakeKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	govHandler := gov.NewHandler(keeper)
+	msg := gov.NewMsgSubmitProposal("Test", "test", gov.ProposalTypeText, addrs[0],
+		sdk.Coins{sdk.NewCoin(gov.DefaultDepositDenom, 2000e8)}, 1000*time.Second)
+	res := govHandler(ctx, msg)
+	require.True(t, res.IsOK(), "expected submit proposal msg to be ok, got: %v", res)
+	proposalID, _ := strconv.ParseInt(string(res.Data), 10, 64)
+
+	options := gov.WeightedVoteOptions{
+		{Option: gov.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: gov.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+	}
+
+	// weighted votes are not accepted before the upgrade
+	res = govHandler(ctx, gov.NewMsgWeightedVote(addrs[0], proposalID, options))
+	require.Equal(t, sdk.ToABCICode(gov.DefaultCodespace, gov.CodeInvalidWeightedVote), res.Code)
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.WeightedVote, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.WeightedVote, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+
+	res = govHandler(ctx, gov.NewMsgVote(addrs[0], proposalID, gov.OptionNo))
+	require.True(t, res.IsOK(), "expected vote msg to be ok, got: %v", res)
+	require.False(t, hasTag(res.Tags, tags.VoteChanged))
+
+	// the vote is changed to a weighted vote
+	res = govHandler(ctx.WithBlockHeight(2), gov.NewMsgWeightedVote(addrs[0], proposalID, options))
+	require.True(t, res.IsOK(), "expected weighted vote msg to be ok, got: %v", res)
+	require.True(t, hasTag(res.Tags, tags.VoteChanged))
+
+	vote, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, gov.OptionEmpty, vote.Option)
+	require.Equal(t, options, vote.WeightedOptions())
+
+	history := keeper.GetVoteHistory(ctx, proposalID, addrs[0])
+	require.Len(t, history, 2)
+	require.Equal(t, gov.OptionNo, history[0].Vote.Option)
+	require.EqualValues(t, 1, history[0].Height)
+	require.Equal(t, options, history[1].Vote.Options)
+	require.EqualValues(t, 2, history[1].Height)
+
+	querier := gov.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{gov.QueryVoteHistory}, abci.RequestQuery{
+		Data: mapp.Cdc.MustMarshalJSON(gov.QueryVoteParams{ProposalID: proposalID, Voter: addrs[0]}),
+	})
+	require.Nil(t, err)
+	var queried []gov.VoteRecord
+	require.NoError(t, mapp.Cdc.UnmarshalJSON(bz, &queried))
+	require.Len(t, queried, 2)
+	require.True(t, history[1].Vote.Equals(queried[1].Vote))
+
+	// only the latest records are kept
+	for i := 0; i < gov.MaxVoteHistory-1; i++ {
+		res = govHandler(ctx.WithBlockHeight(3), gov.NewMsgWeightedVote(addrs[0], proposalID, options))
+		require.True(t, res.IsOK(), "expected weighted vote msg to be ok, got: %v", res)
+	}
+	history = keeper.GetVoteHistory(ctx, proposalID, addrs[0])
+	require.Len(t, history, gov.MaxVoteHistory)
+	require.EqualValues(t, 2, history[0].Height)
+	require.EqualValues(t, 3, history[gov.MaxVoteHistory-1].Height)
+
+	// the voting power of the validator is split by weight
+	_, _, tallyResults := gov.Tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+	power := validatorPower(ctx, stakeKeeper, validator)
+	require.True(t, power.GT(sdk.ZeroDec()))
+	require.Equal(t, power.Mul(sdk.NewDecWithPrec(7, 1)), tallyResults.Yes)
+	require.Equal(t, power.Mul(sdk.NewDecWithPrec(3, 1)), tallyResults.No)
+	require.True(t, tallyResults.Abstain.IsZero())
+
+	// the history is kept after the votes are tallied
+	_, found = keeper.GetVote(ctx, proposalID, addrs[0])
+	require.False(t, found)
+	require.Len(t, keeper.GetVoteHistory(ctx, proposalID, addrs[0]), gov.MaxVoteHistory)
+}
+
+func hasTag(resTags sdk.Tags, key string) bool {
+	for _, tag := range resTags {
+		if string(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func validatorPower(ctx sdk.Context, stakeKeeper stake.Keeper, validator stake.Validator) sdk.Dec {
+	val, _ := stakeKeeper.GetValidator(ctx, validator.OperatorAddr)
+	return val.GetPower()
+}
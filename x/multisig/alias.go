@@ -0,0 +1,43 @@
+package multisig
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/multisig/keeper"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	RouteMultisig    = types.RouteMultisig
+	DefaultCodespace = types.DefaultCodespace
+)
+
+var (
+	// functions aliases
+	NewKeeper = keeper.NewKeeper
+
+	AccountAddress        = types.AccountAddress
+	ValidateMembers       = types.ValidateMembers
+	NewMsgCreateMultisig  = types.NewMsgCreateMultisig
+	NewMsgSubmitProposal  = types.NewMsgSubmitProposal
+	NewMsgApproveProposal = types.NewMsgApproveProposal
+	NewMsgUpdateMembers   = types.NewMsgUpdateMembers
+
+	ErrInvalidMembers     = types.ErrInvalidMembers
+	ErrNoMultisigAccount  = types.ErrNoMultisigAccount
+	ErrNotMember          = types.ErrNotMember
+	ErrNoProposal         = types.ErrNoProposal
+	ErrAlreadyApproved    = types.ErrAlreadyApproved
+	ErrInvalidProposalMsg = types.ErrInvalidProposalMsg
+	ErrMultisigNotEnabled = types.ErrMultisigNotEnabled
+)
+
+type (
+	Keeper          = keeper.Keeper
+	MultisigAccount = types.MultisigAccount
+	Proposal        = types.Proposal
+
+	MsgCreateMultisig  = types.MsgCreateMultisig
+	MsgSubmitProposal  = types.MsgSubmitProposal
+	MsgApproveProposal = types.MsgApproveProposal
+	MsgUpdateMembers   = types.MsgUpdateMembers
+)
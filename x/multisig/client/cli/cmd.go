@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagMembers    = "members"
+	flagThreshold  = "threshold"
+	flagAccount    = "account"
+	flagProposalID = "proposal-id"
+)
+
+func AddCommands(root *cobra.Command, cdc *codec.Codec) {
+	multisigCmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "multisig accounts whose msgs are approved by their members on chain",
+	}
+
+	multisigCmd.AddCommand(
+		client.PostCommands(
+			GetCmdCreateMultisig(cdc),
+			GetCmdSubmitProposal(cdc),
+			GetCmdApproveProposal(cdc),
+			GetCmdUpdateMembers(cdc),
+		)...)
+
+	multisigCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryAccount(cdc),
+			GetCmdQueryProposal(cdc),
+			GetCmdQueryProposals(cdc),
+		)...)
+
+	root.AddCommand(multisigCmd)
+}
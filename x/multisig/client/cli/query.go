@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/multisig"
+)
+
+// GetCmdQueryAccount implements the command to query the members and the threshold of a multisig account.
+func GetCmdQueryAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-account",
+		Short: "query the members and the threshold of a multisig account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+			return query(cdc, multisig.QueryAccount, multisig.QueryAccountParams{Account: account})
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.MarkFlagRequired(flagAccount)
+	return cmd
+}
+
+// GetCmdQueryProposal implements the command to query a pending proposal of a multisig account.
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-proposal",
+		Short: "query a pending proposal of a multisig account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+			params := multisig.QueryProposalParams{Account: account, ProposalID: viper.GetUint64(flagProposalID)}
+			return query(cdc, multisig.QueryProposal, params)
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.Flags().Uint64(flagProposalID, 0, "id of the proposal")
+	cmd.MarkFlagRequired(flagAccount)
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdQueryProposals implements the command to query the pending and failed proposals of a multisig account.
+func GetCmdQueryProposals(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-proposals",
+		Short: "query the pending and failed proposals of a multisig account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+			return query(cdc, multisig.QueryProposals, multisig.QueryAccountParams{Account: account})
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.MarkFlagRequired(flagAccount)
+	return cmd
+}
+
+func query(cdc *codec.Codec, path string, params interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", multisig.StoreKey, path), bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
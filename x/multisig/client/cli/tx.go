@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/multisig"
+)
+
+// GetCmdCreateMultisig implements the command to create a multisig account.
+func GetCmdCreateMultisig(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a multisig account with the members and the threshold",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			creator, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			members, err := parseMembers(viper.GetStringSlice(flagMembers))
+			if err != nil {
+				return err
+			}
+
+			msg := multisig.NewMsgCreateMultisig(creator, members, viper.GetInt64(flagThreshold))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagMembers, nil, "addresses of the members")
+	cmd.Flags().Int64(flagThreshold, 0, "the approvals needed to execute a proposal")
+	cmd.MarkFlagRequired(flagMembers)
+	cmd.MarkFlagRequired(flagThreshold)
+	return cmd
+}
+
+// GetCmdSubmitProposal implements the command to propose the msgs of a tx generated with --generate-only.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose [tx-json-file]",
+		Short: "propose the msgs of a generated tx signed by the multisig account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			proposer, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var stdTx auth.StdTx
+			if err := cdc.UnmarshalJSON(bz, &stdTx); err != nil {
+				return err
+			}
+
+			msg := multisig.NewMsgSubmitProposal(proposer, account, stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.MarkFlagRequired(flagAccount)
+	return cmd
+}
+
+// GetCmdApproveProposal implements the command to approve a pending proposal.
+func GetCmdApproveProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "approve a pending proposal of a multisig account, it is executed once the threshold is reached",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			member, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+
+			msg := multisig.NewMsgApproveProposal(member, account, viper.GetUint64(flagProposalID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.Flags().Uint64(flagProposalID, 0, "id of the proposal")
+	cmd.MarkFlagRequired(flagAccount)
+	cmd.MarkFlagRequired(flagProposalID)
+	return cmd
+}
+
+// GetCmdUpdateMembers implements the command to propose new members and threshold of a multisig account.
+func GetCmdUpdateMembers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-members",
+		Short: "propose to replace the members and the threshold of a multisig account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			proposer, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			account, err := sdk.AccAddressFromBech32(viper.GetString(flagAccount))
+			if err != nil {
+				return err
+			}
+			members, err := parseMembers(viper.GetStringSlice(flagMembers))
+			if err != nil {
+				return err
+			}
+
+			update := multisig.NewMsgUpdateMembers(account, members, viper.GetInt64(flagThreshold))
+			msg := multisig.NewMsgSubmitProposal(proposer, account, []sdk.Msg{update})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "address of the multisig account")
+	cmd.Flags().StringSlice(flagMembers, nil, "addresses of the new members")
+	cmd.Flags().Int64(flagThreshold, 0, "the approvals needed to execute a proposal")
+	cmd.MarkFlagRequired(flagAccount)
+	cmd.MarkFlagRequired(flagMembers)
+	cmd.MarkFlagRequired(flagThreshold)
+	return cmd
+}
+
+func parseMembers(addrs []string) ([]sdk.AccAddress, error) {
+	members := make([]sdk.AccAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		member, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
@@ -0,0 +1,54 @@
+package multisig
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if !sdk.IsUpgrade(sdk.MultisigAccount) {
+			return types.ErrMultisigNotEnabled().Result()
+		}
+		switch msg := msg.(type) {
+		case MsgCreateMultisig:
+			return handleMsgCreateMultisig(ctx, keeper, msg)
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgApproveProposal:
+			return keeper.ApproveProposal(ctx, msg.Member, msg.Account, msg.ProposalID)
+		case MsgUpdateMembers:
+			return handleMsgUpdateMembers(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized multisig msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateMultisig(ctx sdk.Context, keeper Keeper, msg MsgCreateMultisig) sdk.Result {
+	account := keeper.CreateMultisig(ctx, msg.Members, msg.Threshold)
+	return sdk.Result{
+		Data: account.Address,
+		Tags: sdk.NewTags("multisig_account", []byte(account.Address.String())),
+	}
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
+	id, res := keeper.SubmitProposal(ctx, msg.Proposer, msg.Account, msg.Msgs)
+	if !res.IsOK() {
+		return res
+	}
+	res.Tags = res.Tags.AppendTag("proposal_id", []byte(strconv.FormatUint(id, 10)))
+	return res
+}
+
+func handleMsgUpdateMembers(ctx sdk.Context, keeper Keeper, msg MsgUpdateMembers) sdk.Result {
+	if err := keeper.UpdateMembers(ctx, msg.Account, msg.Members, msg.Threshold); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
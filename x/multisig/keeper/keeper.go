@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+// Keeper stores the multisig accounts and their pending proposals, the approved proposals are executed by
+// the handlers of the router
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   baseapp.Router
+}
+
+// NewKeeper creates new instances of the multisig Keeper, the codec should register the msgs which can be proposed
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router baseapp.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// CreateMultisig creates a multisig account with a new address
+func (k Keeper) CreateMultisig(ctx sdk.Context, members []sdk.AccAddress, threshold int64) types.MultisigAccount {
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(types.NextAccountSeqKey); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq+1)
+	store.Set(types.NextAccountSeqKey, bz)
+
+	account := types.MultisigAccount{
+		Address:        types.AccountAddress(seq),
+		Members:        members,
+		Threshold:      threshold,
+		NextProposalID: 1,
+	}
+	k.setMultisigAccount(ctx, account)
+	return account
+}
+
+func (k Keeper) GetMultisigAccount(ctx sdk.Context, addr sdk.AccAddress) (account types.MultisigAccount, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAccountKey(addr))
+	if bz == nil {
+		return account, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &account)
+	return account, true
+}
+
+// UpdateMembers replaces the members and the threshold of the multisig account, the pending proposals are
+// dropped since they were approved by the former members
+func (k Keeper) UpdateMembers(ctx sdk.Context, addr sdk.AccAddress, members []sdk.AccAddress, threshold int64) sdk.Error {
+	account, found := k.GetMultisigAccount(ctx, addr)
+	if !found {
+		return types.ErrNoMultisigAccount(addr)
+	}
+	account.Members = members
+	account.Threshold = threshold
+	k.setMultisigAccount(ctx, account)
+
+	var ids []uint64
+	k.IterateProposals(ctx, addr, func(proposal types.Proposal) bool {
+		ids = append(ids, proposal.ID)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, id := range ids {
+		store.Delete(types.GetProposalKey(addr, id))
+	}
+	return nil
+}
+
+// SubmitProposal adds a proposal approved by the proposer, it is executed at once if the threshold is reached
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposer, addr sdk.AccAddress, msgs []sdk.Msg) (uint64, sdk.Result) {
+	account, found := k.GetMultisigAccount(ctx, addr)
+	if !found {
+		return 0, types.ErrNoMultisigAccount(addr).Result()
+	}
+	if !account.IsMember(proposer) {
+		return 0, types.ErrNotMember(addr, proposer).Result()
+	}
+
+	proposal := types.Proposal{
+		ID:        account.NextProposalID,
+		Account:   addr,
+		Proposer:  proposer,
+		Msgs:      msgs,
+		Approvals: []sdk.AccAddress{proposer},
+	}
+	account.NextProposalID++
+	k.setMultisigAccount(ctx, account)
+	return proposal.ID, k.approve(ctx, account, proposal)
+}
+
+// ApproveProposal adds the approval of the member to the proposal, it is executed once the threshold is reached
+func (k Keeper) ApproveProposal(ctx sdk.Context, member, addr sdk.AccAddress, id uint64) sdk.Result {
+	account, found := k.GetMultisigAccount(ctx, addr)
+	if !found {
+		return types.ErrNoMultisigAccount(addr).Result()
+	}
+	if !account.IsMember(member) {
+		return types.ErrNotMember(addr, member).Result()
+	}
+	proposal, found := k.GetProposal(ctx, addr, id)
+	if !found {
+		return types.ErrNoProposal(addr, id).Result()
+	}
+	if proposal.Failed {
+		return types.ErrProposalFailed(addr, id).Result()
+	}
+	if proposal.IsApprovedBy(member) {
+		return types.ErrAlreadyApproved(member, id).Result()
+	}
+	proposal.Approvals = append(proposal.Approvals, member)
+	return k.approve(ctx, account, proposal)
+}
+
+func (k Keeper) GetProposal(ctx sdk.Context, addr sdk.AccAddress, id uint64) (proposal types.Proposal, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(addr, id))
+	if bz == nil {
+		return proposal, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+	return proposal, true
+}
+
+// IterateProposals iterates the pending and failed proposals of the multisig account by id until the callback returns true
+func (k Keeper) IterateProposals(ctx sdk.Context, addr sdk.AccAddress, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProposalsKey(addr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// approve saves the proposal, or executes it if the threshold is reached. A proposal whose execution fails is
+// kept as failed, so the approval which reached the threshold is not reverted and the proposal can not be
+// approved again.
+func (k Keeper) approve(ctx sdk.Context, account types.MultisigAccount, proposal types.Proposal) sdk.Result {
+	store := ctx.KVStore(k.storeKey)
+	if int64(len(proposal.Approvals)) < account.Threshold {
+		store.Set(types.GetProposalKey(account.Address, proposal.ID), k.cdc.MustMarshalBinaryLengthPrefixed(proposal))
+		return sdk.Result{}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	res := baseapp.DispatchMsgs(cacheCtx, k.router, proposal.Msgs)
+	if !res.IsOK() {
+		ctx.Logger().With("module", "x/multisig").Info("multisig proposal failed",
+			"account", account.Address.String(), "id", proposal.ID, "log", res.Log)
+		proposal.Failed = true
+		store.Set(types.GetProposalKey(account.Address, proposal.ID), k.cdc.MustMarshalBinaryLengthPrefixed(proposal))
+		return sdk.Result{
+			Log:  res.Log,
+			Tags: sdk.NewTags("proposal_failed", []byte(strconv.FormatUint(proposal.ID, 10))),
+		}
+	}
+	write()
+	store.Delete(types.GetProposalKey(account.Address, proposal.ID))
+	return res
+}
+
+func (k Keeper) setMultisigAccount(ctx sdk.Context, account types.MultisigAccount) {
+	ctx.KVStore(k.storeKey).Set(types.GetAccountKey(account.Address), k.cdc.MustMarshalBinaryLengthPrefixed(account))
+}
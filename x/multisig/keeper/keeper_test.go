@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, *[]sdk.Msg) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("acc")
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	auth.RegisterBaseAccount(cdc)
+
+	// the bank handler records the msgs executed
+	var executed []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute("bank", func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		executed = append(executed, msg)
+		return sdk.Result{}
+	})
+	keeper := NewKeeper(cdc, key, router)
+	router.AddRoute(types.RouteMultisig, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		update := msg.(types.MsgUpdateMembers)
+		if err := keeper.UpdateMembers(ctx, update.Account, update.Members, update.Threshold); err != nil {
+			return err.Result()
+		}
+		return sdk.Result{}
+	})
+
+	accountCache := auth.NewAccountCache(auth.NewAccountStoreCache(cdc, ms.GetKVStore(authKey), 10))
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, sdk.RunTxModeDeliver, log.NewNopLogger()).
+		WithAccountCache(accountCache)
+	return ctx, keeper, &executed
+}
+
+func TestProposalApprovals(t *testing.T) {
+	ctx, keeper, executed := createTestInput(t)
+	alice := sdk.AccAddress([]byte("alice---------------"))
+	bob := sdk.AccAddress([]byte("bob-----------------"))
+	carol := sdk.AccAddress([]byte("carol---------------"))
+	other := sdk.AccAddress([]byte("other---------------"))
+
+	account := keeper.CreateMultisig(ctx, []sdk.AccAddress{alice, bob, carol}, 2)
+	require.Equal(t, types.AccountAddress(0), account.Address)
+	require.Equal(t, types.AccountAddress(1), keeper.CreateMultisig(ctx, []sdk.AccAddress{alice}, 1).Address)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 10)}
+	send := bank.NewMsgSend([]bank.Input{bank.NewInput(account.Address, coins)}, []bank.Output{bank.NewOutput(other, coins)})
+
+	_, res := keeper.SubmitProposal(ctx, other, account.Address, []sdk.Msg{send})
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNotMember), res.Code)
+	_, res = keeper.SubmitProposal(ctx, alice, other, []sdk.Msg{send})
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNoMultisigAccount), res.Code)
+
+	// the proposal is approved by the proposer and executed by the second approval
+	id, res := keeper.SubmitProposal(ctx, alice, account.Address, []sdk.Msg{send})
+	require.True(t, res.IsOK())
+	require.Len(t, *executed, 0)
+	proposal, found := keeper.GetProposal(ctx, account.Address, id)
+	require.True(t, found)
+	require.Equal(t, []sdk.AccAddress{alice}, proposal.Approvals)
+
+	res = keeper.ApproveProposal(ctx, alice, account.Address, id)
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeAlreadyApproved), res.Code)
+	res = keeper.ApproveProposal(ctx, other, account.Address, id)
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNotMember), res.Code)
+	require.True(t, keeper.ApproveProposal(ctx, bob, account.Address, id).IsOK())
+	require.Equal(t, []sdk.Msg{send}, *executed)
+	_, found = keeper.GetProposal(ctx, account.Address, id)
+	require.False(t, found)
+	res = keeper.ApproveProposal(ctx, carol, account.Address, id)
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNoProposal), res.Code)
+}
+
+func TestUpdateMembers(t *testing.T) {
+	ctx, keeper, executed := createTestInput(t)
+	alice := sdk.AccAddress([]byte("alice---------------"))
+	bob := sdk.AccAddress([]byte("bob-----------------"))
+	carol := sdk.AccAddress([]byte("carol---------------"))
+
+	account := keeper.CreateMultisig(ctx, []sdk.AccAddress{alice, bob}, 2)
+	coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 10)}
+	send := bank.NewMsgSend([]bank.Input{bank.NewInput(account.Address, coins)}, []bank.Output{bank.NewOutput(carol, coins)})
+	pending, res := keeper.SubmitProposal(ctx, bob, account.Address, []sdk.Msg{send})
+	require.True(t, res.IsOK())
+
+	// bob is rotated out for carol, the address of the account does not change
+	update := types.NewMsgUpdateMembers(account.Address, []sdk.AccAddress{alice, carol}, 1)
+	id, res := keeper.SubmitProposal(ctx, alice, account.Address, []sdk.Msg{update})
+	require.True(t, res.IsOK())
+	require.True(t, keeper.ApproveProposal(ctx, bob, account.Address, id).IsOK())
+
+	updated, found := keeper.GetMultisigAccount(ctx, account.Address)
+	require.True(t, found)
+	require.Equal(t, []sdk.AccAddress{alice, carol}, updated.Members)
+	require.Equal(t, int64(1), updated.Threshold)
+	require.False(t, updated.IsMember(bob))
+
+	// the pending proposals approved by the former members are dropped
+	_, found = keeper.GetProposal(ctx, account.Address, pending)
+	require.False(t, found)
+	res = keeper.ApproveProposal(ctx, bob, account.Address, pending)
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeNotMember), res.Code)
+
+	// the proposals of the new members are executed with the new threshold
+	_, res = keeper.SubmitProposal(ctx, carol, account.Address, []sdk.Msg{send})
+	require.True(t, res.IsOK())
+	require.Equal(t, []sdk.Msg{send}, *executed)
+}
+
+func TestFailedProposal(t *testing.T) {
+	ctx, keeper, executed := createTestInput(t)
+	alice := sdk.AccAddress([]byte("alice---------------"))
+	bob := sdk.AccAddress([]byte("bob-----------------"))
+	carol := sdk.AccAddress([]byte("carol---------------"))
+	other := sdk.AccAddress([]byte("other---------------"))
+
+	account := keeper.CreateMultisig(ctx, []sdk.AccAddress{alice, bob, carol}, 2)
+	coins := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 10)}
+	send := bank.NewMsgSend([]bank.Input{bank.NewInput(account.Address, coins)}, []bank.Output{bank.NewOutput(other, coins)})
+
+	// the update fails since other is not a multisig account, the send before it is reverted
+	update := types.NewMsgUpdateMembers(other, []sdk.AccAddress{alice}, 1)
+	id, res := keeper.SubmitProposal(ctx, alice, account.Address, []sdk.Msg{send, update})
+	require.True(t, res.IsOK())
+	res = keeper.ApproveProposal(ctx, bob, account.Address, id)
+	require.True(t, res.IsOK())
+	require.Equal(t, "proposal_failed", string(res.Tags[0].Key))
+
+	proposal, found := keeper.GetProposal(ctx, account.Address, id)
+	require.True(t, found)
+	require.True(t, proposal.Failed)
+	require.Equal(t, []sdk.AccAddress{alice, bob}, proposal.Approvals)
+	res = keeper.ApproveProposal(ctx, carol, account.Address, id)
+	require.Equal(t, sdk.ToABCICode(types.DefaultCodespace, types.CodeProposalFailed), res.Code)
+	require.Len(t, *executed, 1)
+}
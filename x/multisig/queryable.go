@@ -0,0 +1,87 @@
+package multisig
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryAccount   = "account"
+	QueryProposal  = "proposal"
+	QueryProposals = "proposals"
+)
+
+type QueryAccountParams struct {
+	Account sdk.AccAddress
+}
+
+type QueryProposalParams struct {
+	Account    sdk.AccAddress
+	ProposalID uint64
+}
+
+// creates a querier for the multisig REST endpoints
+func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryAccount:
+			return queryAccount(ctx, k, cdc, req)
+		case QueryProposal:
+			return queryProposal(ctx, k, cdc, req)
+		case QueryProposals:
+			return queryProposals(ctx, k, cdc, req)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown multisig query endpoint")
+		}
+	}
+}
+
+func queryAccount(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAccountParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	account, found := k.GetMultisigAccount(ctx, params.Account)
+	if !found {
+		return nil, types.ErrNoMultisigAccount(params.Account)
+	}
+	return marshalJSON(cdc, account)
+}
+
+func queryProposal(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryProposalParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, found := k.GetProposal(ctx, params.Account, params.ProposalID)
+	if !found {
+		return nil, types.ErrNoProposal(params.Account, params.ProposalID)
+	}
+	return marshalJSON(cdc, proposal)
+}
+
+func queryProposals(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAccountParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposals := make([]Proposal, 0)
+	k.IterateProposals(ctx, params.Account, func(proposal Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return marshalJSON(cdc, proposals)
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,12 @@
+package multisig
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+func Routes(keeper Keeper) map[string]sdk.Handler {
+	routes := make(map[string]sdk.Handler)
+	routes[types.RouteMultisig] = NewHandler(keeper)
+	return routes
+}
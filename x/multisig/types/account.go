@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultisigAccount is an account managed by the module, its msgs are executed once Threshold of the
+// members approved them. The members and the threshold can be changed without changing the address.
+type MultisigAccount struct {
+	Address        sdk.AccAddress   `json:"address"`
+	Members        []sdk.AccAddress `json:"members"`
+	Threshold      int64            `json:"threshold"`
+	NextProposalID uint64           `json:"next_proposal_id"`
+}
+
+// IsMember returns true if the address is one of the members
+func (a MultisigAccount) IsMember(addr sdk.AccAddress) bool {
+	for _, member := range a.Members {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a MultisigAccount) String() string {
+	return fmt.Sprintf("MultisigAccount{%s, %d of %v}", a.Address, a.Threshold, a.Members)
+}
+
+// Proposal is a list of msgs signed by a multisig account, waiting for the approvals of its members.
+// A proposal whose execution failed is kept as failed until the members of the account are updated.
+type Proposal struct {
+	ID        uint64           `json:"id"`
+	Account   sdk.AccAddress   `json:"account"`
+	Proposer  sdk.AccAddress   `json:"proposer"`
+	Msgs      []sdk.Msg        `json:"msgs"`
+	Approvals []sdk.AccAddress `json:"approvals"`
+	Failed    bool             `json:"failed"`
+}
+
+// IsApprovedBy returns true if the address approved the proposal
+func (p Proposal) IsApprovedBy(addr sdk.AccAddress) bool {
+	for _, approval := range p.Approvals {
+		if approval.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Proposal) String() string {
+	return fmt.Sprintf("Proposal{%d of %s by %s, %v, approved by %v, failed: %t}",
+		p.ID, p.Account, p.Proposer, p.Msgs, p.Approvals, p.Failed)
+}
+
+// ValidateMembers checks the members are distinct and the threshold can be reached
+func ValidateMembers(members []sdk.AccAddress, threshold int64) sdk.Error {
+	if len(members) == 0 || len(members) > MaxMembers {
+		return ErrInvalidMembers(fmt.Sprintf("the number of members should be between 1 and %d", MaxMembers))
+	}
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if len(member) != sdk.AddrLen {
+			return sdk.ErrInvalidAddress(member.String())
+		}
+		if seen[string(member)] {
+			return ErrInvalidMembers(fmt.Sprintf("duplicate member %s", member))
+		}
+		seen[string(member)] = true
+	}
+	if threshold <= 0 || threshold > int64(len(members)) {
+		return ErrInvalidMembers(fmt.Sprintf("the threshold should be between 1 and %d", len(members)))
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msgs of the multisig module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateMultisig{}, "cosmos-sdk/MsgCreateMultisig", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitMultisigProposal", nil)
+	cdc.RegisterConcrete(MsgApproveProposal{}, "cosmos-sdk/MsgApproveMultisigProposal", nil)
+	cdc.RegisterConcrete(MsgUpdateMembers{}, "cosmos-sdk/MsgUpdateMultisigMembers", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
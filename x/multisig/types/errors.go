@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 35
+
+	CodeInvalidMembers     sdk.CodeType = 1
+	CodeNoMultisigAccount  sdk.CodeType = 2
+	CodeNotMember          sdk.CodeType = 3
+	CodeNoProposal         sdk.CodeType = 4
+	CodeAlreadyApproved    sdk.CodeType = 5
+	CodeInvalidProposalMsg sdk.CodeType = 6
+	CodeMultisigNotEnabled sdk.CodeType = 7
+	CodeProposalFailed     sdk.CodeType = 8
+)
+
+func ErrInvalidMembers(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidMembers, msg)
+}
+
+func ErrNoMultisigAccount(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoMultisigAccount, fmt.Sprintf("%s is not a multisig account", addr))
+}
+
+func ErrNotMember(account, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotMember,
+		fmt.Sprintf("%s is not a member of multisig account %s", addr, account))
+}
+
+func ErrNoProposal(account sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoProposal,
+		fmt.Sprintf("proposal %d of multisig account %s does not exist", id, account))
+}
+
+func ErrAlreadyApproved(addr sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAlreadyApproved, fmt.Sprintf("%s already approved proposal %d", addr, id))
+}
+
+func ErrProposalFailed(account sdk.AccAddress, id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeProposalFailed,
+		fmt.Sprintf("proposal %d of multisig account %s failed to execute", id, account))
+}
+
+func ErrInvalidProposalMsg(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidProposalMsg, msg)
+}
+
+func ErrMultisigNotEnabled() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMultisigNotEnabled, "multisig account is not enabled yet")
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey      = "multisig"
+	RouteMultisig = "multisig"
+
+	MaxMembers      = 32
+	MaxProposalMsgs = 16
+)
+
+var (
+	AccountKeyPrefix  = []byte{0x01} // prefix for each key to a multisig account
+	ProposalKeyPrefix = []byte{0x02} // prefix for the pending and failed proposals of the multisig accounts
+	NextAccountSeqKey = []byte{0x03} // key for the sequence deriving the address of the next multisig account
+)
+
+// AccountAddress derives the address of the multisig account created with the sequence, there is no
+// private key of the address so it can only send the msgs executed by the proposals
+func AccountAddress(seq uint64) sdk.AccAddress {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return sdk.AccAddress(crypto.AddressHash(append([]byte("BinanceChainMultisigAccount"), bz...)))
+}
+
+func GetAccountKey(addr sdk.AccAddress) []byte {
+	return append(AccountKeyPrefix, addr.Bytes()...)
+}
+
+func GetProposalsKey(addr sdk.AccAddress) []byte {
+	return append(ProposalKeyPrefix, addr.Bytes()...)
+}
+
+func GetProposalKey(addr sdk.AccAddress, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetProposalsKey(addr), bz...)
+}
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	CreateMultisigMsgType  = "create_multisig"
+	SubmitProposalMsgType  = "submit_multisig_proposal"
+	ApproveProposalMsgType = "approve_multisig_proposal"
+	UpdateMembersMsgType   = "update_multisig_members"
+)
+
+var _ sdk.Msg = MsgCreateMultisig{}
+
+// MsgCreateMultisig creates a multisig account with the members and the threshold, the creator does not
+// need to be a member
+type MsgCreateMultisig struct {
+	Creator   sdk.AccAddress   `json:"creator"`
+	Members   []sdk.AccAddress `json:"members"`
+	Threshold int64            `json:"threshold"`
+}
+
+func NewMsgCreateMultisig(creator sdk.AccAddress, members []sdk.AccAddress, threshold int64) MsgCreateMultisig {
+	return MsgCreateMultisig{
+		Creator:   creator,
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+// nolint
+func (msg MsgCreateMultisig) Route() string { return RouteMultisig }
+func (msg MsgCreateMultisig) Type() string  { return CreateMultisigMsgType }
+func (msg MsgCreateMultisig) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+func (msg MsgCreateMultisig) String() string {
+	return fmt.Sprintf("MsgCreateMultisig{%s, %d of %v}", msg.Creator, msg.Threshold, msg.Members)
+}
+
+func (msg MsgCreateMultisig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateMultisig) ValidateBasic() sdk.Error {
+	if len(msg.Creator) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Creator.String())
+	}
+	return ValidateMembers(msg.Members, msg.Threshold)
+}
+
+func (msg MsgCreateMultisig) GetInvolvedAddresses() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Creator}, msg.Members...)
+}
+
+var _ sdk.Msg = MsgSubmitProposal{}
+
+// MsgSubmitProposal proposes the msgs signed by the multisig account, the proposal is approved by the
+// proposer and executed at once if the threshold is reached
+type MsgSubmitProposal struct {
+	Proposer sdk.AccAddress `json:"proposer"`
+	Account  sdk.AccAddress `json:"account"`
+	Msgs     []sdk.Msg      `json:"msgs"`
+}
+
+func NewMsgSubmitProposal(proposer, account sdk.AccAddress, msgs []sdk.Msg) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Proposer: proposer,
+		Account:  account,
+		Msgs:     msgs,
+	}
+}
+
+// nolint
+func (msg MsgSubmitProposal) Route() string { return RouteMultisig }
+func (msg MsgSubmitProposal) Type() string  { return SubmitProposalMsgType }
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+func (msg MsgSubmitProposal) String() string {
+	return fmt.Sprintf("MsgSubmitProposal{%s, %s, %v}", msg.Proposer, msg.Account, msg.Msgs)
+}
+
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+	b, err := json.Marshal(struct {
+		Proposer sdk.AccAddress    `json:"proposer"`
+		Account  sdk.AccAddress    `json:"account"`
+		Msgs     []json.RawMessage `json:"msgs"`
+	}{msg.Proposer, msg.Account, msgsBytes})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	if len(msg.Account) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Account.String())
+	}
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxProposalMsgs {
+		return ErrInvalidProposalMsg(fmt.Sprintf("the number of msgs should be between 1 and %d", MaxProposalMsgs))
+	}
+	for _, m := range msg.Msgs {
+		switch m.(type) {
+		case MsgCreateMultisig, MsgSubmitProposal, MsgApproveProposal:
+			return ErrInvalidProposalMsg(fmt.Sprintf("msg %s/%s can not be proposed", m.Route(), m.Type()))
+		}
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(msg.Account) {
+			return ErrInvalidProposalMsg(fmt.Sprintf("msg %s/%s should only be signed by %s", m.Route(), m.Type(), msg.Account))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgSubmitProposal) GetInvolvedAddresses() []sdk.AccAddress {
+	addrs := []sdk.AccAddress{msg.Proposer, msg.Account}
+	for _, m := range msg.Msgs {
+		addrs = append(addrs, m.GetInvolvedAddresses()...)
+	}
+	return addrs
+}
+
+var _ sdk.Msg = MsgApproveProposal{}
+
+// MsgApproveProposal approves a pending proposal of the multisig account, the proposal is executed once
+// the threshold is reached
+type MsgApproveProposal struct {
+	Member     sdk.AccAddress `json:"member"`
+	Account    sdk.AccAddress `json:"account"`
+	ProposalID uint64         `json:"proposal_id"`
+}
+
+func NewMsgApproveProposal(member, account sdk.AccAddress, proposalID uint64) MsgApproveProposal {
+	return MsgApproveProposal{
+		Member:     member,
+		Account:    account,
+		ProposalID: proposalID,
+	}
+}
+
+// nolint
+func (msg MsgApproveProposal) Route() string { return RouteMultisig }
+func (msg MsgApproveProposal) Type() string  { return ApproveProposalMsgType }
+func (msg MsgApproveProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member}
+}
+
+func (msg MsgApproveProposal) String() string {
+	return fmt.Sprintf("MsgApproveProposal{%s, %s, %d}", msg.Member, msg.Account, msg.ProposalID)
+}
+
+func (msg MsgApproveProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveProposal) ValidateBasic() sdk.Error {
+	if len(msg.Member) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Member.String())
+	}
+	if len(msg.Account) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Account.String())
+	}
+	return nil
+}
+
+func (msg MsgApproveProposal) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member, msg.Account}
+}
+
+var _ sdk.Msg = MsgUpdateMembers{}
+
+// MsgUpdateMembers replaces the members and the threshold of the multisig account. It is signed by the
+// multisig account itself, so it can only be executed by a proposal. The pending proposals are dropped.
+type MsgUpdateMembers struct {
+	Account   sdk.AccAddress   `json:"account"`
+	Members   []sdk.AccAddress `json:"members"`
+	Threshold int64            `json:"threshold"`
+}
+
+func NewMsgUpdateMembers(account sdk.AccAddress, members []sdk.AccAddress, threshold int64) MsgUpdateMembers {
+	return MsgUpdateMembers{
+		Account:   account,
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+// nolint
+func (msg MsgUpdateMembers) Route() string { return RouteMultisig }
+func (msg MsgUpdateMembers) Type() string  { return UpdateMembersMsgType }
+func (msg MsgUpdateMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Account}
+}
+
+func (msg MsgUpdateMembers) String() string {
+	return fmt.Sprintf("MsgUpdateMembers{%s, %d of %v}", msg.Account, msg.Threshold, msg.Members)
+}
+
+func (msg MsgUpdateMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUpdateMembers) ValidateBasic() sdk.Error {
+	if len(msg.Account) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.Account.String())
+	}
+	if err := ValidateMembers(msg.Members, msg.Threshold); err != nil {
+		return err
+	}
+	for _, member := range msg.Members {
+		if member.Equals(msg.Account) {
+			return ErrInvalidMembers("the multisig account can not be its own member")
+		}
+	}
+	return nil
+}
+
+func (msg MsgUpdateMembers) GetInvolvedAddresses() []sdk.AccAddress {
+	return append([]sdk.AccAddress{msg.Account}, msg.Members...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSubmitProposalValidateBasic(t *testing.T) {
+	proposer := sdk.AccAddress([]byte("proposer------------"))
+	account := AccountAddress(0)
+	members := []sdk.AccAddress{proposer}
+	update := NewMsgUpdateMembers(account, members, 1)
+
+	tests := []struct {
+		msg     MsgSubmitProposal
+		expPass bool
+	}{
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{update}), true},
+		{NewMsgSubmitProposal(nil, account, []sdk.Msg{update}), false},
+		{NewMsgSubmitProposal(proposer, account, nil), false},
+		// the msgs should be signed by the multisig account
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{sdk.NewTestMsg(proposer)}), false},
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{NewMsgUpdateMembers(proposer, members, 1)}), false},
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{NewMsgUpdateMembers(account, members, 2)}), false},
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{NewMsgCreateMultisig(account, members, 1)}), false},
+		{NewMsgSubmitProposal(proposer, account, []sdk.Msg{NewMsgApproveProposal(account, account, 1)}), false},
+	}
+
+	for i, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.Nil(t, err, "test: %v", i)
+		} else {
+			require.NotNil(t, err, "test: %v", i)
+		}
+	}
+}
+
+func TestValidateMembers(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice---------------"))
+	bob := sdk.AccAddress([]byte("bob-----------------"))
+
+	require.Nil(t, ValidateMembers([]sdk.AccAddress{alice, bob}, 2))
+	require.NotNil(t, ValidateMembers(nil, 1))
+	require.NotNil(t, ValidateMembers([]sdk.AccAddress{alice, bob}, 0))
+	require.NotNil(t, ValidateMembers([]sdk.AccAddress{alice, bob}, 3))
+	require.NotNil(t, ValidateMembers([]sdk.AccAddress{alice, alice}, 1))
+	require.NotNil(t, ValidateMembers([]sdk.AccAddress{sdk.AccAddress("short")}, 1))
+}
@@ -0,0 +1,11 @@
+package multisig
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/multisig/types"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
@@ -33,8 +33,14 @@ func AddCommands(cmd *cobra.Command, cdc *amino.Codec) {
 	dexCmd.AddCommand(
 		client.GetCommands(
 			ShowFeeParamsCmd(cdc))...)
+	dexCmd.AddCommand(
+		client.GetCommands(
+			ShowCongestionCmd(cdc))...)
 	dexCmd.AddCommand(
 		client.GetCommands(
 			ShowSideChainParamsCmd(cdc))...)
+	dexCmd.AddCommand(
+		client.GetCommands(
+			DryRunProposalCmd(cdc))...)
 	cmd.AddCommand(dexCmd)
 }
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/paramHub"
+	"github.com/cosmos/cosmos-sdk/x/paramHub/types"
+)
+
+const (
+	flagProposalType = "proposal-type"
+	flagParamFile    = "param-file"
+)
+
+func DryRunProposalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-proposal",
+		Short: "Simulate a FeeChange, SCParamsChange or CSCParamsChange proposal against the current state",
+		Long: `Simulate a param change proposal against the current state without submitting it.
+The param file is the same as the one of the submit commands, the fee params for FeeChange, the side chain params
+for SCParamsChange, or a cross side chain param change for CSCParamsChange, e.g.
+
+$ CLI params dry-run-proposal --proposal-type FeeChange --param-file fees.json
+$ CLI params dry-run-proposal --proposal-type SCParamsChange --param-file sc_params.json --side-chain-id bsc
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+			proposalType := viper.GetString(flagProposalType)
+			paramFile := viper.GetString(flagParamFile)
+			sideChainId := viper.GetString(flagSideChainId)
+			if paramFile == "" {
+				return errors.New("param-file is missing")
+			}
+			bz, err := os.ReadFile(paramFile)
+			if err != nil {
+				return err
+			}
+
+			var payload interface{}
+			switch proposalType {
+			case gov.ProposalTypeFeeChange.String():
+				feeParam := types.FeeChangeParams{FeeParams: make([]types.FeeParam, 0)}
+				err = cdc.UnmarshalJSON(bz, &(feeParam.FeeParams))
+				payload = feeParam
+			case gov.ProposalTypeSCParamsChange.String():
+				var scParams types.SCChangeParams
+				err = cdc.UnmarshalJSON(bz, &(scParams.SCParams))
+				payload = scParams
+			case gov.ProposalTypeCSCParamsChange.String():
+				var cscParam types.CSCParamChange
+				err = cdc.UnmarshalJSON(bz, &cscParam)
+				payload = cscParam
+			default:
+				return fmt.Errorf("proposal type %s is not supported, options [%s, %s, %s]", proposalType,
+					gov.ProposalTypeFeeChange, gov.ProposalTypeSCParamsChange, gov.ProposalTypeCSCParamsChange)
+			}
+			if err != nil {
+				return err
+			}
+			// payload get interface field, use amino
+			payloadBz, err := cdc.MarshalJSON(payload)
+			if err != nil {
+				return err
+			}
+
+			data, err := cdc.MarshalJSON(types.DryRunParams{ProposalType: proposalType, SideChainId: sideChainId, Payload: string(payloadBz)})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.Query(fmt.Sprintf("%s/dryRun", paramHub.AbciQueryPrefix), data)
+			if err != nil {
+				return err
+			}
+			var result types.DryRunResult
+			err = cdc.UnmarshalJSON(res, &result)
+			if err != nil {
+				return err
+			}
+			output, err := cdc.MarshalJSONIndent(result, "", "\t")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, gov.ProposalTypeFeeChange.String(), "the type of the proposal, options: [FeeChange, SCParamsChange, CSCParamsChange]")
+	cmd.Flags().String(flagParamFile, "", "the file of the params to change (json format)")
+	cmd.Flags().String(flagSideChainId, "", "the id of side chain, required by SCParamsChange and CSCParamsChange")
+	return cmd
+}
@@ -143,3 +143,29 @@ func ShowFeeParamsCmd(cdc *amino.Codec) *cobra.Command {
 	cmd.Flags().String(flagFormat, types.AMINOFORMAT, fmt.Sprintf("the response format, options: [%s, %s]", types.AMINOFORMAT, types.JSONFORMAT))
 	return cmd
 }
+
+func ShowCongestionCmd(cdc *amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-congestion",
+		Short: "Show the congestion fee multiplier applied on top of the calculated fees",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bz, err := cliCtx.Query(fmt.Sprintf("%s/congestion", paramHub.AbciQueryPrefix), nil)
+			if err != nil {
+				return err
+			}
+			var info types.CongestionInfo
+			err = cdc.UnmarshalJSON(bz, &info)
+			if err != nil {
+				return err
+			}
+			output, err := cdc.MarshalJSONIndent(info, "", "\t")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
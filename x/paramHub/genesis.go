@@ -50,6 +50,8 @@ const (
 	SideChainUnjail      = 1e8
 	Unjail               = 1e8
 
+	ClaimSlashCompensationFee = 1e6
+
 	// Transfer fee
 	TransferFee       = 62500
 	MultiTransferFee  = 50000 // discount 80%
@@ -26,7 +26,7 @@ func (hooks FeeChangeHooks) OnProposalSubmitted(ctx sdk.Context, proposal gov.Pr
 	}
 
 	feeParams := types.FeeChangeParams{}
-	err := hooks.cdc.UnmarshalJSON([]byte(proposal.GetDescription()), &feeParams)
+	err := gov.DecodeProposalContent(hooks.cdc, proposal, &feeParams)
 	if err != nil {
 		return fmt.Errorf("unmarshal feeParam error, err=%s", err.Error())
 	}
@@ -51,8 +51,7 @@ func (hooks CSCParamsChangeHooks) OnProposalSubmitted(ctx sdk.Context, proposal
 	}
 
 	var changeParam types.CSCParamChange
-	strProposal := proposal.GetDescription()
-	err := hooks.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+	err := gov.DecodeProposalContent(hooks.cdc, proposal, &changeParam)
 	if err != nil {
 		return fmt.Errorf("get broken data when unmarshal CSCParamChange msg. proposalId %d, err %v", proposal.GetProposalID(), err)
 	}
@@ -76,8 +75,7 @@ func (hooks SCParamsChangeHooks) OnProposalSubmitted(ctx sdk.Context, proposal g
 	}
 
 	var changeParam types.SCChangeParams
-	strProposal := proposal.GetDescription()
-	err := hooks.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+	err := gov.DecodeProposalContent(hooks.cdc, proposal, &changeParam)
 	if err != nil {
 		return fmt.Errorf("get broken data when unmarshal SCParamsChange msg. proposalId %d, err %v", proposal.GetProposalID(), err)
 	}
@@ -105,8 +103,7 @@ func (hooks BCParamsChangeHooks) OnProposalSubmitted(ctx sdk.Context, proposal g
 	}
 
 	var changeParam types.BCChangeParams
-	strProposal := proposal.GetDescription()
-	err := hooks.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+	err := gov.DecodeProposalContent(hooks.cdc, proposal, &changeParam)
 	if err != nil {
 		return fmt.Errorf("get broken data when unmarshal BCParamsChange msg. proposalId %d, err %v", proposal.GetProposalID(), err)
 	}
@@ -140,6 +140,12 @@ func RegisterUpgradeBeginBlocker(paramHub *ParamHub) {
 		}
 		paramHub.UpdateFeeParams(ctx, updateFeeParams)
 	})
+	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.SlashInsurance, func(ctx sdk.Context) {
+		updateFeeParams := []param.FeeParam{
+			&param.FixedFeeParams{MsgType: "claim_slash_compensation", Fee: ClaimSlashCompensationFee, FeeFor: sdk.FeeForProposer},
+		}
+		paramHub.UpdateFeeParams(ctx, updateFeeParams)
+	})
 	sdk.UpgradeMgr.RegisterBeginBlocker(sdk.FirstSunsetFork, func(ctx sdk.Context) {
 		updateFeeParams := []param.FeeParam{
 			&param.FixedFeeParams{MsgType: "side_stake_migration", Fee: SideChainStakeMigrationFee, FeeFor: sdk.FeeForProposer},
@@ -216,5 +222,6 @@ func init() {
 		"redelegate":                           fees.FixedFeeCalculatorGen,
 		"undelegate":                           fees.FixedFeeCalculatorGen,
 		"unjail":                               fees.FixedFeeCalculatorGen,
+		"claim_slash_compensation":             fees.FixedFeeCalculatorGen,
 	}
 }
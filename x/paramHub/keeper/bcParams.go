@@ -10,7 +10,7 @@ func (keeper *Keeper) getLastBCParamChanges(ctx sdk.Context) *types.BCChangePara
 	var latestProposal *gov.Proposal
 	lastProposalId := keeper.GetLastBCParamChangeProposalId(ctx)
 	keeper.govKeeper.Iterate(ctx, nil, nil, gov.StatusPassed, lastProposalId.ProposalID, true, func(proposal gov.Proposal) bool {
-		if proposal.GetProposalType() == gov.ProposalTypeParameterChange {
+		if proposal.GetProposalType() == gov.ProposalTypeParameterChange && proposal.GetContent() == nil {
 			latestProposal = &proposal
 			return true
 		}
@@ -19,8 +19,7 @@ func (keeper *Keeper) getLastBCParamChanges(ctx sdk.Context) *types.BCChangePara
 
 	if latestProposal != nil {
 		var changeParam types.BCChangeParams
-		strProposal := (*latestProposal).GetDescription()
-		err := keeper.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+		err := gov.DecodeProposalContent(keeper.cdc, *latestProposal, &changeParam)
 		if err != nil {
 			keeper.Logger(ctx).Error("Get broken data when unmarshal BCParamsChange msg, will skip.", "proposalId", (*latestProposal).GetProposalID(), "err", err)
 			return nil
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
+	"github.com/cosmos/cosmos-sdk/x/paramHub/types"
+)
+
+func (keeper *Keeper) GetCongestionFeeParam(ctx sdk.Context) *types.CongestionFeeParam {
+	for _, fp := range keeper.GetFeeParams(ctx) {
+		if fp, ok := fp.(*types.CongestionFeeParam); ok {
+			return fp
+		}
+	}
+	return nil
+}
+
+func (keeper *Keeper) GetCongestionMultiplier(ctx sdk.Context) sdk.Dec {
+	multiplier := sdk.OneDec()
+	keeper.paramSpace.GetIfExists(ctx, ParamStoreKeyCongestionMultiplier, &multiplier)
+	return multiplier
+}
+
+func (keeper *Keeper) setCongestionMultiplier(ctx sdk.Context, multiplier sdk.Dec) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyCongestionMultiplier, multiplier)
+	fees.Congestion.SetMultiplier(multiplier)
+}
+
+func (keeper *Keeper) GetCongestionInfo(ctx sdk.Context) types.CongestionInfo {
+	param := keeper.GetCongestionFeeParam(ctx)
+	return types.CongestionInfo{
+		Enabled:    sdk.IsUpgrade(sdk.CongestionFee) && param != nil,
+		Multiplier: keeper.GetCongestionMultiplier(ctx),
+		Param:      param,
+	}
+}
+
+// updateCongestionMultiplier moves the multiplier according to the number of txs in the current block,
+// the new multiplier applies to the txs from the next block on
+func (keeper *Keeper) updateCongestionMultiplier(ctx sdk.Context) {
+	param := keeper.GetCongestionFeeParam(ctx)
+	if param == nil {
+		return
+	}
+	current := keeper.GetCongestionMultiplier(ctx)
+	next := param.NextMultiplier(current, ctx.BlockHeader().NumTxs)
+	if next.Equal(current) {
+		return
+	}
+	keeper.Logger(ctx).Debug("Congestion fee multiplier changed", "from", current, "to", next)
+	keeper.setCongestionMultiplier(ctx, next)
+}
+
+func (keeper *Keeper) loadCongestionMultiplier(ctx sdk.Context) {
+	fees.Congestion.SetMultiplier(keeper.GetCongestionMultiplier(ctx))
+}
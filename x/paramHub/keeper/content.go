@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/paramHub/types"
+)
+
+// registerContentHandlers makes the gov keeper apply the param changes of the passed proposals with typed content,
+// the proposals with the changes in their JSON descriptions are still picked up by EndBlock and EndBreatheBlock.
+func (keeper *Keeper) registerContentHandlers(govKeeper *gov.Keeper) {
+	govKeeper.AddContentHandler(gov.ProposalTypeParameterChange, keeper.handleBCParamsChangeContent).
+		AddContentHandler(gov.ProposalTypeFeeChange, keeper.handleFeeChangeContent).
+		AddContentHandler(gov.ProposalTypeSCParamsChange, keeper.handleSCParamsChangeContent).
+		AddContentHandler(gov.ProposalTypeCSCParamsChange, keeper.handleCSCParamsChangeContent)
+}
+
+func (keeper *Keeper) handleBCParamsChangeContent(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+	if !sdk.IsUpgrade(sdk.BEP159) {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, "beacon chain params change is not supported yet")
+	}
+	changes, ok := content.Payload().(types.BCChangeParams)
+	if !ok {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, fmt.Sprintf("unexpected content %T", content))
+	}
+	if err := changes.Check(); err != nil {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+	}
+	for _, change := range changes.BCParams {
+		keeper.notifyOnBCUpdate(ctx, change)
+	}
+	return nil
+}
+
+func (keeper *Keeper) handleFeeChangeContent(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+	changes, ok := content.Payload().(types.FeeChangeParams)
+	if !ok {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, fmt.Sprintf("unexpected content %T", content))
+	}
+	if err := changes.Check(); err != nil {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+	}
+	keeper.notifyOnUpdate(ctx, changes.FeeParams)
+	return nil
+}
+
+// handleSCParamsChangeContent runs in the context of the side chain the proposal was submitted to
+func (keeper *Keeper) handleSCParamsChangeContent(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+	if !sdk.IsUpgrade(sdk.LaunchBscUpgrade) || len(ctx.SideChainKeyPrefix()) == 0 {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, "side chain params change should be submitted to a side chain")
+	}
+	changes, ok := content.Payload().(types.SCChangeParams)
+	if !ok {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, fmt.Sprintf("unexpected content %T", content))
+	}
+	if err := changes.Check(); err != nil {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+	}
+	for _, change := range changes.SCParams {
+		keeper.notifyOnUpdate(ctx, change)
+	}
+	return nil
+}
+
+// handleCSCParamsChangeContent runs in the context of the side chain the proposal was submitted to
+func (keeper *Keeper) handleCSCParamsChangeContent(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+	if !sdk.IsUpgrade(sdk.LaunchBscUpgrade) || len(ctx.SideChainId()) == 0 {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, "side chain contract params change should be submitted to a side chain")
+	}
+	change, ok := content.Payload().(types.CSCParamChange)
+	if !ok {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, fmt.Sprintf("unexpected content %T", content))
+	}
+	if err := change.Check(); err != nil {
+		return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+	}
+	keeper.notifyOnUpdate(ctx, types.CSCParamChanges{Changes: []types.CSCParamChange{change}, ChainID: ctx.SideChainId()})
+	return nil
+}
@@ -40,7 +40,8 @@ func (keeper *Keeper) getLastCSCParamChanges(ctx sdk.Context) []types.CSCParamCh
 			if ctx.BlockHeader().Time.Sub(proposal.GetVotingStartTime()) > backPeriod {
 				return true
 			}
-			if proposal.GetStatus() != gov.StatusPassed {
+			// the proposals with typed content are executed by the content handler when they pass
+			if proposal.GetStatus() != gov.StatusPassed || proposal.GetContent() != nil {
 				return false
 			}
 
@@ -48,8 +49,7 @@ func (keeper *Keeper) getLastCSCParamChanges(ctx sdk.Context) []types.CSCParamCh
 			keeper.govKeeper.SetProposal(ctx, proposal)
 
 			var changeParam types.CSCParamChange
-			strProposal := proposal.GetDescription()
-			err := keeper.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+			err := gov.DecodeProposalContent(keeper.cdc, proposal, &changeParam)
 			if err != nil {
 				keeper.Logger(ctx).Error("Get broken data when unmarshal CSCParamChange msg, will skip.", "proposalId", proposal.GetProposalID(), "err", err)
 				return false
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/fees"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/paramHub/types"
+)
+
+// the context key of the dry runs, the fee params applied by a dry run are not synced to the global fee calculators
+type dryRunKey struct{}
+
+func isDryRun(ctx sdk.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// DryRunProposal applies the payload of a param change proposal on a cached context the same way as when the
+// proposal passes, the subscribers of the param changes are notified on the cached context and nothing is written.
+// The params before and after the change are read from the state. The errors of the proposal are reported in the
+// result, the returned error is only for the invalid requests.
+func (keeper *Keeper) DryRunProposal(ctx sdk.Context, params types.DryRunParams) (types.DryRunResult, sdk.Error) {
+	proposalType, err := gov.ProposalTypeFromString(params.ProposalType)
+	if err != nil {
+		return types.DryRunResult{}, sdk.ErrUnknownRequest(err.Error())
+	}
+	ctx = ctx.WithValue(dryRunKey{}, true)
+
+	result := types.DryRunResult{ProposalType: proposalType.String()}
+	switch proposalType {
+	case gov.ProposalTypeFeeChange:
+		keeper.dryRunFeeChange(ctx, params.Payload, &result)
+	case gov.ProposalTypeSCParamsChange:
+		if _, sdkErr := keeper.getSideChainStorePrefix(ctx, params.SideChainId); sdkErr != nil {
+			return types.DryRunResult{}, sdkErr
+		}
+		keeper.dryRunSCParamsChange(ctx, params, &result)
+	case gov.ProposalTypeCSCParamsChange:
+		if _, sdkErr := keeper.getSideChainStorePrefix(ctx, params.SideChainId); sdkErr != nil {
+			return types.DryRunResult{}, sdkErr
+		}
+		keeper.dryRunCSCParamsChange(ctx, params, &result)
+	default:
+		return types.DryRunResult{}, sdk.ErrUnknownRequest(fmt.Sprintf("dry run of %s proposal is not supported", proposalType))
+	}
+	return result, nil
+}
+
+func (keeper *Keeper) getSideChainStorePrefix(ctx sdk.Context, sideChainId string) ([]byte, sdk.Error) {
+	if sideChainId == "" {
+		return nil, types.ErrMissSideChainId(types.DefaultCodespace)
+	}
+	if keeper.ScKeeper == nil {
+		return nil, sdk.ErrInternal("the keeper is not prepared for side chain")
+	}
+	storePrefix := keeper.ScKeeper.GetSideChainStorePrefix(ctx, sideChainId)
+	if len(storePrefix) == 0 {
+		return nil, types.ErrInvalidSideChainId(types.DefaultCodespace, "the side chain id is not registered")
+	}
+	return storePrefix, nil
+}
+
+func (keeper *Keeper) dryRunFeeChange(ctx sdk.Context, payload string, result *types.DryRunResult) {
+	var changeParam types.FeeChangeParams
+	if err := keeper.cdc.UnmarshalJSON([]byte(payload), &changeParam); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to unmarshal FeeChangeParams: %v", err))
+		return
+	}
+	if err := changeParam.Check(); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return
+	}
+
+	// the calculators are global, only check that they can be built from the new params
+	for _, param := range changeParam.FeeParams {
+		msgFeeParam, ok := param.(types.MsgFeeParams)
+		if !ok {
+			continue
+		}
+		generator := fees.GetCalculatorGenerator(msgFeeParam.GetMsgType())
+		if generator == nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("no fee calculator for msg type %s, the fee param would be ignored", msgFeeParam.GetMsgType()))
+			continue
+		}
+		if err := checkCalculatorGenerator(generator, msgFeeParam); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("failed to build the fee calculator of msg type %s: %v", msgFeeParam.GetMsgType(), err))
+		}
+	}
+
+	current := keeper.GetFeeParams(ctx)
+	cacheCtx, _ := ctx.CacheContext()
+	if err := applyChange(func() { keeper.notifyOnUpdate(cacheCtx, changeParam.FeeParams) }); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to apply the fee params: %v", err))
+		return
+	}
+
+	// the updated params keep the positions of the current ones, the new params are appended
+	for i, after := range keeper.GetFeeParams(cacheCtx) {
+		if i >= len(current) {
+			result.FeeParamChanges = append(result.FeeParamChanges, types.FeeParamChange{After: after})
+		} else if !bytes.Equal(keeper.cdc.MustMarshalJSON(current[i]), keeper.cdc.MustMarshalJSON(after)) {
+			result.FeeParamChanges = append(result.FeeParamChanges, types.FeeParamChange{Before: current[i], After: after})
+		}
+	}
+}
+
+// applyChange runs the subscribers of a param change, the panics are returned as errors
+func applyChange(apply func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	apply()
+	return nil
+}
+
+func checkCalculatorGenerator(generator fees.FeeCalculatorGenerator, param types.FeeParam) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	generator(param)
+	return nil
+}
+
+func (keeper *Keeper) dryRunSCParamsChange(ctx sdk.Context, params types.DryRunParams, result *types.DryRunResult) {
+	var changeParam types.SCChangeParams
+	if err := keeper.cdc.UnmarshalJSON([]byte(params.Payload), &changeParam); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to unmarshal SCChangeParams: %v", err))
+		return
+	}
+	if err := changeParam.Check(); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return
+	}
+
+	current, sdkErr := keeper.GetSCParams(ctx, params.SideChainId)
+	if sdkErr != nil {
+		result.Errors = append(result.Errors, sdkErr.RawError())
+		return
+	}
+	storePrefix, _ := keeper.getSideChainStorePrefix(ctx, params.SideChainId)
+	cacheCtx, _ := ctx.CacheContext()
+	sideChainCtx := cacheCtx.WithSideChainKeyPrefix(storePrefix)
+	for _, change := range changeParam.SCParams {
+		if err := applyChange(func() { keeper.notifyOnUpdate(sideChainCtx, change) }); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("failed to apply %T: %v", change, err))
+			return
+		}
+	}
+	updated, sdkErr := keeper.GetSCParams(cacheCtx, params.SideChainId)
+	if sdkErr != nil {
+		result.Errors = append(result.Errors, sdkErr.RawError())
+		return
+	}
+
+	// Check makes sure there is exactly one proposed param of every type
+	for _, proposed := range changeParam.SCParams {
+		proposedType, _ := proposed.GetParamAttribute()
+		for i, before := range current {
+			if beforeType, _ := before.GetParamAttribute(); beforeType != proposedType {
+				continue
+			}
+			after := updated[i]
+			// the subscribers skip the invalid changes
+			beforeBz, afterBz := keeper.cdc.MustMarshalJSON(before), keeper.cdc.MustMarshalJSON(after)
+			if bytes.Equal(beforeBz, afterBz) && !bytes.Equal(beforeBz, keeper.cdc.MustMarshalJSON(proposed)) {
+				result.Errors = append(result.Errors, fmt.Sprintf("the change of %s is skipped by its subscriber", proposedType))
+			}
+			result.SCParamChanges = append(result.SCParamChanges, types.SCParamChange{Before: before, After: after})
+			break
+		}
+	}
+}
+
+func (keeper *Keeper) dryRunCSCParamsChange(ctx sdk.Context, params types.DryRunParams, result *types.DryRunResult) {
+	var changeParam types.CSCParamChange
+	if err := keeper.cdc.UnmarshalJSON([]byte(params.Payload), &changeParam); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to unmarshal CSCParamChange: %v", err))
+		return
+	}
+	if err := changeParam.Check(); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return
+	}
+
+	// the subscriber sends the change to the side chain as an ibc package and only logs the failures,
+	// so the package is built here the same way to report them
+	cacheCtx, _ := ctx.CacheContext()
+	err := applyChange(func() {
+		if _, sdkErr := keeper.SaveParamChangeToIbc(cacheCtx, params.SideChainId, changeParam); sdkErr != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("failed to save the param change to ibc: %s", sdkErr.RawError()))
+		}
+	})
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("failed to save the param change to ibc: %v", err))
+	}
+	result.CSCParamChange = &changeParam
+}
@@ -12,16 +12,32 @@ func (keeper *Keeper) initFeeGenesis(ctx sdk.Context, state types.GenesisState)
 }
 
 func (keeper *Keeper) UpdateFeeParams(ctx sdk.Context, updates []types.FeeParam) {
+	origin := keeper.mergeFeeParams(ctx, updates)
+	if !isDryRun(ctx) {
+		keeper.updateFeeCalculator(origin)
+	}
+	keeper.SetFeeParams(ctx, origin)
+	return
+}
+
+// mergeFeeParams applies the updates to the current fee params and returns the result
+func (keeper *Keeper) mergeFeeParams(ctx sdk.Context, updates []types.FeeParam) []types.FeeParam {
 	log := keeper.Logger(ctx)
 	origin := keeper.GetFeeParams(ctx)
 	opFeeMap := make(map[string]int, len(updates))
 	dexFeeLoc := 0
+	congestionFeeLoc := -1
+	feeDenomsLoc := -1
 	for index, update := range origin {
 		switch update := update.(type) {
 		case types.MsgFeeParams:
 			opFeeMap[update.GetMsgType()] = index
 		case *types.DexFeeParam:
 			dexFeeLoc = index
+		case *types.CongestionFeeParam:
+			congestionFeeLoc = index
+		case *types.FeeDenomsParam:
+			feeDenomsLoc = index
 		default:
 			log.Debug("Origin Fee param not supported ", "feeParam", update)
 		}
@@ -37,13 +53,25 @@ func (keeper *Keeper) UpdateFeeParams(ctx sdk.Context, updates []types.FeeParam)
 			}
 		case *types.DexFeeParam:
 			origin[dexFeeLoc] = update
+		case *types.CongestionFeeParam:
+			if congestionFeeLoc >= 0 {
+				origin[congestionFeeLoc] = update
+			} else {
+				congestionFeeLoc = len(origin)
+				origin = append(origin, update)
+			}
+		case *types.FeeDenomsParam:
+			if feeDenomsLoc >= 0 {
+				origin[feeDenomsLoc] = update
+			} else {
+				feeDenomsLoc = len(origin)
+				origin = append(origin, update)
+			}
 		default:
 			log.Info("Update fee param not supported ", "feeParam", update)
 		}
 	}
-	keeper.updateFeeCalculator(origin)
-	keeper.SetFeeParams(ctx, origin)
-	return
+	return origin
 }
 
 func (keeper *Keeper) loadFeeParam(ctx sdk.Context) {
@@ -83,6 +111,7 @@ func (keeper *Keeper) registerFeeParamCallBack() {
 }
 
 func (keeper *Keeper) updateFeeCalculator(updates []types.FeeParam) {
+	keeper.updateFeeDenoms(updates)
 	fees.UnsetAllCalculators()
 	for _, u := range updates {
 		if u, ok := u.(types.MsgFeeParams); ok {
@@ -94,7 +123,19 @@ func (keeper *Keeper) updateFeeCalculator(updates []types.FeeParam) {
 				if err != nil {
 					panic(err)
 				}
-				fees.RegisterCalculator(u.GetMsgType(), generator(u))
+				fees.RegisterCalculator(u.GetMsgType(), fees.Congestion.Wrap(generator(u)))
+			}
+		}
+	}
+}
+
+// updateFeeDenoms syncs the accepted fee denoms with the fee params
+func (keeper *Keeper) updateFeeDenoms(params []types.FeeParam) {
+	fees.Denoms.Clear()
+	for _, p := range params {
+		if p, ok := p.(*types.FeeDenomsParam); ok {
+			for _, d := range p.Denoms {
+				fees.Denoms.SetRate(d.Denom, d.Rate)
 			}
 		}
 	}
@@ -105,7 +146,7 @@ func (keeper *Keeper) getLastFeeChangeParam(ctx sdk.Context) []types.FeeParam {
 	var latestProposal *gov.Proposal
 	lastProposalId := keeper.getLastFeeChangeProposalId(ctx)
 	keeper.govKeeper.Iterate(ctx, nil, nil, gov.StatusPassed, lastProposalId.ProposalID, true, func(proposal gov.Proposal) bool {
-		if proposal.GetProposalType() == gov.ProposalTypeFeeChange {
+		if proposal.GetProposalType() == gov.ProposalTypeFeeChange && proposal.GetContent() == nil {
 			latestProposal = &proposal
 			return true
 		}
@@ -113,8 +154,7 @@ func (keeper *Keeper) getLastFeeChangeParam(ctx sdk.Context) []types.FeeParam {
 	})
 	if latestProposal != nil {
 		var changeParam types.FeeChangeParams
-		strProposal := (*latestProposal).GetDescription()
-		err := keeper.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+		err := gov.DecodeProposalContent(keeper.cdc, *latestProposal, &changeParam)
 		if err != nil {
 			log.Error("Get broken data when unmarshal FeeChangeParams msg, will skip", "proposalId", (*latestProposal).GetProposalID(), "err", err)
 			return nil
@@ -19,6 +19,7 @@ import (
 var (
 	ParamStoreKeyLastFeeChangeProposalID = []byte("lastFeeChangeProposalID")
 	ParamStoreKeyFees                    = []byte("fees")
+	ParamStoreKeyCongestionMultiplier    = []byte("congestionMultiplier")
 
 	// for side chain
 	ParamStoreKeySCLastParamsChangeProposalID = []byte("SCLastParamsChangeProposalID")
@@ -36,6 +37,7 @@ func ParamTypeTable() params.TypeTable {
 	return params.NewTypeTable(
 		ParamStoreKeyLastFeeChangeProposalID, types.LastProposalID{},
 		ParamStoreKeyFees, []types.FeeParam{},
+		ParamStoreKeyCongestionMultiplier, sdk.Dec{},
 		ParamStoreKeySCLastParamsChangeProposalID, types.LastProposalID{},
 		ParamStoreKeyBCLastParamsChangeProposalID, types.LastProposalID{},
 	)
@@ -84,8 +86,10 @@ func (keeper *Keeper) GetSubscriberBCParamSpace() []*types.BCParamSpaceProto {
 	return keeper.subscriberBCParamSpace
 }
 
+// SetGovKeeper connects the gov keeper and registers the handlers of the param change proposals on it
 func (keeper *Keeper) SetGovKeeper(govKeeper *gov.Keeper) {
 	keeper.govKeeper = govKeeper
+	keeper.registerContentHandlers(govKeeper)
 }
 
 func (keeper *Keeper) SetupForSideChain(scKeeper *sidechain.Keeper, ibcKeeper *ibc.Keeper) {
@@ -151,6 +155,9 @@ func (keeper *Keeper) EndBlock(ctx sdk.Context) {
 			}
 		}
 	}
+	if sdk.IsUpgrade(sdk.CongestionFee) {
+		keeper.updateCongestionMultiplier(ctx)
+	}
 	return
 }
 
@@ -190,6 +197,7 @@ func (keeper *Keeper) InitGenesis(ctx sdk.Context, params types.GenesisState) {
 
 func (keeper *Keeper) Load(ctx sdk.Context) {
 	keeper.loadFeeParam(ctx)
+	keeper.loadCongestionMultiplier(ctx)
 }
 
 func (keeper *Keeper) SubscribeParamChange(updateCb func(sdk.Context, interface{}), spaceProto *types.ParamSpaceProto, genesisCb func(sdk.Context, interface{}), loadCb func(sdk.Context, interface{})) {
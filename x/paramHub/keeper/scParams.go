@@ -10,7 +10,7 @@ func (keeper *Keeper) getLastSCParamChanges(ctx sdk.Context) *types.SCChangePara
 	var latestProposal *gov.Proposal
 	lastProposalId := keeper.GetLastSCParamChangeProposalId(ctx)
 	keeper.govKeeper.Iterate(ctx, nil, nil, gov.StatusPassed, lastProposalId.ProposalID, true, func(proposal gov.Proposal) bool {
-		if proposal.GetProposalType() == gov.ProposalTypeSCParamsChange {
+		if proposal.GetProposalType() == gov.ProposalTypeSCParamsChange && proposal.GetContent() == nil {
 			latestProposal = &proposal
 			return true
 		}
@@ -19,8 +19,7 @@ func (keeper *Keeper) getLastSCParamChanges(ctx sdk.Context) *types.SCChangePara
 
 	if latestProposal != nil {
 		var changeParam types.SCChangeParams
-		strProposal := (*latestProposal).GetDescription()
-		err := keeper.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+		err := gov.DecodeProposalContent(keeper.cdc, *latestProposal, &changeParam)
 		if err != nil {
 			keeper.Logger(ctx).Error("Get broken data when unmarshal SCParamsChange msg, will skip.", "proposalId", (*latestProposal).GetProposalID(), "err", err)
 			return nil
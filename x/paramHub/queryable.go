@@ -19,6 +19,12 @@ func NewQuerier(hub *ParamHub, cdc *codec.Codec) sdk.Querier {
 				return nil, sdk.ErrInternal(err.Error())
 			}
 			return res, nil
+		case "congestion":
+			res, err := cdc.MarshalJSON(hub.GetCongestionInfo(ctx))
+			if err != nil {
+				return nil, sdk.ErrInternal(err.Error())
+			}
+			return res, nil
 		case "sideParams":
 			if len(req.Data) == 0 {
 				return nil, types.ErrMissSideChainId(types.DefaultCodespace)
@@ -37,6 +43,21 @@ func NewQuerier(hub *ParamHub, cdc *codec.Codec) sdk.Querier {
 				return nil, sdk.ErrInternal(err.Error())
 			}
 			return res, nil
+		case "dryRun":
+			var params types.DryRunParams
+			err := cdc.UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid data %v", err))
+			}
+			result, sdkErr := hub.DryRunProposal(ctx, params)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+			res, err := cdc.MarshalJSON(result)
+			if err != nil {
+				return nil, sdk.ErrInternal(err.Error())
+			}
+			return res, nil
 
 		default:
 			return res, sdk.ErrUnknownRequest(req.Path)
@@ -65,6 +86,18 @@ func CreateAbciQueryHandler(paramHub *ParamHub) func(sdk.Context, abci.RequestQu
 				Code:  uint32(sdk.ABCICodeOK),
 				Value: bz,
 			}
+		case "congestion":
+			bz, err := paramHub.GetCodeC().MarshalJSON(paramHub.GetCongestionInfo(ctx))
+			if err != nil {
+				return &abci.ResponseQuery{
+					Code: uint32(sdk.CodeInternal),
+					Log:  err.Error(),
+				}
+			}
+			return &abci.ResponseQuery{
+				Code:  uint32(sdk.ABCICodeOK),
+				Value: bz,
+			}
 		case "sideParams":
 			if len(req.Data) == 0 {
 				return &abci.ResponseQuery{
@@ -117,6 +150,33 @@ func CreateAbciQueryHandler(paramHub *ParamHub) func(sdk.Context, abci.RequestQu
 				Code:  uint32(sdk.ABCICodeOK),
 				Value: bz,
 			}
+		case "dryRun":
+			var params types.DryRunParams
+			err := paramHub.GetCodeC().UnmarshalJSON(req.Data, &params)
+			if err != nil {
+				return &abci.ResponseQuery{
+					Code: uint32(sdk.CodeInternal),
+					Log:  fmt.Sprintf("invalid data %v", err),
+				}
+			}
+			result, sdkErr := paramHub.DryRunProposal(ctx, params)
+			if sdkErr != nil {
+				return &abci.ResponseQuery{
+					Code: uint32(sdkErr.ABCICode()),
+					Log:  sdkErr.ABCILog(),
+				}
+			}
+			bz, err := paramHub.GetCodeC().MarshalJSON(result)
+			if err != nil {
+				return &abci.ResponseQuery{
+					Code: uint32(sdk.CodeInternal),
+					Log:  err.Error(),
+				}
+			}
+			return &abci.ResponseQuery{
+				Code:  uint32(sdk.ABCICodeOK),
+				Value: bz,
+			}
 
 		default:
 			return &abci.ResponseQuery{
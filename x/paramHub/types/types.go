@@ -14,6 +14,8 @@ const (
 	OperateFeeType  = "operate"
 	TransferFeeType = "transfer"
 	DexFeeType      = "dex"
+	CongestionType  = "congestion"
+	FeeDenomsType   = "fee_denoms"
 
 	JSONFORMAT  = "json"
 	AMINOFORMAT = "amino"
@@ -61,8 +63,9 @@ var (
 		"side_undelegate":                      {},
 		"side_stake_migration":                 {},
 
-		"bsc_submit_evidence": {},
-		"side_chain_unjail":   {},
+		"bsc_submit_evidence":      {},
+		"side_chain_unjail":        {},
+		"claim_slash_compensation": {},
 
 		"side_submit_proposal": {},
 		"side_deposit":         {},
@@ -212,6 +215,108 @@ func (p *DexFeeParam) Check() error {
 	return nil
 }
 
+// MaxCongestionMultiplier bounds the max_multiplier of CongestionFeeParam so that the scaled fees can not overflow
+const MaxCongestionMultiplier = 1000
+
+var _ FeeParam = (*CongestionFeeParam)(nil)
+
+// CongestionFeeParam turns on the fee market mode, in which the calculated fees are scaled by a multiplier
+// tracking the number of txs per block. The multiplier moves towards max_multiplier when the blocks are fuller
+// than target_txs_per_block and towards min_multiplier when they are emptier, by at most max_change_rate per block.
+type CongestionFeeParam struct {
+	TargetTxsPerBlock int64   `json:"target_txs_per_block"`
+	MaxChangeRate     sdk.Dec `json:"max_change_rate"`
+	MinMultiplier     sdk.Dec `json:"min_multiplier"`
+	MaxMultiplier     sdk.Dec `json:"max_multiplier"`
+}
+
+func (p *CongestionFeeParam) GetParamType() string {
+	return CongestionType
+}
+
+func (p *CongestionFeeParam) Check() error {
+	if p.TargetTxsPerBlock <= 0 || p.TargetTxsPerBlock > math.MaxInt32 {
+		return fmt.Errorf("target_txs_per_block(%d) should be in (0, %d]", p.TargetTxsPerBlock, math.MaxInt32)
+	}
+	if p.MaxChangeRate.LTE(sdk.ZeroDec()) || p.MaxChangeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("max_change_rate(%s) should be in (0, 1]", p.MaxChangeRate)
+	}
+	if p.MinMultiplier.LTE(sdk.ZeroDec()) || p.MinMultiplier.GT(sdk.OneDec()) {
+		return fmt.Errorf("min_multiplier(%s) should be in (0, 1]", p.MinMultiplier)
+	}
+	if p.MaxMultiplier.LT(sdk.OneDec()) || p.MaxMultiplier.GT(sdk.NewDecWithoutFra(MaxCongestionMultiplier)) {
+		return fmt.Errorf("max_multiplier(%s) should be in [1, %d]", p.MaxMultiplier, MaxCongestionMultiplier)
+	}
+	return nil
+}
+
+// NextMultiplier returns the multiplier of the next block given the current one and the number of txs in the
+// current block
+func (p *CongestionFeeParam) NextMultiplier(current sdk.Dec, numTxs int64) sdk.Dec {
+	// the fullness is the relative distance to the target, capped at 1 so that a burst moves the
+	// multiplier by at most max_change_rate
+	fullness := sdk.OneDec()
+	if numTxs < 2*p.TargetTxsPerBlock {
+		fullness = sdk.NewDecWithoutFra(numTxs - p.TargetTxsPerBlock).QuoInt(p.TargetTxsPerBlock)
+	}
+	next := current.Add(current.Mul(fullness).Mul(p.MaxChangeRate))
+	if next.LT(p.MinMultiplier) {
+		return p.MinMultiplier
+	}
+	if next.GT(p.MaxMultiplier) {
+		return p.MaxMultiplier
+	}
+	return next
+}
+
+// CongestionInfo is the current state of the fee market mode, which clients can use to estimate the fees
+type CongestionInfo struct {
+	Enabled    bool                `json:"enabled"`
+	Multiplier sdk.Dec             `json:"multiplier"`
+	Param      *CongestionFeeParam `json:"param,omitempty"`
+}
+
+// MaxFeeDenoms is the max number of the accepted fee denoms besides the native token
+const MaxFeeDenoms = 32
+
+var _ FeeParam = (*FeeDenomsParam)(nil)
+
+// FeeDenomRate is the amount of the denom charged for one native token
+type FeeDenomRate struct {
+	Denom string  `json:"denom"`
+	Rate  sdk.Dec `json:"rate"`
+}
+
+// FeeDenomsParam is the table of the denoms the fees can be paid in besides the native token, the fees are
+// calculated in the native token and converted with the rates
+type FeeDenomsParam struct {
+	Denoms []FeeDenomRate `json:"denoms"`
+}
+
+func (p *FeeDenomsParam) GetParamType() string {
+	return FeeDenomsType
+}
+
+func (p *FeeDenomsParam) Check() error {
+	if len(p.Denoms) > MaxFeeDenoms {
+		return fmt.Errorf("too many fee denoms, max %d, actual %d", MaxFeeDenoms, len(p.Denoms))
+	}
+	seen := make(map[string]bool, len(p.Denoms))
+	for _, d := range p.Denoms {
+		if len(d.Denom) == 0 || d.Denom == sdk.NativeTokenSymbol {
+			return fmt.Errorf("fee denom %q is invalid", d.Denom)
+		}
+		if seen[d.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", d.Denom)
+		}
+		seen[d.Denom] = true
+		if d.Rate.LTE(sdk.ZeroDec()) {
+			return fmt.Errorf("rate(%s) of fee denom %s should be positive", d.Rate, d.Denom)
+		}
+	}
+	return nil
+}
+
 func (f *FeeChangeParams) Check() error {
 	return checkFeeParams(f.FeeParams)
 }
@@ -226,18 +331,37 @@ func (f *FeeChangeParams) String() string {
 
 func checkFeeParams(fees []FeeParam) error {
 	numDexFeeParams := 0
+	numCongestionFeeParams := 0
+	numFeeDenomsParams := 0
 	for _, c := range fees {
 		err := c.Check()
 		if err != nil {
 			return err
 		}
-		if _, ok := c.(*DexFeeParam); ok {
+		switch c.(type) {
+		case *DexFeeParam:
 			numDexFeeParams++
+		case *CongestionFeeParam:
+			if !sdk.IsUpgrade(sdk.CongestionFee) {
+				return fmt.Errorf("congestion fee param is not supported before upgrade %s", sdk.CongestionFee)
+			}
+			numCongestionFeeParams++
+		case *FeeDenomsParam:
+			if !sdk.IsUpgrade(sdk.MultiAssetFee) {
+				return fmt.Errorf("fee denoms param is not supported before upgrade %s", sdk.MultiAssetFee)
+			}
+			numFeeDenomsParams++
 		}
 	}
 	if numDexFeeParams > 1 {
 		return fmt.Errorf("have more than one DexFeeParam, actural %d", numDexFeeParams)
 	}
+	if numCongestionFeeParams > 1 {
+		return fmt.Errorf("have more than one CongestionFeeParam, actural %d", numCongestionFeeParams)
+	}
+	if numFeeDenomsParams > 1 {
+		return fmt.Errorf("have more than one FeeDenomsParam, actural %d", numFeeDenomsParams)
+	}
 	return nil
 }
 
@@ -380,3 +504,42 @@ func (s *BCChangeParams) Check() error {
 	}
 	return nil
 }
+
+// ---------   Definition dry run of param change proposals ------------------- //
+
+// DryRunParams is the request to simulate a FeeChange, SCParamsChange or CSCParamsChange proposal,
+// the payload is the same as the description of the proposal.
+type DryRunParams struct {
+	ProposalType string `json:"proposal_type"`
+	SideChainId  string `json:"side_chain_id"`
+	Payload      string `json:"payload"`
+}
+
+// DryRunResult is how the params would change if the proposal was executed against the current state
+type DryRunResult struct {
+	ProposalType string `json:"proposal_type"`
+	// fee change, only the fee params which would change are listed
+	FeeParamChanges []FeeParamChange `json:"fee_param_changes,omitempty"`
+	// side chain params change
+	SCParamChanges []SCParamChange `json:"sc_param_changes,omitempty"`
+	// cross side chain params change, the current value is kept by the contract on the side chain
+	CSCParamChange *CSCParamChange `json:"csc_param_change,omitempty"`
+
+	Errors []string `json:"errors,omitempty"`
+}
+
+// FeeParamChange is the current and the proposed value of a fee param, Before is nil for a new fee param
+type FeeParamChange struct {
+	Before FeeParam `json:"before"`
+	After  FeeParam `json:"after"`
+}
+
+// SCParamChange is the current and the proposed value of a side chain param
+type SCParamChange struct {
+	Before SCParam `json:"before"`
+	After  SCParam `json:"after"`
+}
+
+func (r DryRunResult) IsOK() bool {
+	return len(r.Errors) == 0
+}
@@ -196,3 +196,81 @@ func testRegisterWire(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&slashing.Params{}, "params/SlashParamSet", nil)
 	cdc.RegisterConcrete(&ibc.Params{}, "params/IbcParamSet", nil)
 }
+
+func TestCongestionFeeParamCheck(t *testing.T) {
+	testCases := []struct {
+		fp          fTypes.CongestionFeeParam
+		expectError bool
+	}{
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(10)}, false},
+		{fTypes.CongestionFeeParam{0, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(10)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(10)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithoutFra(2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(10)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.ZeroDec(), sdk.NewDecWithoutFra(10)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithoutFra(2), sdk.NewDecWithoutFra(10)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(9, 1)}, true},
+		{fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(fTypes.MaxCongestionMultiplier + 1)}, true},
+	}
+	for i, testCase := range testCases {
+		err := testCase.fp.Check()
+		if testCase.expectError {
+			assert.Error(t, err, "test: %v", i)
+		} else {
+			assert.NoError(t, err, "test: %v", i)
+		}
+	}
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.CongestionFee, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.CongestionFee, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+	feeChange := fTypes.FeeChangeParams{FeeParams: []fTypes.FeeParam{&testCases[0].fp}}
+	assert.Error(t, feeChange.Check())
+	sdk.UpgradeMgr.SetHeight(10)
+	assert.NoError(t, feeChange.Check())
+	feeChange.FeeParams = append(feeChange.FeeParams, &testCases[0].fp)
+	assert.Error(t, feeChange.Check())
+}
+
+func TestCongestionNextMultiplier(t *testing.T) {
+	fp := fTypes.CongestionFeeParam{100, sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithoutFra(2)}
+
+	// the multiplier stays on target
+	assert.Equal(t, sdk.OneDec(), fp.NextMultiplier(sdk.OneDec(), 100))
+	// full blocks move it up, empty blocks move it down
+	assert.Equal(t, sdk.NewDecWithPrec(10625, 4), fp.NextMultiplier(sdk.OneDec(), 150))
+	assert.Equal(t, sdk.NewDecWithPrec(875, 3), fp.NextMultiplier(sdk.OneDec(), 0))
+	// a burst moves it by at most max_change_rate
+	assert.Equal(t, sdk.NewDecWithPrec(1125, 3), fp.NextMultiplier(sdk.OneDec(), 100000))
+	// the multiplier is bounded
+	assert.Equal(t, sdk.NewDecWithoutFra(2), fp.NextMultiplier(sdk.NewDecWithPrec(19, 1), 1000))
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), fp.NextMultiplier(sdk.NewDecWithPrec(55, 2), 0))
+}
+
+func TestFeeDenomsParamCheck(t *testing.T) {
+	testCases := []struct {
+		fp          fTypes.FeeDenomsParam
+		expectError bool
+	}{
+		{fTypes.FeeDenomsParam{[]fTypes.FeeDenomRate{{"USD", sdk.NewDecWithPrec(15, 1)}, {"EUR", sdk.OneDec()}}}, false},
+		{fTypes.FeeDenomsParam{[]fTypes.FeeDenomRate{{"", sdk.OneDec()}}}, true},
+		{fTypes.FeeDenomsParam{[]fTypes.FeeDenomRate{{sdk.NativeTokenSymbol, sdk.OneDec()}}}, true},
+		{fTypes.FeeDenomsParam{[]fTypes.FeeDenomRate{{"USD", sdk.OneDec()}, {"USD", sdk.OneDec()}}}, true},
+		{fTypes.FeeDenomsParam{[]fTypes.FeeDenomRate{{"USD", sdk.ZeroDec()}}}, true},
+	}
+	for i, testCase := range testCases {
+		err := testCase.fp.Check()
+		if testCase.expectError {
+			assert.Error(t, err, "test: %v", i)
+		} else {
+			assert.NoError(t, err, "test: %v", i)
+		}
+	}
+
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.MultiAssetFee, 10)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.MultiAssetFee, 0)
+	sdk.UpgradeMgr.SetHeight(1)
+	feeChange := fTypes.FeeChangeParams{FeeParams: []fTypes.FeeParam{&testCases[0].fp}}
+	assert.Error(t, feeChange.Check())
+	sdk.UpgradeMgr.SetHeight(10)
+	assert.NoError(t, feeChange.Check())
+}
@@ -12,6 +12,8 @@ func RegisterWire(cdc *amino.Codec) {
 	cdc.RegisterConcrete(&types.FixedFeeParams{}, "params/FixedFeeParams", nil)
 	cdc.RegisterConcrete(&types.TransferFeeParam{}, "params/TransferFeeParams", nil)
 	cdc.RegisterConcrete(&types.DexFeeParam{}, "params/DexFeeParam", nil)
+	cdc.RegisterConcrete(&types.CongestionFeeParam{}, "params/CongestionFeeParam", nil)
+	cdc.RegisterConcrete(&types.FeeDenomsParam{}, "params/FeeDenomsParam", nil)
 	cdc.RegisterInterface((*types.SCParam)(nil), nil)
 	cdc.RegisterInterface((*types.BCParam)(nil), nil)
 }
@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -142,6 +143,20 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 
 }
 
+// SetRaw decodes the JSON value of the parameter with its registered type and sets it
+func (s Subspace) SetRaw(ctx sdk.Context, key []byte, value []byte) error {
+	ty, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s is not registered", key)
+	}
+	param := reflect.New(ty)
+	if err := s.cdc.UnmarshalJSON(value, param.Interface()); err != nil {
+		return err
+	}
+	s.Set(ctx, key, param.Elem().Interface())
+	return nil
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.KeyValuePairs() {
@@ -0,0 +1,42 @@
+package schedule
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/schedule/keeper"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	RouteSchedule    = types.RouteSchedule
+	DefaultCodespace = types.DefaultCodespace
+
+	EventTypeScheduledSendExecuted = keeper.EventTypeScheduledSendExecuted
+	EventTypeScheduledSendFailed   = keeper.EventTypeScheduledSendFailed
+)
+
+var (
+	// functions aliases
+	NewKeeper = keeper.NewKeeper
+
+	NewHeightSchedule         = types.NewHeightSchedule
+	NewTimeSchedule           = types.NewTimeSchedule
+	NewMsgScheduleSend        = types.NewMsgScheduleSend
+	NewMsgCancelScheduledSend = types.NewMsgCancelScheduledSend
+
+	ErrInvalidSchedule    = types.ErrInvalidSchedule
+	ErrNoScheduledSend    = types.ErrNoScheduledSend
+	ErrNotSender          = types.ErrNotSender
+	ErrTooManySchedules   = types.ErrTooManySchedules
+	ErrScheduleNotEnabled = types.ErrScheduleNotEnabled
+
+	EscrowAccAddr = types.EscrowAccAddr
+)
+
+type (
+	Keeper        = keeper.Keeper
+	Schedule      = types.Schedule
+	ScheduledSend = types.ScheduledSend
+
+	MsgScheduleSend        = types.MsgScheduleSend
+	MsgCancelScheduledSend = types.MsgCancelScheduledSend
+)
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagTo             = "to"
+	flagAmount         = "amount"
+	flagHeight         = "height"
+	flagTime           = "time"
+	flagExecutions     = "executions"
+	flagIntervalBlocks = "interval-blocks"
+	flagInterval       = "interval"
+	flagID             = "id"
+	flagSender         = "sender"
+)
+
+func AddCommands(root *cobra.Command, cdc *codec.Codec) {
+	scheduleCmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "transfers executed at a future height or time",
+	}
+
+	scheduleCmd.AddCommand(
+		client.PostCommands(
+			GetCmdScheduleSend(cdc),
+			GetCmdCancelScheduledSend(cdc),
+		)...)
+
+	scheduleCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryScheduledSend(cdc),
+			GetCmdQueryScheduledSends(cdc),
+		)...)
+
+	root.AddCommand(scheduleCmd)
+}
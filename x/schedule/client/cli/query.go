@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/schedule"
+)
+
+// GetCmdQueryScheduledSend implements the command to query a scheduled send by id.
+func GetCmdQueryScheduledSend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-send",
+		Short: "query a scheduled send by id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(schedule.QueryScheduledSendParams{ID: viper.GetUint64(flagID)})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", schedule.StoreKey, schedule.QueryScheduledSend), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagID, 0, "id of the scheduled send")
+	cmd.MarkFlagRequired(flagID)
+	return cmd
+}
+
+// GetCmdQueryScheduledSends implements the command to query the pending scheduled sends of a sender.
+func GetCmdQueryScheduledSends(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-sends",
+		Short: "query the pending scheduled sends of a sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			sender, err := sdk.AccAddressFromBech32(viper.GetString(flagSender))
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(schedule.QueryScheduledSendsParams{Sender: sender})
+			if err != nil {
+				return err
+			}
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", schedule.StoreKey, schedule.QueryScheduledSends), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "address of the sender")
+	cmd.MarkFlagRequired(flagSender)
+	return cmd
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/schedule"
+)
+
+// GetCmdScheduleSend implements the command to schedule a transfer at a future height or time.
+func GetCmdScheduleSend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "escrow coins and send them at a future height or time, optionally repeated",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			to, err := sdk.AccAddressFromBech32(viper.GetString(flagTo))
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			var sched schedule.Schedule
+			height, timeStr := viper.GetInt64(flagHeight), viper.GetString(flagTime)
+			switch {
+			case height > 0 && timeStr == "":
+				sched = schedule.NewHeightSchedule(height, viper.GetInt64(flagExecutions), viper.GetInt64(flagIntervalBlocks))
+			case height == 0 && timeStr != "":
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return fmt.Errorf("invalid time, it should be in RFC3339 format: %v", err)
+				}
+				sched = schedule.NewTimeSchedule(t, viper.GetInt64(flagExecutions), viper.GetDuration(flagInterval))
+			default:
+				return errors.New("exactly one of --height and --time should be set")
+			}
+
+			msg := schedule.NewMsgScheduleSend(from, to, amount, sched)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "address of the receiver")
+	cmd.Flags().String(flagAmount, "", "the coins sent by each execution")
+	cmd.Flags().Int64(flagHeight, 0, "the height of the first execution")
+	cmd.Flags().String(flagTime, "", "the time of the first execution in RFC3339 format")
+	cmd.Flags().Int64(flagExecutions, 1, "the number of executions")
+	cmd.Flags().Int64(flagIntervalBlocks, 0, "the blocks between the executions at a height")
+	cmd.Flags().Duration(flagInterval, 0, "the duration between the executions at a time, e.g. 24h")
+	cmd.MarkFlagRequired(flagTo)
+	cmd.MarkFlagRequired(flagAmount)
+	return cmd
+}
+
+// GetCmdCancelScheduledSend implements the command to cancel a scheduled send.
+func GetCmdCancelScheduledSend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "cancel a scheduled send and refund the coins of its remaining executions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := schedule.NewMsgCancelScheduledSend(from, viper.GetUint64(flagID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+			}
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(flagID, 0, "id of the scheduled send")
+	cmd.MarkFlagRequired(flagID)
+	return cmd
+}
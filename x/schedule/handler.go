@@ -0,0 +1,53 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if !sdk.IsUpgrade(sdk.ScheduledSend) {
+			return types.ErrScheduleNotEnabled().Result()
+		}
+		switch msg := msg.(type) {
+		case MsgScheduleSend:
+			return handleMsgScheduleSend(ctx, keeper, msg)
+		case MsgCancelScheduledSend:
+			return handleMsgCancelScheduledSend(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized schedule msg type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgScheduleSend(ctx sdk.Context, keeper Keeper, msg MsgScheduleSend) sdk.Result {
+	id, tags, err := keeper.ScheduleSend(ctx, msg.From, msg.To, msg.Amount, msg.Schedule)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{
+		Data: []byte(strconv.FormatUint(id, 10)),
+		Tags: tags,
+	}
+}
+
+func handleMsgCancelScheduledSend(ctx sdk.Context, keeper Keeper, msg MsgCancelScheduledSend) sdk.Result {
+	tags, err := keeper.CancelScheduledSend(ctx, msg.From, msg.ID)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: tags}
+}
+
+// EndBlocker executes the scheduled sends which are due at the block
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	if !sdk.IsUpgrade(sdk.ScheduledSend) {
+		return
+	}
+	ctx.EventManager().EmitEvents(keeper.ExecuteDueSends(ctx))
+}
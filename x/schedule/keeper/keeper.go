@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+// the events of the scheduled sends processed in EndBlock
+const (
+	EventTypeScheduledSendExecuted = "scheduled_send_executed"
+	EventTypeScheduledSendFailed   = "scheduled_send_failed"
+)
+
+// Keeper stores the scheduled sends, escrows their coins and executes them once they are due
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	ck       bank.Keeper
+}
+
+// NewKeeper creates new instances of the schedule Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ck bank.Keeper) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		ck:       ck,
+	}
+}
+
+// ScheduleSend escrows the coins of all the executions of the send and enqueues its first execution,
+// the id of the new scheduled send is returned
+func (k Keeper) ScheduleSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins, schedule types.Schedule) (uint64, sdk.Tags, sdk.Error) {
+	if schedule.IsDue(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return 0, nil, types.ErrInvalidSchedule("the first execution should be in the future")
+	}
+	pending := 0
+	k.IterateScheduledSends(ctx, from, func(types.ScheduledSend) bool {
+		pending++
+		return false
+	})
+	if pending >= types.MaxSchedulesPerSender {
+		return 0, nil, types.ErrTooManySchedules(from)
+	}
+
+	send := types.ScheduledSend{
+		ID:       k.nextID(ctx),
+		From:     from,
+		To:       to,
+		Amount:   amount,
+		Schedule: schedule,
+	}
+	tags, err := k.ck.SendCoins(ctx, from, types.EscrowAccAddr, send.Escrowed())
+	if err != nil {
+		return 0, nil, err
+	}
+	k.setScheduledSend(ctx, send)
+	ctx.KVStore(k.storeKey).Set(types.GetSenderIndexKey(from, send.ID), []byte{})
+	k.enqueue(ctx, send)
+	return send.ID, tags, nil
+}
+
+// CancelScheduledSend removes the scheduled send and refunds the coins of its remaining executions,
+// the failed sends which could not be refunded are reclaimed this way as well
+func (k Keeper) CancelScheduledSend(ctx sdk.Context, sender sdk.AccAddress, id uint64) (sdk.Tags, sdk.Error) {
+	send, found := k.GetScheduledSend(ctx, id)
+	if !found {
+		return nil, types.ErrNoScheduledSend(id)
+	}
+	if !send.From.Equals(sender) {
+		return nil, types.ErrNotSender(id, sender)
+	}
+	tags, err := k.ck.SendCoins(ctx, types.EscrowAccAddr, send.From, send.Escrowed())
+	if err != nil {
+		return nil, err
+	}
+	if !send.Failed {
+		k.dequeue(ctx, send)
+	}
+	k.deleteScheduledSend(ctx, send)
+	return tags, nil
+}
+
+func (k Keeper) GetScheduledSend(ctx sdk.Context, id uint64) (send types.ScheduledSend, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScheduledSendKey(id))
+	if bz == nil {
+		return send, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &send)
+	return send, true
+}
+
+// IterateScheduledSends iterates the pending scheduled sends of the sender by id until the callback returns true
+func (k Keeper) IterateScheduledSends(ctx sdk.Context, sender sdk.AccAddress, cb func(send types.ScheduledSend) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSenderIndexPrefix(sender))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		send, found := k.GetScheduledSend(ctx, types.IDFromQueueKey(iterator.Key()))
+		if !found {
+			continue
+		}
+		if cb(send) {
+			break
+		}
+	}
+}
+
+// ExecuteDueSends executes the scheduled sends which are due at the block, at most MaxExecutionsPerBlock
+// of them, the others are left in the queues for the following blocks. A recurring send is enqueued again
+// until its executions run out. A send which fails is removed and the coins of its remaining executions
+// are refunded to the sender, if the refund fails as well the send is kept as failed so the sender can
+// cancel it to reclaim the coins later.
+func (k Keeper) ExecuteDueSends(ctx sdk.Context) sdk.Events {
+	logger := ctx.Logger().With("module", "x/schedule")
+	events := sdk.EmptyEvents()
+	for _, id := range k.dueSends(ctx, types.MaxExecutionsPerBlock) {
+		send, found := k.GetScheduledSend(ctx, id)
+		if !found {
+			continue
+		}
+		k.dequeue(ctx, send)
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.ck.SendCoins(cacheCtx, types.EscrowAccAddr, send.To, send.Amount); err != nil {
+			logger.Info("scheduled send failed", "id", send.ID, "err", err.Error())
+			refundCtx, writeRefund := ctx.CacheContext()
+			if _, refundErr := k.ck.SendCoins(refundCtx, types.EscrowAccAddr, send.From, send.Escrowed()); refundErr != nil {
+				// the coins are left in the escrow, e.g. if the sender is rejected by the hooks of a denom
+				logger.Error("failed to refund scheduled send", "id", send.ID, "err", refundErr.Error())
+				send.Failed = true
+				k.setScheduledSend(ctx, send)
+			} else {
+				writeRefund()
+				k.deleteScheduledSend(ctx, send)
+			}
+			events = events.AppendEvent(newScheduledSendEvent(EventTypeScheduledSendFailed, send))
+			continue
+		}
+		write()
+		events = events.AppendEvent(newScheduledSendEvent(EventTypeScheduledSendExecuted, send))
+
+		send.Schedule = send.Schedule.Next()
+		if send.Schedule.Executions == 0 {
+			k.deleteScheduledSend(ctx, send)
+			continue
+		}
+		k.setScheduledSend(ctx, send)
+		k.enqueue(ctx, send)
+	}
+	return events
+}
+
+// dueSends returns the ids of the sends which are due at the block, the sends at a height come first
+func (k Keeper) dueSends(ctx sdk.Context, limit int) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	ids := make([]uint64, 0)
+	collect := func(iterator sdk.Iterator) {
+		defer iterator.Close()
+		for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+			ids = append(ids, types.IDFromQueueKey(iterator.Key()))
+		}
+	}
+	collect(store.Iterator(types.HeightQueueKeyPrefix, types.GetHeightQueuePrefix(ctx.BlockHeight()+1)))
+	collect(store.Iterator(types.TimeQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.GetTimeQueuePrefix(ctx.BlockHeader().Time))))
+	return ids
+}
+
+func (k Keeper) nextID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64 = 1
+	if bz := store.Get(types.NextIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id+1)
+	store.Set(types.NextIDKey, bz)
+	return id
+}
+
+func (k Keeper) setScheduledSend(ctx sdk.Context, send types.ScheduledSend) {
+	ctx.KVStore(k.storeKey).Set(types.GetScheduledSendKey(send.ID), k.cdc.MustMarshalBinaryLengthPrefixed(send))
+}
+
+func (k Keeper) deleteScheduledSend(ctx sdk.Context, send types.ScheduledSend) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledSendKey(send.ID))
+	store.Delete(types.GetSenderIndexKey(send.From, send.ID))
+}
+
+func (k Keeper) queueKey(send types.ScheduledSend) []byte {
+	if send.Schedule.IsHeightBased() {
+		return types.GetHeightQueueKey(send.Schedule.Height, send.ID)
+	}
+	return types.GetTimeQueueKey(send.Schedule.Time, send.ID)
+}
+
+func (k Keeper) enqueue(ctx sdk.Context, send types.ScheduledSend) {
+	ctx.KVStore(k.storeKey).Set(k.queueKey(send), []byte{})
+}
+
+func (k Keeper) dequeue(ctx sdk.Context, send types.ScheduledSend) {
+	ctx.KVStore(k.storeKey).Delete(k.queueKey(send))
+}
+
+func newScheduledSendEvent(eventType string, send types.ScheduledSend) sdk.Event {
+	return sdk.NewEvent(eventType,
+		sdk.NewAttribute("id", strconv.FormatUint(send.ID, 10)),
+		sdk.NewAttribute("from", send.From.String()),
+		sdk.NewAttribute("to", send.To.String()),
+		sdk.NewAttribute("amount", send.Amount.String()),
+	)
+}
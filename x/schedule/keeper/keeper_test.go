@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("acc")
+	bankKey := sdk.NewKVStoreKey("bank")
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	accountCache := auth.NewAccountCache(auth.NewAccountStoreCache(cdc, ms.GetKVStore(authKey), 10))
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Unix(1000, 0)}, sdk.RunTxModeDeliver, log.NewNopLogger()).
+		WithAccountCache(accountCache)
+	bankKeeper := bank.NewBaseKeeper(auth.NewAccountKeeper(cdc, authKey, auth.ProtoBaseAccount)).
+		WithTransferHooks(bank.NewTransferHooks(bankKey, nil))
+	return ctx, NewKeeper(codec.New(), key, bankKeeper), bankKeeper
+}
+
+func atBlock(ctx sdk.Context, height int64, blockTime time.Time) sdk.Context {
+	return ctx.WithBlockHeader(abci.Header{Height: height, Time: blockTime}).WithBlockHeight(height)
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, amount)}
+}
+
+func TestScheduleSendAtHeight(t *testing.T) {
+	ctx, keeper, bankKeeper := createTestInput(t)
+	sender := sdk.AccAddress([]byte("sender--------------"))
+	receiver := sdk.AccAddress([]byte("receiver------------"))
+	bankKeeper.SetCoins(ctx, sender, coins(1000))
+
+	_, _, err := keeper.ScheduleSend(ctx, sender, receiver, coins(100), types.NewHeightSchedule(10, 1, 0))
+	require.Equal(t, types.CodeInvalidSchedule, err.Code())
+	_, _, err = keeper.ScheduleSend(ctx, sender, receiver, coins(100), types.NewHeightSchedule(20, 20, 5))
+	require.Equal(t, sdk.CodeInsufficientCoins, err.Code())
+
+	// the coins of all the executions are escrowed
+	id, _, err := keeper.ScheduleSend(ctx, sender, receiver, coins(100), types.NewHeightSchedule(20, 3, 5))
+	require.Nil(t, err)
+	require.Equal(t, coins(700), bankKeeper.GetCoins(ctx, sender))
+	require.Equal(t, coins(300), bankKeeper.GetCoins(ctx, types.EscrowAccAddr))
+
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 19, ctx.BlockHeader().Time)), 0)
+	events := keeper.ExecuteDueSends(atBlock(ctx, 20, ctx.BlockHeader().Time))
+	require.Len(t, events, 1)
+	require.Equal(t, EventTypeScheduledSendExecuted, events[0].Type)
+	require.Equal(t, coins(100), bankKeeper.GetCoins(ctx, receiver))
+	send, found := keeper.GetScheduledSend(ctx, id)
+	require.True(t, found)
+	require.Equal(t, int64(25), send.Schedule.Height)
+	require.Equal(t, int64(2), send.Schedule.Executions)
+
+	// a late block executes the due execution once, the following one is due after the interval
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 27, ctx.BlockHeader().Time)), 1)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 29, ctx.BlockHeader().Time)), 0)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 30, ctx.BlockHeader().Time)), 1)
+	require.Equal(t, coins(300), bankKeeper.GetCoins(ctx, receiver))
+	require.True(t, bankKeeper.GetCoins(ctx, types.EscrowAccAddr).IsZero())
+	_, found = keeper.GetScheduledSend(ctx, id)
+	require.False(t, found)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 35, ctx.BlockHeader().Time)), 0)
+}
+
+func TestScheduleSendAtTime(t *testing.T) {
+	ctx, keeper, bankKeeper := createTestInput(t)
+	sender := sdk.AccAddress([]byte("sender--------------"))
+	receiver := sdk.AccAddress([]byte("receiver------------"))
+	bankKeeper.SetCoins(ctx, sender, coins(1000))
+	start := ctx.BlockHeader().Time.Add(time.Hour)
+
+	id, _, err := keeper.ScheduleSend(ctx, sender, receiver, coins(100), types.NewTimeSchedule(start, 4, time.Hour))
+	require.Nil(t, err)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 11, start.Add(-time.Second))), 0)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 12, start)), 1)
+	require.Equal(t, coins(100), bankKeeper.GetCoins(ctx, receiver))
+
+	// only the sender can cancel, the coins of the remaining executions are refunded
+	_, err = keeper.CancelScheduledSend(ctx, receiver, id)
+	require.Equal(t, types.CodeNotSender, err.Code())
+	_, err = keeper.CancelScheduledSend(ctx, sender, id)
+	require.Nil(t, err)
+	require.Equal(t, coins(900), bankKeeper.GetCoins(ctx, sender))
+	require.True(t, bankKeeper.GetCoins(ctx, types.EscrowAccAddr).IsZero())
+	_, err = keeper.CancelScheduledSend(ctx, sender, id)
+	require.Equal(t, types.CodeNoScheduledSend, err.Code())
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 13, start.Add(time.Hour))), 0)
+}
+
+func TestScheduledSendsOfSender(t *testing.T) {
+	ctx, keeper, bankKeeper := createTestInput(t)
+	sender := sdk.AccAddress([]byte("sender--------------"))
+	other := sdk.AccAddress([]byte("other---------------"))
+	receiver := sdk.AccAddress([]byte("receiver------------"))
+	bankKeeper.SetCoins(ctx, sender, coins(1000))
+	bankKeeper.SetCoins(ctx, other, coins(1000))
+
+	for i := 0; i < types.MaxSchedulesPerSender; i++ {
+		_, _, err := keeper.ScheduleSend(ctx, sender, receiver, coins(1), types.NewHeightSchedule(20, 1, 0))
+		require.Nil(t, err)
+	}
+	_, _, err := keeper.ScheduleSend(ctx, sender, receiver, coins(1), types.NewHeightSchedule(20, 1, 0))
+	require.Equal(t, types.CodeTooManySchedules, err.Code())
+	_, _, err = keeper.ScheduleSend(ctx, other, receiver, coins(1), types.NewHeightSchedule(20, 1, 0))
+	require.Nil(t, err)
+
+	count := 0
+	keeper.IterateScheduledSends(ctx, sender, func(send types.ScheduledSend) bool {
+		require.Equal(t, sender, send.From)
+		count++
+		return false
+	})
+	require.Equal(t, types.MaxSchedulesPerSender, count)
+}
+
+func TestFailedScheduledSend(t *testing.T) {
+	ctx, keeper, bankKeeper := createTestInput(t)
+	sender := sdk.AccAddress([]byte("sender--------------"))
+	receiver := sdk.AccAddress([]byte("receiver------------"))
+	amount := sdk.Coins{sdk.NewCoin("XYZ-000", 100)}
+	bankKeeper.SetCoins(ctx, sender, sdk.Coins{sdk.NewCoin("XYZ-000", 1000)})
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.TransferHooks, 0)
+	sdk.UpgradeMgr.SetHeight(ctx.BlockHeight())
+	hooks := bankKeeper.GetTransferHooks()
+	require.Nil(t, hooks.SetDenomHooks(ctx, "XYZ-000", []string{bank.FreezeHook}))
+
+	// the send is refunded if the receiver is rejected
+	id, _, err := keeper.ScheduleSend(ctx, sender, receiver, amount, types.NewHeightSchedule(20, 2, 5))
+	require.Nil(t, err)
+	hooks.SetAccountFlag(ctx, "XYZ-000", bank.FrozenFlag, receiver, true)
+	events := keeper.ExecuteDueSends(atBlock(ctx, 20, ctx.BlockHeader().Time))
+	require.Len(t, events, 1)
+	require.Equal(t, EventTypeScheduledSendFailed, events[0].Type)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("XYZ-000", 1000)}, bankKeeper.GetCoins(ctx, sender))
+	_, found := keeper.GetScheduledSend(ctx, id)
+	require.False(t, found)
+
+	// the send is kept as failed if the refund is rejected as well
+	id, _, err = keeper.ScheduleSend(ctx, sender, receiver, amount, types.NewHeightSchedule(20, 2, 5))
+	require.Nil(t, err)
+	hooks.SetAccountFlag(ctx, "XYZ-000", bank.FrozenFlag, sender, true)
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 20, ctx.BlockHeader().Time)), 1)
+	send, found := keeper.GetScheduledSend(ctx, id)
+	require.True(t, found)
+	require.True(t, send.Failed)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("XYZ-000", 200)}, bankKeeper.GetCoins(ctx, types.EscrowAccAddr))
+	require.Len(t, keeper.ExecuteDueSends(atBlock(ctx, 25, ctx.BlockHeader().Time)), 0)
+
+	// the sender reclaims the coins once it is unfrozen
+	_, err = keeper.CancelScheduledSend(ctx, sender, id)
+	require.NotNil(t, err)
+	hooks.SetAccountFlag(ctx, "XYZ-000", bank.FrozenFlag, sender, false)
+	_, err = keeper.CancelScheduledSend(ctx, sender, id)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("XYZ-000", 1000)}, bankKeeper.GetCoins(ctx, sender))
+	require.True(t, bankKeeper.GetCoins(ctx, types.EscrowAccAddr).IsZero())
+	_, found = keeper.GetScheduledSend(ctx, id)
+	require.False(t, found)
+}
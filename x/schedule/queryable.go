@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	QueryScheduledSend  = "scheduled-send"
+	QueryScheduledSends = "scheduled-sends"
+)
+
+type QueryScheduledSendParams struct {
+	ID uint64
+}
+
+type QueryScheduledSendsParams struct {
+	Sender sdk.AccAddress
+}
+
+// creates a querier for the schedule REST endpoints
+func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryScheduledSend:
+			return queryScheduledSend(ctx, k, cdc, req)
+		case QueryScheduledSends:
+			return queryScheduledSends(ctx, k, cdc, req)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown schedule query endpoint")
+		}
+	}
+}
+
+func queryScheduledSend(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryScheduledSendParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	send, found := k.GetScheduledSend(ctx, params.ID)
+	if !found {
+		return nil, types.ErrNoScheduledSend(params.ID)
+	}
+	return marshalJSON(cdc, send)
+}
+
+func queryScheduledSends(ctx sdk.Context, k Keeper, cdc *codec.Codec, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryScheduledSendsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	sends := make([]ScheduledSend, 0)
+	k.IterateScheduledSends(ctx, params.Sender, func(send ScheduledSend) bool {
+		sends = append(sends, send)
+		return false
+	})
+	return marshalJSON(cdc, sends)
+}
+
+func marshalJSON(cdc *codec.Codec, o interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
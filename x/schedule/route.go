@@ -0,0 +1,12 @@
+package schedule
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+func Routes(keeper Keeper) map[string]sdk.Handler {
+	routes := make(map[string]sdk.Handler)
+	routes[types.RouteSchedule] = NewHandler(keeper)
+	return routes
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msgs of the schedule module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgScheduleSend{}, "cosmos-sdk/MsgScheduleSend", nil)
+	cdc.RegisterConcrete(MsgCancelScheduledSend{}, "cosmos-sdk/MsgCancelScheduledSend", nil)
+}
+
+var msgCdc = codec.New()
+
+func init() {
+	RegisterCodec(msgCdc)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = 34
+
+	CodeInvalidSchedule    sdk.CodeType = 1
+	CodeNoScheduledSend    sdk.CodeType = 2
+	CodeNotSender          sdk.CodeType = 3
+	CodeTooManySchedules   sdk.CodeType = 4
+	CodeScheduleNotEnabled sdk.CodeType = 5
+)
+
+func ErrInvalidSchedule(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidSchedule, msg)
+}
+
+func ErrNoScheduledSend(id uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoScheduledSend, fmt.Sprintf("scheduled send %d does not exist", id))
+}
+
+func ErrNotSender(id uint64, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotSender,
+		fmt.Sprintf("%s is not the sender of scheduled send %d", addr, id))
+}
+
+func ErrTooManySchedules(sender sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeTooManySchedules,
+		fmt.Sprintf("%s has %d pending scheduled sends at most", sender, MaxSchedulesPerSender))
+}
+
+func ErrScheduleNotEnabled() sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeScheduleNotEnabled, "scheduled send is not enabled yet")
+}
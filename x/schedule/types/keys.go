@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StoreKey      = "schedule"
+	RouteSchedule = "schedule"
+
+	MaxExecutions         = 1000 // the max number of executions of a scheduled send
+	MaxSchedulesPerSender = 100
+	MaxExecutionsPerBlock = 200 // the mature sends left over are executed in the following blocks
+)
+
+var (
+	// the escrow holding the coins of the scheduled sends until they are executed
+	EscrowAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainScheduledSendEscrow")))
+
+	ScheduledSendKeyPrefix = []byte{0x01} // prefix for each key to a scheduled send
+	SenderIndexKeyPrefix   = []byte{0x02} // prefix for the index of the scheduled sends by sender
+	HeightQueueKeyPrefix   = []byte{0x03} // prefix for the queue of the scheduled sends executed at a height
+	TimeQueueKeyPrefix     = []byte{0x04} // prefix for the queue of the scheduled sends executed at a time
+	NextIDKey              = []byte{0x05} // key for the id of the next scheduled send
+)
+
+func idBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// IDFromQueueKey returns the id of the scheduled send at the end of a key of the queues or the sender index
+func IDFromQueueKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+func GetScheduledSendKey(id uint64) []byte {
+	return append(ScheduledSendKeyPrefix, idBytes(id)...)
+}
+
+func GetSenderIndexPrefix(sender sdk.AccAddress) []byte {
+	return append(SenderIndexKeyPrefix, sender.Bytes()...)
+}
+
+func GetSenderIndexKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(GetSenderIndexPrefix(sender), idBytes(id)...)
+}
+
+func GetHeightQueuePrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(HeightQueueKeyPrefix, bz...)
+}
+
+func GetHeightQueueKey(height int64, id uint64) []byte {
+	return append(GetHeightQueuePrefix(height), idBytes(id)...)
+}
+
+func GetTimeQueuePrefix(t time.Time) []byte {
+	return append(TimeQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+func GetTimeQueueKey(t time.Time, id uint64) []byte {
+	return append(GetTimeQueuePrefix(t), idBytes(id)...)
+}
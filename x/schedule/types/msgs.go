@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ScheduleSendMsgType        = "schedule_send"
+	CancelScheduledSendMsgType = "cancel_scheduled_send"
+)
+
+var _ sdk.Msg = MsgScheduleSend{}
+
+// MsgScheduleSend escrows the coins of all the executions and sends the amount to the receiver on schedule
+type MsgScheduleSend struct {
+	From     sdk.AccAddress `json:"from"`
+	To       sdk.AccAddress `json:"to"`
+	Amount   sdk.Coins      `json:"amount"`
+	Schedule Schedule       `json:"schedule"`
+}
+
+func NewMsgScheduleSend(from, to sdk.AccAddress, amount sdk.Coins, schedule Schedule) MsgScheduleSend {
+	return MsgScheduleSend{
+		From:     from,
+		To:       to,
+		Amount:   amount,
+		Schedule: schedule,
+	}
+}
+
+// nolint
+func (msg MsgScheduleSend) Route() string { return RouteSchedule }
+func (msg MsgScheduleSend) Type() string  { return ScheduleSendMsgType }
+func (msg MsgScheduleSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgScheduleSend) String() string {
+	return fmt.Sprintf("MsgScheduleSend{%s -> %s, %s, %+v}", msg.From, msg.To, msg.Amount, msg.Schedule)
+}
+
+func (msg MsgScheduleSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgScheduleSend) ValidateBasic() sdk.Error {
+	if len(msg.From) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	if len(msg.To) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.To.String())
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if err := msg.Schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, coin := range msg.Amount {
+		if coin.Amount > sdk.TokenMaxTotalSupply/msg.Schedule.Executions {
+			return sdk.ErrInvalidCoins(fmt.Sprintf("the total amount of %s overflows", coin.Denom))
+		}
+	}
+	return nil
+}
+
+func (msg MsgScheduleSend) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From, msg.To, EscrowAccAddr}
+}
+
+var _ sdk.Msg = MsgCancelScheduledSend{}
+
+// MsgCancelScheduledSend removes a pending scheduled send, the coins of the remaining executions are refunded
+type MsgCancelScheduledSend struct {
+	From sdk.AccAddress `json:"from"`
+	ID   uint64         `json:"id"`
+}
+
+func NewMsgCancelScheduledSend(from sdk.AccAddress, id uint64) MsgCancelScheduledSend {
+	return MsgCancelScheduledSend{From: from, ID: id}
+}
+
+// nolint
+func (msg MsgCancelScheduledSend) Route() string { return RouteSchedule }
+func (msg MsgCancelScheduledSend) Type() string  { return CancelScheduledSendMsgType }
+func (msg MsgCancelScheduledSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgCancelScheduledSend) String() string {
+	return fmt.Sprintf("MsgCancelScheduledSend{%s, %d}", msg.From, msg.ID)
+}
+
+func (msg MsgCancelScheduledSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelScheduledSend) ValidateBasic() sdk.Error {
+	if len(msg.From) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelScheduledSend) GetInvolvedAddresses() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From, EscrowAccAddr}
+}
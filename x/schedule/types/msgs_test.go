@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgScheduleSendValidateBasic(t *testing.T) {
+	from := sdk.AccAddress([]byte("from----------------"))
+	to := sdk.AccAddress([]byte("to------------------"))
+	amount := sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, 100)}
+	start := time.Unix(1000, 0)
+
+	tests := []struct {
+		msg     MsgScheduleSend
+		expPass bool
+	}{
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, 1, 0)), true},
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, 5, 100)), true},
+		{NewMsgScheduleSend(from, to, amount, NewTimeSchedule(start, 1, 0)), true},
+		{NewMsgScheduleSend(from, to, amount, NewTimeSchedule(start, 5, time.Hour)), true},
+		{NewMsgScheduleSend(nil, to, amount, NewHeightSchedule(10, 1, 0)), false},
+		{NewMsgScheduleSend(from, to, nil, NewHeightSchedule(10, 1, 0)), false},
+		{NewMsgScheduleSend(from, to, amount, Schedule{Executions: 1}), false},
+		{NewMsgScheduleSend(from, to, amount, Schedule{Height: 10, Time: start, Executions: 1}), false},
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, 0, 0)), false},
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, MaxExecutions+1, 1)), false},
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, 5, 0)), false},
+		{NewMsgScheduleSend(from, to, amount, NewHeightSchedule(10, 1, 100)), false},
+		{NewMsgScheduleSend(from, to, amount, NewTimeSchedule(start, 5, 0)), false},
+		{NewMsgScheduleSend(from, to, sdk.Coins{sdk.NewCoin(sdk.NativeTokenSymbol, sdk.TokenMaxTotalSupply)},
+			NewHeightSchedule(10, 2, 1)), false},
+	}
+
+	for i, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.Nil(t, err, "test: %v", i)
+		} else {
+			require.NotNil(t, err, "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Schedule decides when a scheduled send is executed, either at a height or at a time. A recurring
+// send is executed Executions times, separated by the interval of the same kind.
+type Schedule struct {
+	Height         int64         `json:"height"`
+	Time           time.Time     `json:"time"`
+	Executions     int64         `json:"executions"`
+	IntervalBlocks int64         `json:"interval_blocks"`
+	Interval       time.Duration `json:"interval"`
+}
+
+// NewHeightSchedule executes the send at the height, repeated every interval blocks
+func NewHeightSchedule(height, executions, intervalBlocks int64) Schedule {
+	return Schedule{Height: height, Executions: executions, IntervalBlocks: intervalBlocks}
+}
+
+// NewTimeSchedule executes the send at the first block from the time on, repeated every interval
+func NewTimeSchedule(t time.Time, executions int64, interval time.Duration) Schedule {
+	return Schedule{Time: t.UTC(), Executions: executions, Interval: interval}
+}
+
+func (s Schedule) IsHeightBased() bool {
+	return s.Height > 0
+}
+
+func (s Schedule) ValidateBasic() sdk.Error {
+	if s.IsHeightBased() == !s.Time.IsZero() {
+		return ErrInvalidSchedule("exactly one of the height and the time should be set")
+	}
+	if s.Executions <= 0 || s.Executions > MaxExecutions {
+		return ErrInvalidSchedule(fmt.Sprintf("the executions should be between 1 and %d", MaxExecutions))
+	}
+	if s.IntervalBlocks < 0 || s.Interval < 0 {
+		return ErrInvalidSchedule("the interval should not be negative")
+	}
+	if s.IsHeightBased() {
+		if s.Interval != 0 || (s.Executions > 1) != (s.IntervalBlocks > 0) {
+			return ErrInvalidSchedule("the recurring sends at a height should only set the interval blocks")
+		}
+	} else if s.IntervalBlocks != 0 || (s.Executions > 1) != (s.Interval > 0) {
+		return ErrInvalidSchedule("the recurring sends at a time should only set the interval")
+	}
+	return nil
+}
+
+// IsDue returns true if the schedule is due at the block
+func (s Schedule) IsDue(height int64, blockTime time.Time) bool {
+	if s.IsHeightBased() {
+		return s.Height <= height
+	}
+	return !s.Time.After(blockTime)
+}
+
+// Next returns the schedule of the following execution
+func (s Schedule) Next() Schedule {
+	s.Executions--
+	if s.IsHeightBased() {
+		s.Height += s.IntervalBlocks
+	} else {
+		s.Time = s.Time.Add(s.Interval)
+	}
+	return s
+}
+
+// ScheduledSend is a pending send, the coins of the remaining executions are held by the escrow. A failed
+// send is no longer executed, it is kept until the sender cancels it because its coins could not be refunded.
+type ScheduledSend struct {
+	ID       uint64         `json:"id"`
+	From     sdk.AccAddress `json:"from"`
+	To       sdk.AccAddress `json:"to"`
+	Amount   sdk.Coins      `json:"amount"`
+	Schedule Schedule       `json:"schedule"`
+	Failed   bool           `json:"failed"`
+}
+
+// Escrowed returns the coins held by the escrow for the remaining executions
+func (s ScheduledSend) Escrowed() sdk.Coins {
+	return MulCoins(s.Amount, s.Schedule.Executions)
+}
+
+func (s ScheduledSend) String() string {
+	return fmt.Sprintf("ScheduledSend{%d: %s -> %s, %s, %+v, failed: %t}", s.ID, s.From, s.To, s.Amount, s.Schedule, s.Failed)
+}
+
+// MulCoins multiplies the amounts of the coins, the overflow should be checked before
+func MulCoins(coins sdk.Coins, n int64) sdk.Coins {
+	res := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		res[i] = sdk.NewCoin(coin.Denom, coin.Amount*n)
+	}
+	return res
+}
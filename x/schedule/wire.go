@@ -0,0 +1,11 @@
+package schedule
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/schedule/types"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
@@ -1,6 +1,7 @@
 package sidechain
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,7 +22,8 @@ func (k *Keeper) getLastChanPermissionChanges(ctx sdk.Context) []types.ChanPermi
 			if ctx.BlockHeader().Time.Sub(proposal.GetVotingStartTime()) > backPeriod {
 				return true
 			}
-			if proposal.GetStatus() != gov.StatusPassed {
+			// the proposals with typed content are executed by the content handler when they pass
+			if proposal.GetStatus() != gov.StatusPassed || proposal.GetContent() != nil {
 				return false
 			}
 
@@ -29,8 +31,7 @@ func (k *Keeper) getLastChanPermissionChanges(ctx sdk.Context) []types.ChanPermi
 			k.govKeeper.SetProposal(ctx, proposal)
 
 			var setting types.ChanPermissionSetting
-			strProposal := proposal.GetDescription()
-			err := k.cdc.UnmarshalJSON([]byte(strProposal), &setting)
+			err := gov.DecodeProposalContent(k.cdc, proposal, &setting)
 			if err != nil {
 				ctx.Logger().With("module", "side_chain").Error("Get broken data when unmarshal ChanPermissionSetting msg, will skip.",
 					"proposalId", proposal.GetProposalID(), "err", err)
@@ -58,6 +59,16 @@ func (k *Keeper) getLastChanPermissionChanges(ctx sdk.Context) []types.ChanPermi
 	return changes
 }
 
+func (k *Keeper) checkChanPermissionSetting(setting types.ChanPermissionSetting) error {
+	if _, ok := k.cfg.destChainNameToID[setting.SideChainId]; !ok {
+		return fmt.Errorf("the SideChainId do not exist")
+	}
+	if _, ok := k.cfg.channelIDToName[setting.ChannelId]; !ok {
+		return fmt.Errorf("the ChannelId do not exist")
+	}
+	return nil
+}
+
 func (k *Keeper) SaveChannelSettingChangeToIbc(ctx sdk.Context, sideChainId sdk.ChainID, channelId sdk.ChannelID, permission sdk.ChannelPermission) (seq uint64, sdkErr sdk.Error) {
 	valueBytes := []byte{byte(channelId), byte(permission)}
 
@@ -27,19 +27,36 @@ func (hooks ChanPermissionSettingHooks) OnProposalSubmitted(ctx sdk.Context, pro
 	}
 
 	var changeParam types.ChanPermissionSetting
-	strProposal := proposal.GetDescription()
-	err := hooks.cdc.UnmarshalJSON([]byte(strProposal), &changeParam)
+	err := gov.DecodeProposalContent(hooks.cdc, proposal, &changeParam)
 	if err != nil {
-		fmt.Errorf("get broken data when unmarshal ChanPermissionSetting msg. proposalId %d, err %v", proposal.GetProposalID(), err)
+		return fmt.Errorf("get broken data when unmarshal ChanPermissionSetting msg. proposalId %d, err %v", proposal.GetProposalID(), err)
 	}
 	if err := changeParam.Check(); err != nil {
 		return err
 	}
-	if _, ok := hooks.k.cfg.destChainNameToID[changeParam.SideChainId]; !ok {
-		return fmt.Errorf("the SideChainId do not exist")
-	}
-	if _, ok := hooks.k.cfg.channelIDToName[changeParam.ChannelId]; !ok {
-		return fmt.Errorf("the ChannelId do not exist")
+	return hooks.k.checkChanPermissionSetting(changeParam)
+}
+
+// NewChanPermissionContentHandler returns the handler of passed ManageChanPermission proposals with typed content,
+// it sets the permission of the channel and sends the change to the side chain.
+func NewChanPermissionContentHandler(keeper *Keeper) gov.ContentHandler {
+	return func(ctx sdk.Context, proposal gov.Proposal, content gov.Content) sdk.Error {
+		if !sdk.IsUpgrade(sdk.LaunchBscUpgrade) {
+			return gov.ErrInvalidProposalContent(gov.DefaultCodespace, "channel permission is not supported yet")
+		}
+		setting, ok := content.Payload().(types.ChanPermissionSetting)
+		if !ok {
+			return gov.ErrInvalidProposalContent(gov.DefaultCodespace, fmt.Sprintf("unexpected content %T", content))
+		}
+		if err := setting.Check(); err != nil {
+			return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+		}
+		if err := keeper.checkChanPermissionSetting(setting); err != nil {
+			return gov.ErrInvalidProposalContent(gov.DefaultCodespace, err.Error())
+		}
+		id := keeper.cfg.destChainNameToID[setting.SideChainId]
+		keeper.SetChannelSendPermission(ctx, id, setting.ChannelId, setting.Permission)
+		_, err := keeper.SaveChannelSettingChangeToIbc(ctx, id, setting.ChannelId, setting.Permission)
+		return err
 	}
-	return nil
 }
@@ -39,8 +39,10 @@ func NewKeeper(storeKey sdk.StoreKey, paramspace params.Subspace, cdc *codec.Cod
 	}
 }
 
+// SetGovKeeper connects the gov keeper and registers the handler of the channel permission proposals on it
 func (k *Keeper) SetGovKeeper(govKeeper *gov.Keeper) {
 	k.govKeeper = govKeeper
+	govKeeper.AddContentHandler(gov.ProposalTypeManageChanPermission, NewChanPermissionContentHandler(k))
 }
 
 func (k *Keeper) SetIbcKeeper(ibcKeeper IbcKeeper) {
@@ -20,6 +20,7 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdUnjail(cdc),
 			GetCmdBscSubmitEvidence(cdc),
 			GetCmdSideChainUnjail(cdc),
+			GetCmdClaimSlashCompensation(cdc),
 		)...)
 
 	slashingCmd.AddCommand(
@@ -28,6 +29,7 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdQuerySideChainSlashRecord(slashingStoreName, cdc),
 			GetCmdQuerySideChainSlashRecords(cdc),
 			GetCmdQueryAllSideSlashRecords(slashingStoreName, cdc),
+			GetCmdQueryCompensationEvents(cdc),
 		)...)
 
 	root.AddCommand(slashingCmd)
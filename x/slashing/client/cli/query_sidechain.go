@@ -297,3 +297,46 @@ func convertInfractionType(infractionTypeS string) (byte, error) {
 	}
 	return res, nil
 }
+
+// GetCmdQueryCompensationEvents implements the command to query the slash compensation events
+func GetCmdQueryCompensationEvents(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "side-compensation-events",
+		Short: "Query the compensation events of slashes on side chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			sideChainId, _, err := getSideChainConfig(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			params := slashing.QueryCompensationEventsParams{
+				BaseParams: slashing.NewBaseParams(sideChainId),
+			}
+			if valAddrStr := viper.GetString(FlagAddressValidator); len(valAddrStr) != 0 {
+				params.Validator, err = sdk.ValAddressFromBech32(valAddrStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+			response, err := cliCtx.QueryWithData(fmt.Sprintf("custom/slashing/%s", slashing.QueryCompensationEvents), bz)
+			if err != nil {
+				return err
+			}
+			if len(response) == 0 {
+				return fmt.Errorf("no compensation event found")
+			}
+
+			fmt.Println(string(response))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
+	cmd.Flags().String(FlagAddressValidator, "", "bech32 address of the slashed validator operator")
+	return cmd
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"os"
+	"strconv"
 )
 
 const (
@@ -111,6 +112,42 @@ func GetCmdSideChainUnjail(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdClaimSlashCompensation implements the command to claim the compensation of a slash.
+func GetCmdClaimSlashCompensation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-slash-compensation [event-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "claim the compensation from the insurance pool for the slash of the validator you delegated to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			sideChainId, err := getSideChainId()
+			if err != nil {
+				return err
+			}
+
+			eventId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid event id %s", args[0])
+			}
+
+			msg := slashing.NewMsgClaimSlashCompensation(delAddr, sideChainId, eventId)
+
+			return utils.GenerateOrBroadcastMsgs(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagSideChainId, "", "chain-id of the side chain the validator belongs to")
+	return cmd
+}
+
 func getSideChainId() (sideChainId string, err error) {
 	sideChainId = viper.GetString(flagSideChainId)
 	if len(sideChainId) == 0 {
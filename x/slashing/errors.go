@@ -29,6 +29,8 @@ const (
 	CodeInvalidSideChain            CodeType = 205
 	CodeDuplicateDowntimeClaim      CodeType = 206
 	CodeDuplicateMaliciousVoteClaim CodeType = 207
+	CodeInvalidCompensationClaim    CodeType = 208
+	CodeCompensationClaimed         CodeType = 209
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -94,3 +96,11 @@ func ErrDuplicateMaliciousVoteClaim(codespace sdk.CodespaceType) sdk.Error {
 func ErrInvalidInput(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, msg)
 }
+
+func ErrInvalidCompensationClaim(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCompensationClaim, msg)
+}
+
+func ErrCompensationClaimed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeCompensationClaimed, "the compensation has been claimed")
+}
@@ -37,6 +37,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgBscSubmitEvidence(ctx, msg, k)
 		case MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case MsgClaimSlashCompensation:
+			return handleMsgClaimSlashCompensation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -49,6 +51,8 @@ func NewSlashingHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case MsgClaimSlashCompensation:
+			return handleMsgClaimSlashCompensation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -72,12 +72,17 @@ func handleMsgBscSubmitEvidence(ctx sdk.Context, msg MsgBscSubmitEvidence, k Kee
 
 	if submitterRewardReal > 0 {
 		submitterBalance := k.BankKeeper.GetCoins(ctx, msg.Submitter)
-		if err := k.BankKeeper.SetCoins(ctx, msg.Submitter, submitterBalance.Plus(sdk.Coins{submitterRewardCoin})); err != nil {
+		if err := k.BankKeeper.SetCoins(sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeSlash), msg.Submitter, submitterBalance.Plus(sdk.Coins{submitterRewardCoin})); err != nil {
 			return ErrFailedToSlash(k.Codespace, err.Error()).Result()
 		}
 	}
 
 	remainingReward := slashedAmount.RawInt() - submitterRewardReal
+	toInsurancePool, sdkErr := k.payInsurance(ctx, sideCtx, sideChainId, validator, DoubleSign, remainingReward)
+	if sdkErr != nil {
+		return ErrFailedToSlash(k.Codespace, sdkErr.Error()).Result()
+	}
+	remainingReward = remainingReward - toInsurancePool
 	var toFeePool int64
 	var validatorsCompensation map[string]int64
 	var found bool
@@ -123,6 +128,7 @@ func handleMsgBscSubmitEvidence(ctx sdk.Context, msg MsgBscSubmitEvidence, k Kee
 			SlashAmt:               slashedAmount.RawInt(),
 			SideChainId:            sideChainId,
 			ToFeePool:              toFeePool,
+			ToInsurancePool:        toInsurancePool,
 			Submitter:              msg.Submitter,
 			SubmitterReward:        submitterRewardReal,
 			ValidatorsCompensation: validatorsCompensation,
@@ -149,3 +155,26 @@ func handleMsgSideChainUnjail(ctx sdk.Context, msg MsgSideChainUnjail, k Keeper)
 		Tags: tags,
 	}
 }
+
+func handleMsgClaimSlashCompensation(ctx sdk.Context, msg MsgClaimSlashCompensation, k Keeper) sdk.Result {
+	if !sdk.IsUpgrade(sdk.SlashInsurance) {
+		return ErrInvalidInput(k.Codespace, "slash insurance is not enabled").Result()
+	}
+
+	scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, msg.SideChainId)
+	if err != nil {
+		return ErrInvalidSideChainId(DefaultCodespace).Result()
+	}
+
+	amount, sdkErr := k.claimCompensation(ctx, scCtx, msg.DelegatorAddr, msg.EventId)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	tags := sdk.NewTags("sideChainId", []byte(msg.SideChainId), "delegator", []byte(msg.DelegatorAddr.String()),
+		"compensation", []byte(fmt.Sprintf("%d", amount)))
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -41,6 +41,32 @@ func (k Keeper) onSideChainValidatorBonded(ctx sdk.Context, sideConsAddr []byte,
 		}
 		k.setValidatorSigningInfo(ctx, sideConsAddr, signingInfo)
 	}
+
+	if sdk.IsUpgrade(sdk.SideChainLiveness) {
+		// Create a new slashing period when a side chain validator is bonded, the downtime slashing
+		// of side chain validators is capped by the slashing period
+		slashingPeriod := ValidatorSlashingPeriod{
+			ValidatorAddr: sideConsAddr,
+			StartHeight:   ctx.BlockHeight(),
+			EndHeight:     0,
+			SlashedSoFar:  sdk.ZeroDec(),
+		}
+		k.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
+	}
+}
+
+// Mark the slashing period as having ended when a side chain validator begins unbonding
+func (k Keeper) onSideChainValidatorBeginUnbonding(ctx sdk.Context, sideConsAddr []byte, _ sdk.ValAddress) {
+	if !sdk.IsUpgrade(sdk.SideChainLiveness) {
+		return
+	}
+	address := sdk.ConsAddress(sideConsAddr)
+	if !k.hasValidatorSlashingPeriodForHeight(ctx, address, ctx.BlockHeight()) {
+		return
+	}
+	slashingPeriod := k.getValidatorSlashingPeriodForHeight(ctx, address, ctx.BlockHeight())
+	slashingPeriod.EndHeight = ctx.BlockHeight()
+	k.addOrUpdateValidatorSlashingPeriod(ctx, slashingPeriod)
 }
 
 // Mark the slashing period as having ended when a validator begins unbonding
@@ -103,6 +129,11 @@ func (h Hooks) OnSideChainValidatorBonded(ctx sdk.Context, sideConsAddr []byte,
 	h.k.onSideChainValidatorBonded(ctx, sideConsAddr, operator)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) OnSideChainValidatorBeginUnbonding(ctx sdk.Context, sideConsAddr []byte, operator sdk.ValAddress) {
+	h.k.onSideChainValidatorBeginUnbonding(ctx, sideConsAddr, operator)
+}
+
 // Implements sdk.ValidatorHooks
 func (h Hooks) OnValidatorBeginUnbonding(ctx sdk.Context, address sdk.ConsAddress, operator sdk.ValAddress) {
 	h.k.onValidatorBeginUnbonding(ctx, address, operator)
@@ -120,5 +151,3 @@ func (h Hooks) OnValidatorRemoved(_ sdk.Context, _ sdk.ValAddress)
 func (h Hooks) OnDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) OnDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
 func (h Hooks) OnDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) OnSideChainValidatorBeginUnbonding(ctx sdk.Context, sideConsAddr []byte, operator sdk.ValAddress) {
-}
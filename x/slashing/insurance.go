@@ -0,0 +1,218 @@
+package slashing
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stake "github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// SlashInsurancePoolAddr is the account holding the slash insurance fund, the fund is paid by a share of the
+// slashed amount and the fees of side chain validators and paid out to the delegators of the slashed validators.
+var SlashInsurancePoolAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainSlashInsurancePool")))
+
+// validatorDelegations lists the delegations to a validator, it is implemented by the stake keeper
+type validatorDelegations interface {
+	GetSimplifiedDelegationsByValidator(ctx sdk.Context, validator sdk.ValAddress) []stake.SimplifiedDelegation
+}
+
+// CompensationEvent is opened when a side chain validator is slashed with the insurance enabled. The delegators
+// of the validator can claim their compensations before ExpireTime, in proportion to their shares at the slash.
+type CompensationEvent struct {
+	Id              uint64         `json:"id"`
+	SideChainId     string         `json:"side_chain_id"`
+	Validator       sdk.ValAddress `json:"validator"`
+	InfractionType  byte           `json:"infraction_type"`
+	SlashHeight     int64          `json:"slash_height"`
+	ExpireTime      time.Time      `json:"expire_time"`
+	TotalShares     sdk.Dec        `json:"total_shares"`     // shares of the delegators except the self delegator
+	MaxCompensation int64          `json:"max_compensation"` // total compensations can be paid for this event
+	Paid            int64          `json:"paid"`
+}
+
+// InsurancePoolAddr returns the account of the insurance pool, the stake keeper pays the insurance share of the
+// fees into it
+func (k Keeper) InsurancePoolAddr() sdk.AccAddress {
+	return SlashInsurancePoolAddr
+}
+
+// payInsurance pays the insurance share of the slashed amount into the insurance pool and opens a compensation
+// event for the delegators of the slashed validator. It returns the amount paid into the pool, which should be
+// deducted from the amount allocated to the other validators or the fee pool.
+func (k *Keeper) payInsurance(ctx, sideCtx sdk.Context, sideChainName string, validator sdk.Validator,
+	infractionType byte, amount int64) (int64, sdk.Error) {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeSlash)
+	if !sdk.IsUpgrade(sdk.SlashInsurance) || validator == nil || amount <= 0 {
+		return 0, nil
+	}
+	share := k.InsuranceShare(sideCtx)
+	if share.LTE(sdk.ZeroDec()) {
+		return 0, nil
+	}
+
+	insurance := sdk.MinInt64(sdk.NewDec(amount).Mul(share).RawInt(), amount)
+	if insurance <= 0 {
+		return 0, nil
+	}
+	bondDenom := k.validatorSet.BondDenom(sideCtx)
+	if _, _, err := k.BankKeeper.AddCoins(ctx, SlashInsurancePoolAddr, sdk.Coins{sdk.NewCoin(bondDenom, insurance)}); err != nil {
+		return 0, err
+	}
+
+	window := k.CompensationWindow(sideCtx)
+	maxCompensation := sdk.MinInt64(k.MaxCompensationPerEvent(sideCtx),
+		k.BankKeeper.GetCoins(ctx, SlashInsurancePoolAddr).AmountOf(bondDenom))
+	if window <= 0 || maxCompensation <= 0 {
+		return insurance, nil
+	}
+
+	// the delegators are eligible with their shares at the slash, the self delegation has been slashed, it is
+	// excluded from the compensation
+	vd, ok := k.validatorSet.(validatorDelegations)
+	if !ok {
+		return insurance, nil
+	}
+	var delegations []stake.SimplifiedDelegation
+	totalShares := sdk.ZeroDec()
+	for _, del := range vd.GetSimplifiedDelegationsByValidator(sideCtx, validator.GetOperator()) {
+		if del.DelegatorAddr.Equals(validator.GetFeeAddr()) || del.Shares.LTE(sdk.ZeroDec()) {
+			continue
+		}
+		delegations = append(delegations, del)
+		totalShares = totalShares.Add(del.Shares)
+	}
+	if totalShares.LTE(sdk.ZeroDec()) {
+		return insurance, nil
+	}
+
+	header := sideCtx.BlockHeader()
+	event := CompensationEvent{
+		Id:              k.nextCompensationEventId(sideCtx),
+		SideChainId:     sideChainName,
+		Validator:       validator.GetOperator(),
+		InfractionType:  infractionType,
+		SlashHeight:     header.Height,
+		ExpireTime:      header.Time.Add(window),
+		TotalShares:     totalShares,
+		MaxCompensation: maxCompensation,
+	}
+	k.setCompensationEvent(sideCtx, event)
+	for _, del := range delegations {
+		k.setCompensationShares(sideCtx, event.Id, del.DelegatorAddr, del.Shares)
+	}
+	return insurance, nil
+}
+
+// claimCompensation pays the compensation of a delegator for a compensation event from the insurance pool.
+func (k *Keeper) claimCompensation(ctx, sideCtx sdk.Context, delAddr sdk.AccAddress, eventId uint64) (int64, sdk.Error) {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeSlash)
+	event, found := k.getCompensationEvent(sideCtx, eventId)
+	if !found {
+		return 0, ErrInvalidCompensationClaim(k.Codespace, fmt.Sprintf("compensation event %d does not exist", eventId))
+	}
+	if ctx.BlockHeader().Time.After(event.ExpireTime) {
+		return 0, ErrInvalidCompensationClaim(k.Codespace, fmt.Sprintf("compensation event %d has expired", eventId))
+	}
+	if k.hasClaimedCompensation(sideCtx, eventId, delAddr) {
+		return 0, ErrCompensationClaimed(k.Codespace)
+	}
+	if validator := k.validatorSet.Validator(sideCtx, event.Validator); validator != nil && validator.GetFeeAddr().Equals(delAddr) {
+		return 0, ErrInvalidCompensationClaim(k.Codespace, "the self delegator of the slashed validator can not be compensated")
+	}
+
+	shares, found := k.getCompensationShares(sideCtx, eventId, delAddr)
+	if !found {
+		return 0, ErrInvalidCompensationClaim(k.Codespace, "no delegation to the slashed validator at the slash")
+	}
+
+	compensation := new(big.Int).Mul(big.NewInt(event.MaxCompensation), big.NewInt(shares.RawInt()))
+	compensation.Quo(compensation, big.NewInt(event.TotalShares.RawInt()))
+	bondDenom := k.validatorSet.BondDenom(sideCtx)
+	amount := sdk.MinInt64(compensation.Int64(), event.MaxCompensation-event.Paid)
+	amount = sdk.MinInt64(amount, k.BankKeeper.GetCoins(ctx, SlashInsurancePoolAddr).AmountOf(bondDenom))
+	if amount <= 0 {
+		return 0, ErrInvalidCompensationClaim(k.Codespace, "no compensation to claim")
+	}
+
+	if _, err := k.BankKeeper.SendCoins(ctx, SlashInsurancePoolAddr, delAddr, sdk.Coins{sdk.NewCoin(bondDenom, amount)}); err != nil {
+		return 0, err
+	}
+	event.Paid += amount
+	k.setCompensationEvent(sideCtx, event)
+	k.setClaimedCompensation(sideCtx, eventId, delAddr)
+	sideCtx.KVStore(k.storeKey).Delete(GetCompensationSharesKey(eventId, delAddr))
+	return amount, nil
+}
+
+func (k Keeper) nextCompensationEventId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get(LastCompensationEventIdKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	}
+	id++
+	store.Set(LastCompensationEventIdKey, k.cdc.MustMarshalBinaryLengthPrefixed(id))
+	return id
+}
+
+func (k Keeper) getCompensationEvent(ctx sdk.Context, id uint64) (event CompensationEvent, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCompensationEventKey(id))
+	if bz == nil {
+		return event, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &event)
+	return event, true
+}
+
+func (k Keeper) setCompensationEvent(ctx sdk.Context, event CompensationEvent) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(event)
+	store.Set(GetCompensationEventKey(event.Id), bz)
+}
+
+func (k Keeper) getCompensationEvents(ctx sdk.Context, validator sdk.ValAddress) []CompensationEvent {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, CompensationEventKey)
+	defer iterator.Close()
+
+	events := make([]CompensationEvent, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var event CompensationEvent
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &event)
+		if len(validator) != 0 && !event.Validator.Equals(validator) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func (k Keeper) hasClaimedCompensation(ctx sdk.Context, eventId uint64, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetCompensationClaimKey(eventId, delAddr))
+}
+
+func (k Keeper) setClaimedCompensation(ctx sdk.Context, eventId uint64, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCompensationClaimKey(eventId, delAddr), []byte{0x01})
+}
+
+func (k Keeper) getCompensationShares(ctx sdk.Context, eventId uint64, delAddr sdk.AccAddress) (shares sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCompensationSharesKey(eventId, delAddr))
+	if bz == nil {
+		return shares, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &shares)
+	return shares, true
+}
+
+func (k Keeper) setCompensationShares(ctx sdk.Context, eventId uint64, delAddr sdk.AccAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCompensationSharesKey(eventId, delAddr), k.cdc.MustMarshalBinaryLengthPrefixed(shares))
+}
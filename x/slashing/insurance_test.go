@@ -0,0 +1,96 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestSlashInsurance(t *testing.T) {
+	slashingParams := DefaultParams()
+	slashingParams.MaxEvidenceAge = 12 * 60 * 60 * time.Second
+	slashingParams.InsuranceShare = sdk.NewDecWithPrec(5, 1)
+	slashingParams.CompensationWindow = 24 * time.Hour
+	slashingParams.MaxCompensationPerEvent = 10e8
+	ctx, sideCtx, bankKeeper, stakeKeeper, _, keeper := createSideTestInput(t, slashingParams)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SlashInsurance, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SlashInsurance, 0)
+	handler := NewHandler(keeper)
+
+	bondAmount := int64(10000e8)
+	valAddr := addrs[0]
+	sideConsAddr := createSideAddr(20)
+	msgCreateVal := newTestMsgCreateSideValidator(valAddr, sideConsAddr, createSideAddr(20), bondAmount)
+	got := stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgCreateVal)
+	require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	delegators := []sdk.AccAddress{sdk.AccAddress(addrs[1]), sdk.AccAddress(addrs[2])}
+	for i, delAmount := range []int64{1000e8, 3000e8} {
+		msgDelegate := stake.NewMsgSideChainDelegate("bsc", delegators[i], valAddr, sdk.NewCoin("steak", delAmount))
+		got = stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgDelegate)
+		require.True(t, got.IsOK(), "expected delegate msg to be ok, got: %v", got)
+	}
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	claim := SideSlashPackage{
+		SideAddr:      sideConsAddr,
+		SideHeight:    100,
+		SideChainId:   sdk.ChainID(1),
+		SideTimestamp: uint64(ctx.BlockHeader().Time.Unix()),
+	}
+	require.Nil(t, keeper.slashingSideDowntime(ctx, &claim))
+
+	// half of the slashed amount except the claim fee is paid into the insurance pool
+	insurance := (slashingParams.DowntimeSlashAmount - slashingParams.DowntimeSlashFee) / 2
+	require.EqualValues(t, insurance, bankKeeper.GetCoins(ctx, SlashInsurancePoolAddr).AmountOf("steak"))
+
+	events := keeper.getCompensationEvents(sideCtx, nil)
+	require.Len(t, events, 1)
+	event := events[0]
+	require.EqualValues(t, 1, event.Id)
+	require.EqualValues(t, valAddr, event.Validator)
+	require.EqualValues(t, slashingParams.MaxCompensationPerEvent, event.MaxCompensation)
+	require.EqualValues(t, int64(4000e8), event.TotalShares.RawInt())
+	require.EqualValues(t, ctx.BlockHeader().Time.Add(slashingParams.CompensationWindow).Unix(), event.ExpireTime.Unix())
+
+	// the delegators are eligible with their shares at the slash, the delegations changed since then do not matter
+	got = stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, stake.NewMsgSideChainUndelegate("bsc", delegators[0], valAddr, sdk.NewCoin("steak", 500e8)))
+	require.True(t, got.IsOK(), "expected undelegate msg to be ok, got: %v", got)
+	result := handler(ctx, NewMsgClaimSlashCompensation(sdk.AccAddress(createSideAddr(20)), "bsc", event.Id))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidCompensationClaim), result.Code)
+
+	// the self delegator can not be compensated
+	result = handler(ctx, NewMsgClaimSlashCompensation(sdk.AccAddress(valAddr), "bsc", event.Id))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidCompensationClaim), result.Code)
+
+	// the delegators are compensated in proportion to their shares
+	balance := bankKeeper.GetCoins(ctx, delegators[0]).AmountOf("steak")
+	result = handler(ctx, NewMsgClaimSlashCompensation(delegators[0], "bsc", event.Id))
+	require.True(t, result.IsOK(), "expected claim msg to be ok, got: %v", result)
+	require.EqualValues(t, balance+slashingParams.MaxCompensationPerEvent/4, bankKeeper.GetCoins(ctx, delegators[0]).AmountOf("steak"))
+
+	result = handler(ctx, NewMsgClaimSlashCompensation(delegators[0], "bsc", event.Id))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeCompensationClaimed), result.Code)
+
+	balance = bankKeeper.GetCoins(ctx, delegators[1]).AmountOf("steak")
+	result = handler(ctx, NewMsgClaimSlashCompensation(delegators[1], "bsc", event.Id))
+	require.True(t, result.IsOK(), "expected claim msg to be ok, got: %v", result)
+	require.EqualValues(t, balance+slashingParams.MaxCompensationPerEvent*3/4, bankKeeper.GetCoins(ctx, delegators[1]).AmountOf("steak"))
+
+	event, found := keeper.getCompensationEvent(sideCtx, event.Id)
+	require.True(t, found)
+	require.EqualValues(t, event.MaxCompensation, event.Paid)
+	require.EqualValues(t, insurance-event.Paid, bankKeeper.GetCoins(ctx, SlashInsurancePoolAddr).AmountOf("steak"))
+
+	// unknown and expired events
+	result = handler(ctx, NewMsgClaimSlashCompensation(delegators[0], "bsc", 2))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidCompensationClaim), result.Code)
+	ctx = ctx.WithBlockTime(event.ExpireTime.Add(time.Second))
+	result = handler(ctx, NewMsgClaimSlashCompensation(sdk.AccAddress(addrs[2]), "bsc", event.Id))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidCompensationClaim), result.Code)
+}
@@ -61,6 +61,10 @@ func (k *Keeper) initIbc() {
 	if err != nil {
 		panic(fmt.Sprintf("register ibc channel failed, channel=%s, err=%s", ChannelName, err.Error()))
 	}
+	err = k.ScKeeper.RegisterChannel(SideLivenessChannelName, SideLivenessChannelId, NewSideLivenessApp(k))
+	if err != nil {
+		panic(fmt.Sprintf("register ibc channel failed, channel=%s, err=%s", SideLivenessChannelName, err.Error()))
+	}
 }
 
 func (k *Keeper) SetPbsbServer(server *pubsub.Server) {
@@ -141,26 +145,8 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
-	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
-	signInfo.IndexOffset++
-
-	// Update signed block bit array & counter
-	// This counter just tracks the sum of the bit array
-	// That way we avoid needing to read/write the whole array each time
-	previous := k.getValidatorMissedBlockBitArray(ctx, consAddr, index)
 	missed := !signed
-	switch {
-	case !previous && missed:
-		// Array value has changed from not missed to missed, increment counter
-		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
-		signInfo.MissedBlocksCounter++
-	case previous && !missed:
-		// Array value has changed from missed to not missed, decrement counter
-		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, false)
-		signInfo.MissedBlocksCounter--
-	default:
-		// Array value at this index has not changed, no need to update counter
-	}
+	k.trackValidatorSignature(ctx, consAddr, &signInfo, k.SignedBlocksWindow(ctx), signed)
 
 	if missed {
 		logger.Info(fmt.Sprintf("Absent validator %s at height %d, %d missed, threshold %d", addr, height, signInfo.MissedBlocksCounter, k.MinSignedPerWindow(ctx)))
@@ -197,6 +183,30 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	k.setValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// track the signature of one block in the signing info and the missed block bit array of a validator
+func (k Keeper) trackValidatorSignature(ctx sdk.Context, consAddr sdk.ConsAddress, signInfo *ValidatorSigningInfo, window int64, signed bool) {
+	index := signInfo.IndexOffset % window
+	signInfo.IndexOffset++
+
+	// Update signed block bit array & counter
+	// This counter just tracks the sum of the bit array
+	// That way we avoid needing to read/write the whole array each time
+	previous := k.getValidatorMissedBlockBitArray(ctx, consAddr, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		// Array value has changed from not missed to missed, increment counter
+		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+		signInfo.MissedBlocksCounter++
+	case previous && !missed:
+		// Array value has changed from missed to not missed, decrement counter
+		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, false)
+		signInfo.MissedBlocksCounter--
+	default:
+		// Array value at this index has not changed, no need to update counter
+	}
+}
+
 // AddValidators adds the validators to the keepers validator addr to pubkey mapping.
 func (k Keeper) AddValidators(ctx sdk.Context, vals []abci.ValidatorUpdate) {
 	for i := 0; i < len(vals); i++ {
@@ -317,6 +327,18 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideSlashPackage) s
 		return ErrDuplicateDowntimeClaim(k.Codespace)
 	}
 
+	return k.slashSideDowntime(ctx, sideCtx, sideChainName, sideConsAddr, pack.SideHeight)
+}
+
+// slash and jail a side chain validator for downtime, the downtime is either reported by the side chain
+// or detected by the liveness tracker
+func (k *Keeper) slashSideDowntime(ctx, sideCtx sdk.Context, sideChainName string, sideConsAddr []byte, infractionHeight uint64) sdk.Error {
+	header := sideCtx.BlockHeader()
+	if sdk.IsUpgrade(sdk.SideChainLiveness) && !k.capSideDowntimeBySlashingPeriod(sideCtx, sideConsAddr) {
+		// the validator has been slashed for downtime in current slashing period
+		return ErrDuplicateDowntimeClaim(k.Codespace)
+	}
+
 	slashAmt := k.DowntimeSlashAmount(sideCtx)
 	validator, slashedAmt, err := k.validatorSet.SlashSideChain(ctx, sideChainName, sideConsAddr, sdk.NewDec(slashAmt))
 	if err != nil {
@@ -334,6 +356,11 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideSlashPackage) s
 	}
 
 	remaining := slashedAmt.RawInt() - downtimeClaimFeeReal
+	toInsurancePool, sdkErr := k.payInsurance(ctx, sideCtx, sideChainName, validator, Downtime, remaining)
+	if sdkErr != nil {
+		return ErrFailedToSlash(k.Codespace, sdkErr.Error())
+	}
+	remaining = remaining - toInsurancePool
 	var validatorsAllocatedAmt map[string]int64
 	var found bool
 	if remaining > 0 {
@@ -352,7 +379,7 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideSlashPackage) s
 	sr := SlashRecord{
 		ConsAddr:         sideConsAddr,
 		InfractionType:   Downtime,
-		InfractionHeight: pack.SideHeight,
+		InfractionHeight: infractionHeight,
 		SlashHeight:      header.Height,
 		JailUntil:        jailUntil,
 		SlashAmt:         slashedAmt.RawInt(),
@@ -374,11 +401,12 @@ func (k *Keeper) slashingSideDowntime(ctx sdk.Context, pack *SideSlashPackage) s
 		event := SideSlashEvent{
 			Validator:              validator.GetOperator(),
 			InfractionType:         Downtime,
-			InfractionHeight:       int64(pack.SideHeight),
+			InfractionHeight:       int64(infractionHeight),
 			SlashHeight:            header.Height,
 			JailUtil:               jailUntil,
 			SlashAmt:               slashedAmt.RawInt(),
 			ToFeePool:              toFeePool,
+			ToInsurancePool:        toInsurancePool,
 			SideChainId:            sideChainName,
 			ValidatorsCompensation: validatorsAllocatedAmt,
 		}
@@ -436,6 +464,12 @@ func (k *Keeper) slashingSideMaliciousVote(ctx sdk.Context, pack *SideSlashPacka
 		return ErrFailedToSlash(k.Codespace, err.Error())
 	}
 
+	toInsurancePool, sdkErr := k.payInsurance(ctx, sideCtx, sideChainName, validator, MaliciousVote, sdk.MinInt64(slashAmt, slashedAmt.RawInt()))
+	if sdkErr != nil {
+		return ErrFailedToSlash(k.Codespace, sdkErr.Error())
+	}
+	slashAmt = slashAmt - toInsurancePool
+
 	var toFeePool int64
 	var validatorsCompensation map[string]int64
 	if slashAmt > 0 {
@@ -478,6 +512,7 @@ func (k *Keeper) slashingSideMaliciousVote(ctx sdk.Context, pack *SideSlashPacka
 			JailUtil:               jailUntil,
 			SlashAmt:               slashedAmt.RawInt(),
 			ToFeePool:              toFeePool,
+			ToInsurancePool:        toInsurancePool,
 			SideChainId:            sideChainName,
 			ValidatorsCompensation: validatorsCompensation,
 		}
@@ -14,6 +14,11 @@ var (
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
 	SlashRecordKey                  = []byte{0x05} // Prefix for slash record
+	SideLivenessEpochKey            = []byte{0x06} // Key for the last epoch handled by the side chain liveness tracker
+	CompensationEventKey            = []byte{0x07} // Prefix for slash compensation events
+	CompensationClaimKey            = []byte{0x08} // Prefix for claimed slash compensations
+	LastCompensationEventIdKey      = []byte{0x09} // Key for the id of the last slash compensation event
+	CompensationSharesKey           = []byte{0x0A} // Prefix for the shares of the delegators eligible for slash compensations
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -63,3 +68,21 @@ func GetSlashRecordsByAddrAndTypeIndexKey(sideConsAddr []byte, infractionType by
 func GetSlashRecordsByAddrIndexKey(sideConsAddr []byte) []byte {
 	return append(SlashRecordKey, sideConsAddr...)
 }
+
+func GetCompensationEventKey(id uint64) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return append(CompensationEventKey, idBz...)
+}
+
+func GetCompensationClaimKey(eventId uint64, delAddr sdk.AccAddress) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, eventId)
+	return append(append(CompensationClaimKey, idBz...), delAddr.Bytes()...)
+}
+
+func GetCompensationSharesKey(eventId uint64, delAddr sdk.AccAddress) []byte {
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, eventId)
+	return append(append(CompensationSharesKey, idBz...), delAddr.Bytes()...)
+}
@@ -0,0 +1,198 @@
+package slashing
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sTypes "github.com/cosmos/cosmos-sdk/x/sidechain/types"
+)
+
+const (
+	SideLivenessChannelName = "sideLiveness"
+	SideLivenessChannelId   = sdk.ChannelID(17)
+
+	// upper bound of the blocks in one epoch, to bound the cost of handling a liveness package
+	maxSideLivenessEpochLength = 1000
+)
+
+// SideLivenessPackage is relayed from the side chain at the end of every epoch, it carries which
+// blocks of the epoch have been signed by each of the side chain validators.
+type SideLivenessPackage struct {
+	SideChainId   sdk.ChainID `json:"side_chain_id"`
+	Epoch         uint64      `json:"epoch"`
+	SideHeight    uint64      `json:"side_height"` // height of the last block in the epoch
+	SideTimestamp uint64      `json:"side_timestamp"`
+	EpochLength   uint64      `json:"epoch_length"` // number of blocks in the epoch
+	Validators    [][]byte    `json:"validators"`   // side chain consensus addresses
+	// Bitmaps[i] is the signing bitmap of Validators[i], bit j (little endian in every byte)
+	// is set if the validator signed the j-th block of the epoch
+	Bitmaps [][]byte `json:"bitmaps"`
+}
+
+func (p SideLivenessPackage) signed(validatorIdx int, blockIdx uint64) bool {
+	return p.Bitmaps[validatorIdx][blockIdx/8]&(1<<(blockIdx%8)) != 0
+}
+
+// SideLivenessApp is the cross chain application which feeds the relayed signing bitmaps
+// into the signing info of side chain validators.
+type SideLivenessApp struct {
+	k *Keeper
+}
+
+func NewSideLivenessApp(k *Keeper) *SideLivenessApp {
+	return &SideLivenessApp{
+		k: k,
+	}
+}
+
+func (app *SideLivenessApp) ExecuteSynPackage(ctx sdk.Context, payload []byte, _ int64) sdk.ExecuteResult {
+	var resCode uint32
+	var err sdk.Error
+	if !sdk.IsUpgrade(sdk.SideChainLiveness) {
+		err = ErrInvalidInput(app.k.Codespace, "side chain liveness tracking is not enabled")
+	} else {
+		var pack *SideLivenessPackage
+		pack, err = app.k.checkSideLivenessPackage(payload)
+		if err == nil {
+			err = app.k.handleSideLiveness(ctx, pack)
+		}
+	}
+	if err != nil {
+		resCode = uint32(err.ABCICode())
+	}
+	ackPackage, encodeErr := sTypes.GenCommonAckPackage(resCode)
+	if encodeErr != nil {
+		panic(encodeErr)
+	}
+	return sdk.ExecuteResult{
+		Payload: ackPackage,
+		Err:     err,
+		Tags:    sdk.EmptyTags(),
+	}
+}
+
+func (app *SideLivenessApp) ExecuteAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	panic("receive unexpected ack package")
+}
+
+// When the ack application crash, payload is the payload of the origin package.
+func (app *SideLivenessApp) ExecuteFailAckPackage(ctx sdk.Context, payload []byte) sdk.ExecuteResult {
+	panic("receive unexpected fail ack package")
+}
+
+func (k *Keeper) checkSideLivenessPackage(payload []byte) (*SideLivenessPackage, sdk.Error) {
+	var pack SideLivenessPackage
+	err := rlp.DecodeBytes(payload, &pack)
+	if err != nil {
+		return nil, ErrInvalidInput(k.Codespace, "failed to parse the payload")
+	}
+
+	if pack.Epoch == 0 {
+		return nil, ErrInvalidClaim(k.Codespace, "epoch must be positive")
+	}
+	if pack.SideHeight == 0 {
+		return nil, ErrInvalidClaim(k.Codespace, "side height must be positive")
+	}
+	if pack.SideHeight > math.MaxInt64 {
+		return nil, ErrInvalidClaim(k.Codespace, "side height overflow")
+	}
+	if pack.SideTimestamp == 0 {
+		return nil, ErrInvalidClaim(k.Codespace, "invalid side timestamp")
+	}
+	if pack.EpochLength == 0 || pack.EpochLength > maxSideLivenessEpochLength {
+		return nil, ErrInvalidClaim(k.Codespace, fmt.Sprintf("epoch length should be in range 1 to %d", maxSideLivenessEpochLength))
+	}
+	if len(pack.Validators) != len(pack.Bitmaps) {
+		return nil, ErrInvalidClaim(k.Codespace, "the number of bitmaps does not match the number of validators")
+	}
+	bitmapLen := int((pack.EpochLength + 7) / 8)
+	for i, addr := range pack.Validators {
+		if len(addr) != sdk.AddrLen {
+			return nil, ErrInvalidClaim(k.Codespace, fmt.Sprintf("wrong validator address length:%d, expected:%d", len(addr), sdk.AddrLen))
+		}
+		if len(pack.Bitmaps[i]) != bitmapLen {
+			return nil, ErrInvalidClaim(k.Codespace, fmt.Sprintf("wrong bitmap length:%d, expected:%d", len(pack.Bitmaps[i]), bitmapLen))
+		}
+	}
+	return &pack, nil
+}
+
+// handleSideLiveness tracks the signatures of side chain validators in an epoch with the signing info and missed
+// block bit array, the same way as the validators of this chain. The thresholds are the SignedBlocksWindow and
+// MinSignedPerWindow params of the side chain, validators missed too many blocks are slashed for downtime.
+func (k *Keeper) handleSideLiveness(ctx sdk.Context, pack *SideLivenessPackage) sdk.Error {
+	logger := ctx.Logger().With("module", "x/slashing")
+	sideChainName, err := k.ScKeeper.GetDestChainName(pack.SideChainId)
+	if err != nil {
+		return ErrInvalidSideChainId(DefaultCodespace)
+	}
+	sideCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainName)
+	if err != nil {
+		return ErrInvalidSideChainId(DefaultCodespace)
+	}
+
+	if lastEpoch, found := k.getSideLivenessEpoch(sideCtx); found && pack.Epoch <= lastEpoch {
+		return ErrInvalidClaim(k.Codespace, fmt.Sprintf("epoch %d is not after the last handled epoch %d", pack.Epoch, lastEpoch))
+	}
+
+	window := k.SignedBlocksWindow(sideCtx)
+	if window <= 0 {
+		return ErrInvalidInput(k.Codespace, "signed blocks window of the side chain is not set")
+	}
+	maxMissed := window - k.MinSignedPerWindow(sideCtx)
+	for i, sideConsAddr := range pack.Validators {
+		validator := k.validatorSet.ValidatorBySideChainConsAddr(sideCtx, sideConsAddr)
+		if validator == nil || validator.GetJailed() {
+			continue
+		}
+		signInfo, found := k.getValidatorSigningInfo(sideCtx, sideConsAddr)
+		if !found {
+			continue
+		}
+
+		consAddr := sdk.ConsAddress(sideConsAddr)
+		for j := uint64(0); j < pack.EpochLength; j++ {
+			k.trackValidatorSignature(sideCtx, consAddr, &signInfo, window, pack.signed(i, j))
+		}
+
+		// the validator should have been tracked for a whole window before being slashed
+		if signInfo.IndexOffset < window || signInfo.MissedBlocksCounter <= maxMissed {
+			k.setValidatorSigningInfo(sideCtx, sideConsAddr, signInfo)
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Side chain validator %s missed %d blocks in the window of %d blocks, threshold %d",
+			sdk.HexEncode(sideConsAddr), signInfo.MissedBlocksCounter, window, maxMissed))
+		// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
+		signInfo.MissedBlocksCounter = 0
+		signInfo.IndexOffset = 0
+		k.clearValidatorMissedBlockBitArray(sideCtx, consAddr)
+		k.setValidatorSigningInfo(sideCtx, sideConsAddr, signInfo)
+
+		if err := k.slashSideDowntime(ctx, sideCtx, sideChainName, sideConsAddr, pack.SideHeight); err != nil {
+			logger.Error("failed to slash side chain validator for downtime",
+				"validator", sdk.HexEncode(sideConsAddr), "err", err.Error())
+		}
+	}
+
+	k.setSideLivenessEpoch(sideCtx, pack.Epoch)
+	return nil
+}
+
+func (k Keeper) getSideLivenessEpoch(ctx sdk.Context) (epoch uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(SideLivenessEpochKey)
+	if bz == nil {
+		return 0, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &epoch)
+	return epoch, true
+}
+
+func (k Keeper) setSideLivenessEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(epoch)
+	store.Set(SideLivenessEpochKey, bz)
+}
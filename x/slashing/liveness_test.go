@@ -0,0 +1,127 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func newSideLivenessPackage(epoch uint64, validators [][]byte, missed []uint64, timestamp time.Time) SideLivenessPackage {
+	epochLength := uint64(100)
+	bitmaps := make([][]byte, len(validators))
+	for i := range validators {
+		bitmaps[i] = make([]byte, epochLength/8+1)
+		for j := uint64(0); j < epochLength; j++ {
+			if j >= missed[i] {
+				bitmaps[i][j/8] |= 1 << (j % 8)
+			}
+		}
+	}
+	return SideLivenessPackage{
+		SideChainId:   sdk.ChainID(1),
+		Epoch:         epoch,
+		SideHeight:    epoch * epochLength,
+		SideTimestamp: uint64(timestamp.Unix()),
+		EpochLength:   epochLength,
+		Validators:    validators,
+		Bitmaps:       bitmaps,
+	}
+}
+
+func TestSideChainLiveness(t *testing.T) {
+	slashingParams := DefaultParams()
+	slashingParams.MaxEvidenceAge = 12 * 60 * 60 * time.Second
+	ctx, sideCtx, _, stakeKeeper, _, keeper := createSideTestInput(t, slashingParams)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainLiveness, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SideChainLiveness, 0)
+	app := NewSideLivenessApp(&keeper)
+
+	bondAmount := int64(10000e8)
+	sideConsAddrs := make([][]byte, 2)
+	for i := range sideConsAddrs {
+		sideConsAddrs[i] = createSideAddr(20)
+		msgCreateVal := newTestMsgCreateSideValidator(addrs[i], sideConsAddrs[i], createSideAddr(20), bondAmount)
+		got := stake.NewHandler(stakeKeeper, gov.Keeper{})(ctx, msgCreateVal)
+		require.True(t, got.IsOK(), "expected create validator msg to be ok, got: %v", got)
+	}
+	stake.EndBreatheBlock(ctx, stakeKeeper)
+
+	// the first validator missed 40 blocks in the first window, which is below the threshold
+	pack := newSideLivenessPackage(1, sideConsAddrs, []uint64{40, 0}, ctx.BlockHeader().Time)
+	bz, err := rlp.EncodeToBytes(pack)
+	require.NoError(t, err)
+	result := app.ExecuteSynPackage(ctx, bz, 0)
+	require.Nil(t, result.Err)
+
+	info, found := keeper.getValidatorSigningInfo(sideCtx, sideConsAddrs[0])
+	require.True(t, found)
+	require.EqualValues(t, 100, info.IndexOffset)
+	require.EqualValues(t, 40, info.MissedBlocksCounter)
+
+	// the epoch can not be handled twice
+	result = app.ExecuteSynPackage(ctx, bz, 0)
+	require.NotNil(t, result.Err)
+	require.EqualValues(t, CodeInvalidClaim, result.Err.Code())
+
+	// the first validator missed 60 blocks in the second window and is slashed for downtime
+	pack = newSideLivenessPackage(2, sideConsAddrs, []uint64{60, 10}, ctx.BlockHeader().Time)
+	bz, err = rlp.EncodeToBytes(pack)
+	require.NoError(t, err)
+	result = app.ExecuteSynPackage(ctx, bz, 0)
+	require.Nil(t, result.Err)
+
+	info, found = keeper.getValidatorSigningInfo(sideCtx, sideConsAddrs[0])
+	require.True(t, found)
+	require.EqualValues(t, 0, info.IndexOffset)
+	require.EqualValues(t, 0, info.MissedBlocksCounter)
+	require.EqualValues(t, ctx.BlockHeader().Time.Add(slashingParams.DowntimeUnbondDuration).Unix(), info.JailedUntil.Unix())
+
+	slashRecord, found := keeper.getSlashRecord(sideCtx, sideConsAddrs[0], Downtime, pack.SideHeight)
+	require.True(t, found)
+	require.EqualValues(t, slashingParams.DowntimeSlashAmount, slashRecord.SlashAmt)
+
+	validator, found := stakeKeeper.GetValidatorBySideConsAddr(sideCtx, sideConsAddrs[0])
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+	require.EqualValues(t, bondAmount-slashingParams.DowntimeSlashAmount, validator.Tokens.RawInt())
+
+	info, found = keeper.getValidatorSigningInfo(sideCtx, sideConsAddrs[1])
+	require.True(t, found)
+	require.EqualValues(t, 200, info.IndexOffset)
+	require.EqualValues(t, 10, info.MissedBlocksCounter)
+	validator, found = stakeKeeper.GetValidatorBySideConsAddr(sideCtx, sideConsAddrs[1])
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+
+	// the downtime reported by the side chain in the same slashing period is not slashed again
+	claim := SideSlashPackage{
+		SideAddr:      sideConsAddrs[0],
+		SideHeight:    150,
+		SideChainId:   sdk.ChainID(1),
+		SideTimestamp: uint64(ctx.BlockHeader().Time.Unix()),
+	}
+	sdkErr := keeper.slashingSideDowntime(ctx, &claim)
+	require.NotNil(t, sdkErr)
+	require.EqualValues(t, CodeDuplicateDowntimeClaim, sdkErr.Code())
+
+	// invalid packages
+	pack = newSideLivenessPackage(3, sideConsAddrs, []uint64{0, 0}, ctx.BlockHeader().Time)
+	pack.Bitmaps = pack.Bitmaps[:1]
+	bz, err = rlp.EncodeToBytes(pack)
+	require.NoError(t, err)
+	_, sdkErr = keeper.checkSideLivenessPackage(bz)
+	require.NotNil(t, sdkErr)
+
+	pack = newSideLivenessPackage(3, sideConsAddrs, []uint64{0, 0}, ctx.BlockHeader().Time)
+	pack.EpochLength = 200
+	bz, err = rlp.EncodeToBytes(pack)
+	require.NoError(t, err)
+	_, sdkErr = keeper.checkSideLivenessPackage(bz)
+	require.NotNil(t, sdkErr)
+}
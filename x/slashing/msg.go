@@ -18,6 +18,8 @@ const (
 	TypeMsgUnjail            = "unjail"
 	TypeMsgSideChainUnjail   = "side_chain_unjail"
 	TypeMsgBscSubmitEvidence = "bsc_submit_evidence"
+
+	TypeMsgClaimSlashCompensation = "claim_slash_compensation"
 )
 
 // verify interface at compile time
@@ -193,3 +195,53 @@ func (msg MsgBscSubmitEvidence) GetSigners() []sdk.AccAddress {
 func (msg MsgBscSubmitEvidence) GetInvolvedAddresses() []sdk.AccAddress {
 	return msg.GetSigners()
 }
+
+//__________________________________________________________________
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgClaimSlashCompensation{}
+
+// MsgClaimSlashCompensation - struct for claiming the compensation of a slash from the insurance pool
+type MsgClaimSlashCompensation struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	SideChainId   string         `json:"side_chain_id"`
+	EventId       uint64         `json:"event_id"` // id of the compensation event opened by the slash
+}
+
+func NewMsgClaimSlashCompensation(delegatorAddr sdk.AccAddress, sideChainId string, eventId uint64) MsgClaimSlashCompensation {
+	return MsgClaimSlashCompensation{
+		DelegatorAddr: delegatorAddr,
+		SideChainId:   sideChainId,
+		EventId:       eventId,
+	}
+}
+
+func (msg MsgClaimSlashCompensation) Route() string { return MsgRoute }
+func (msg MsgClaimSlashCompensation) Type() string  { return TypeMsgClaimSlashCompensation }
+func (msg MsgClaimSlashCompensation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgClaimSlashCompensation) GetSignBytes() []byte {
+	b := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgClaimSlashCompensation) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) != sdk.AddrLen {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("Expected delegator address length is %d, actual length is %d", sdk.AddrLen, len(msg.DelegatorAddr)))
+	}
+	if len(msg.SideChainId) == 0 || len(msg.SideChainId) > types.MaxSideChainIdLength {
+		return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("side chain id must be included and max length is %d bytes", types.MaxSideChainIdLength))
+	}
+	if msg.EventId == 0 {
+		return ErrInvalidInput(DefaultCodespace, "event id must be positive")
+	}
+	return nil
+}
+
+func (msg MsgClaimSlashCompensation) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), SlashInsurancePoolAddr)
+}
@@ -27,6 +27,9 @@ var (
 	KeyDowntimeSlashAmount      = []byte("DowntimeSlashAmount")
 	KeySubmitterReward          = []byte("SubmitterReward")
 	KeyDowntimeSlashFee         = []byte("DowntimeSlashFee")
+	KeyInsuranceShare           = []byte("InsuranceShare")
+	KeyCompensationWindow       = []byte("CompensationWindow")
+	KeyMaxCompensationPerEvent  = []byte("MaxCompensationPerEvent")
 )
 
 // ParamTypeTable for slashing module
@@ -48,6 +51,11 @@ type Params struct {
 	DowntimeSlashAmount      int64         `json:"downtime_slash_amount"`
 	SubmitterReward          int64         `json:"submitter_reward"`
 	DowntimeSlashFee         int64         `json:"downtime_slash_fee"`
+
+	// slash insurance, the insurance is disabled if InsuranceShare is zero
+	InsuranceShare          sdk.Dec       `json:"insurance_share"`            // share of the slashed amount and the fees paid into the insurance pool
+	CompensationWindow      time.Duration `json:"compensation_window"`        // duration in which the delegators can claim compensations
+	MaxCompensationPerEvent int64         `json:"max_compensation_per_event"` // upper bound of the compensations of a slash
 }
 
 func (p *Params) GetParamAttribute() (string, bool) {
@@ -80,6 +88,28 @@ func (p *Params) UpdateCheck() error {
 	if p.DowntimeSlashFee < 1e8 || p.DowntimeSlashFee > 1000e8 {
 		return fmt.Errorf("the downtime_slash_fee should be in range 1e8 to 1000e8")
 	}
+	// the signing window and threshold are used by the liveness tracker of side chain validators
+	if sdk.IsUpgrade(sdk.SideChainLiveness) {
+		if p.SignedBlocksWindow < 100 || p.SignedBlocksWindow > 100000 {
+			return fmt.Errorf("the signed_blocks_window should be in range 100 to 100000")
+		}
+		if p.MinSignedPerWindow.LT(sdk.ZeroDec()) || p.MinSignedPerWindow.GT(sdk.OneDec()) {
+			return fmt.Errorf("the min_signed_per_window should be in range 0 to 1")
+		}
+	}
+	if sdk.IsUpgrade(sdk.SlashInsurance) {
+		if p.InsuranceShare.LT(sdk.ZeroDec()) || p.InsuranceShare.GT(sdk.OneDec()) {
+			return fmt.Errorf("the insurance_share should be in range 0 to 1")
+		}
+		if p.InsuranceShare.GT(sdk.ZeroDec()) {
+			if p.CompensationWindow < 1*time.Hour || p.CompensationWindow > 100*24*time.Hour {
+				return fmt.Errorf("the compensation_window should be in range 1 hour to 100 day")
+			}
+			if p.MaxCompensationPerEvent <= 0 {
+				return fmt.Errorf("the max_compensation_per_event should be positive")
+			}
+		}
+	}
 	return nil
 }
 
@@ -98,6 +128,9 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyDowntimeSlashAmount, &p.DowntimeSlashAmount},
 		{KeySubmitterReward, &p.SubmitterReward},
 		{KeyDowntimeSlashFee, &p.DowntimeSlashFee},
+		{KeyInsuranceShare, &p.InsuranceShare},
+		{KeyCompensationWindow, &p.CompensationWindow},
+		{KeyMaxCompensationPerEvent, &p.MaxCompensationPerEvent},
 	}
 }
 
@@ -206,6 +239,22 @@ func (k Keeper) DowntimeSlashFee(ctx sdk.Context) (downtimeSlashFee int64) {
 	return
 }
 
+// the params of slash insurance are optional, the insurance is disabled if they are not set
+func (k Keeper) InsuranceShare(ctx sdk.Context) (share sdk.Dec) {
+	k.paramspace.GetIfExists(ctx, KeyInsuranceShare, &share)
+	return
+}
+
+func (k Keeper) CompensationWindow(ctx sdk.Context) (window time.Duration) {
+	k.paramspace.GetIfExists(ctx, KeyCompensationWindow, &window)
+	return
+}
+
+func (k Keeper) MaxCompensationPerEvent(ctx sdk.Context) (maxCompensation int64) {
+	k.paramspace.GetIfExists(ctx, KeyMaxCompensationPerEvent, &maxCompensation)
+	return
+}
+
 // set the params
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	k.paramspace.SetParamSet(ctx, &params)
@@ -17,6 +17,7 @@ type SideSlashEvent struct {
 	JailUtil               time.Time
 	SlashAmt               int64
 	ToFeePool              int64
+	ToInsurancePool        int64
 	SideChainId            string
 	Submitter              sdk.AccAddress
 	SubmitterReward        int64
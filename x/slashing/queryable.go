@@ -12,6 +12,7 @@ import (
 const (
 	QueryConsAddrSlashRecords     = "consAddrSlashHistories"
 	QueryConsAddrTypeSlashRecords = "consAddrTypeSlashHistories"
+	QueryCompensationEvents       = "compensationEvents"
 )
 
 // creates a querier for staking REST endpoints
@@ -32,6 +33,13 @@ func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
 				return res, err
 			}
 			return queryConsAddrTypeSlashRecords(ctx, k, param)
+		case QueryCompensationEvents:
+			param := new(QueryCompensationEventsParams)
+			ctx, err = RequestPrepare(ctx, k, req, param)
+			if err != nil {
+				return res, err
+			}
+			return queryCompensationEvents(ctx, k, param)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
 		}
@@ -64,6 +72,11 @@ type QueryConsAddrTypeParams struct {
 	InfractionType byte
 }
 
+type QueryCompensationEventsParams struct {
+	BaseParams
+	Validator sdk.ValAddress // optional, query the compensation events of all validators if empty
+}
+
 func RequestPrepare(ctx sdk.Context, k Keeper, req abci.RequestQuery, p types.SideChainIder) (newCtx sdk.Context, err sdk.Error) {
 	if req.Data == nil || len(req.Data) == 0 {
 		return ctx, nil
@@ -118,3 +131,17 @@ func queryConsAddrTypeSlashRecords(ctx sdk.Context, k Keeper, params *QueryConsA
 
 	return res, nil
 }
+
+func queryCompensationEvents(ctx sdk.Context, k Keeper, params *QueryCompensationEventsParams) (res []byte, err sdk.Error) {
+	events := k.getCompensationEvents(ctx, params.Validator)
+	if len(events) == 0 {
+		return
+	}
+
+	res, resErr := codec.MarshalJSONIndent(k.cdc, events)
+	if resErr != nil {
+		return res, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", resErr.Error()))
+	}
+
+	return res, nil
+}
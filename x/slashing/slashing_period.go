@@ -51,6 +51,33 @@ func (k Keeper) getValidatorSlashingPeriodForHeight(ctx sdk.Context, address sdk
 	return
 }
 
+// Stored by validator Tendermint address (not operator address)
+// This function checks whether there is a slashing period starting at or before a particular height.
+func (k Keeper) hasValidatorSlashingPeriodForHeight(ctx sdk.Context, address sdk.ConsAddress, height int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	start := GetValidatorSlashingPeriodPrefix(address)
+	end := sdk.PrefixEndBytes(GetValidatorSlashingPeriodKey(address, height))
+	iterator := store.ReverseIterator(start, end)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// Side chain validators are slashed by a fixed amount rather than a fraction, so the slashing period is
+// marked as fully slashed once the validator is slashed for downtime. This makes sure a side chain validator
+// is slashed for downtime at most once in a slashing period, no matter it is reported by the side chain or
+// detected by the liveness tracker. Returns false if the validator has already been slashed in the period.
+func (k Keeper) capSideDowntimeBySlashingPeriod(sideCtx sdk.Context, sideConsAddr []byte) bool {
+	address := sdk.ConsAddress(sideConsAddr)
+	height := sideCtx.BlockHeight()
+	if !k.hasValidatorSlashingPeriodForHeight(sideCtx, address, height) {
+		// validators bonded before the liveness tracker is enabled have no slashing period yet
+		slashingPeriod := NewValidatorSlashingPeriod(height, 0, sdk.ZeroDec())
+		slashingPeriod.ValidatorAddr = address
+		k.addOrUpdateValidatorSlashingPeriod(sideCtx, slashingPeriod)
+	}
+	return k.capBySlashingPeriod(sideCtx, address, sdk.OneDec(), height).GT(sdk.ZeroDec())
+}
+
 // Stored by validator Tendermint address (not operator address)
 // This function sets a validator slashing period for a particular validator,
 // start height, end height, and current slashed-so-far total, or updates
@@ -73,6 +73,15 @@ func AddCommands(root *cobra.Command, cdc *codec.Codec) {
 			GetCmdQueryCrossStakeInfoByBscAddress(cdc),
 		)...,
 	)
+	stakingCmd.AddCommand(client.LineBreak)
+	stakingCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryStakeSnapshots(cdc),
+			GetCmdQueryValidatorSnapshotHistory(cdc),
+			GetCmdQueryElectedValidatorSets(cdc),
+			GetCmdQueryStakeChurns(cdc),
+		)...,
+	)
 
 	root.AddCommand(stakingCmd)
 }
@@ -46,6 +46,9 @@ const (
 	FlagSideVoteAddr = "side-vote-addr"
 	FlagBLSWalletDir = "bls-wallet"
 	FlagBLSPassword  = "bls-password"
+
+	FlagPage  = "page"
+	FlagLimit = "limit"
 )
 
 // common flagsets to add to various functions
@@ -65,6 +68,7 @@ var (
 	fsSideChainId           = flag.NewFlagSet("", flag.ContinueOnError)
 	fsSmartChainValidator   = flag.NewFlagSet("", flag.ContinueOnError)
 	fsSmartChainBeneficiary = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPage                  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -103,4 +107,6 @@ func init() {
 	fsSideChainId.String(FlagSideChainId, "", "Chain-id of the side chain the validator belongs to")
 	fsSmartChainValidator.String(FlagAddressSmartChainValidator, "", "Smart chain operator address of the validator")
 	fsSmartChainBeneficiary.String(FlagAddressSmartChainBeneficiary, "", "Smart chain address of the delegation's beneficiary")
+	fsPage.Int(FlagPage, 1, "Page number of the results, starts from 1")
+	fsPage.Int(FlagLimit, 30, "Number of results per page, at most 100")
 }
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// GetCmdQueryStakeSnapshots implements the command to query the stake snapshots taken in breathe blocks.
+func GetCmdQueryStakeSnapshots(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Query the stake snapshots taken in breathe blocks, the newest comes first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := stake.QuerySnapshotsParams{
+				BaseParams: stake.NewBaseParams(viper.GetString(FlagSideChainId)),
+				PageParams: getPageParams(),
+			}
+
+			response, err := querySnapshots(cliCtx, stake.QueryStakeSnapshots, params)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				var snapshots []stake.StakeSnapshot
+				if err = cdc.UnmarshalJSON(response, &snapshots); err != nil {
+					return err
+				}
+				for _, snapshot := range snapshots {
+					fmt.Printf("Height: %d\n", snapshot.Height)
+					for i, val := range snapshot.Validators {
+						fmt.Printf("  %d. %s (%s), Tokens: %s, Accumulated Stake: %s, Commission Rate: %s, Delegator Count: %d\n",
+							i+1, val.OperatorAddr, val.Moniker, val.Tokens, val.AccumulatedStake, val.CommissionRate, val.DelegatorCount)
+					}
+				}
+			case "json":
+				fmt.Println(string(response))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetCmdQueryValidatorSnapshotHistory implements the command to query the history of a validator over the stake snapshots.
+func GetCmdQueryValidatorSnapshotHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-snapshots [operator-addr]",
+		Short: "Query the voting power, commission and delegator count of a validator over the stake snapshots",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := stake.QueryValidatorSnapshotsParams{
+				BaseParams:    stake.NewBaseParams(viper.GetString(FlagSideChainId)),
+				PageParams:    getPageParams(),
+				ValidatorAddr: valAddr,
+			}
+
+			response, err := querySnapshots(cliCtx, stake.QueryValidatorSnapshotHistory, params)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				var records []stake.ValidatorSnapshotRecord
+				if err = cdc.UnmarshalJSON(response, &records); err != nil {
+					return err
+				}
+				for _, record := range records {
+					fmt.Println(record.HumanReadableString())
+				}
+			case "json":
+				fmt.Println(string(response))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetCmdQueryElectedValidatorSets implements the command to query the elected validator set of every stake snapshot.
+func GetCmdQueryElectedValidatorSets(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "elected-validators",
+		Short: "Query the validators elected in every stake snapshot, the newest comes first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := stake.QuerySnapshotsParams{
+				BaseParams: stake.NewBaseParams(viper.GetString(FlagSideChainId)),
+				PageParams: getPageParams(),
+			}
+
+			response, err := querySnapshots(cliCtx, stake.QueryElectedValidatorSets, params)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				var sets []stake.ElectedValidatorSet
+				if err = cdc.UnmarshalJSON(response, &sets); err != nil {
+					return err
+				}
+				for _, set := range sets {
+					fmt.Println(set.HumanReadableString())
+					fmt.Println()
+				}
+			case "json":
+				fmt.Println(string(response))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+// GetCmdQueryStakeChurns implements the command to query the churn of the elected validator set between snapshots.
+func GetCmdQueryStakeChurns(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-churn",
+		Short: "Query the validators joined and left the elected set between consecutive stake snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := stake.QuerySnapshotsParams{
+				BaseParams: stake.NewBaseParams(viper.GetString(FlagSideChainId)),
+				PageParams: getPageParams(),
+			}
+
+			response, err := querySnapshots(cliCtx, stake.QueryStakeChurns, params)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				var churns []stake.StakeChurn
+				if err = cdc.UnmarshalJSON(response, &churns); err != nil {
+					return err
+				}
+				for _, churn := range churns {
+					fmt.Println(churn.HumanReadableString())
+					fmt.Println()
+				}
+			case "json":
+				fmt.Println(string(response))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsSideChainId)
+	cmd.Flags().AddFlagSet(fsPage)
+	return cmd
+}
+
+func getPageParams() stake.PageParams {
+	return stake.PageParams{
+		Page:  viper.GetInt(FlagPage),
+		Limit: viper.GetInt(FlagLimit),
+	}
+}
+
+func querySnapshots(cliCtx context.CLIContext, endpoint string, params interface{}) ([]byte, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := cliCtx.QueryWithData(fmt.Sprintf("custom/stake/%s", endpoint), bz)
+	if err != nil {
+		return nil, err
+	} else if len(response) == 0 {
+		return nil, fmt.Errorf("No stake snapshots found ")
+	}
+	return response, nil
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 
@@ -9,12 +10,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 	"github.com/gorilla/mux"
 )
 
 const storeName = "stake"
 
+// REST query parameters of the stake snapshot queries
+const (
+	RestSideChainId = "side_chain_id"
+	RestPage        = "page"
+	RestLimit       = "limit"
+)
+
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 
 	// Get all delegations from a delegator
@@ -101,6 +110,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		paramsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Get the stake snapshots taken in breathe blocks
+	r.HandleFunc(
+		"/stake/snapshots",
+		stakeSnapshotsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the elected validator set of every stake snapshot
+	r.HandleFunc(
+		"/stake/snapshots/elected_validators",
+		electedValidatorSetsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the churn of the elected validator set between stake snapshots
+	r.HandleFunc(
+		"/stake/snapshots/churns",
+		stakeChurnsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	// Get the history of a validator over the stake snapshots
+	r.HandleFunc(
+		"/stake/validators/{validatorAddr}/snapshots",
+		validatorSnapshotHistoryHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query a delegator delegations
@@ -256,3 +289,53 @@ func paramsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFu
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// HTTP request handler to query the stake snapshots
+func stakeSnapshotsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return querySnapshots(cliCtx, cdc, "custom/stake/stakeSnapshots")
+}
+
+// HTTP request handler to query the elected validator sets of the stake snapshots
+func electedValidatorSetsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return querySnapshots(cliCtx, cdc, "custom/stake/electedValidatorSets")
+}
+
+// HTTP request handler to query the churn of the elected validator set
+func stakeChurnsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return querySnapshots(cliCtx, cdc, "custom/stake/stakeChurns")
+}
+
+// HTTP request handler to query the history of a validator over the stake snapshots
+func validatorSnapshotHistoryHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pageParams, ok := parsePageParams(w, r)
+		if !ok {
+			return
+		}
+
+		params := stake.QueryValidatorSnapshotsParams{
+			BaseParams:    stake.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			PageParams:    pageParams,
+			ValidatorAddr: validatorAddr,
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/stake/validatorSnapshotHistory", bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
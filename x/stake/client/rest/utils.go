@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -141,3 +142,49 @@ func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func querySnapshots(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageParams, ok := parsePageParams(w, r)
+		if !ok {
+			return
+		}
+
+		params := stake.QuerySnapshotsParams{
+			BaseParams: stake.NewBaseParams(r.URL.Query().Get(RestSideChainId)),
+			PageParams: pageParams,
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// parses the optional page and limit query parameters, zero values fall back to the defaults of the querier
+func parsePageParams(w http.ResponseWriter, r *http.Request) (params stake.PageParams, ok bool) {
+	if pageStr := r.URL.Query().Get(RestPage); pageStr != "" {
+		page, ok := utils.ParseInt64OrReturnBadRequest(w, pageStr)
+		if !ok {
+			return params, false
+		}
+		params.Page = int(page)
+	}
+	if limitStr := r.URL.Query().Get(RestLimit); limitStr != "" {
+		limit, ok := utils.ParseInt64OrReturnBadRequest(w, limitStr)
+		if !ok {
+			return params, false
+		}
+		params.Limit = int(limit)
+	}
+	return params, true
+}
@@ -547,15 +547,8 @@ func (k Keeper) transferBondTokens(ctx sdk.Context, from, to sdk.AccAddress, bon
 	if balance := balanceCoins.AmountOf(bondAmt.Denom); balance < bondAmt.Amount {
 		return sdk.ErrInsufficientCoins(fmt.Sprintf("No enough balance to delegate, token: %s, balance: %d, amount: %d", bondAmt.Denom, balance, bondAmt.Amount))
 	}
-	delegationAccBalance := k.BankKeeper.GetCoins(ctx, to)
-	if err := k.BankKeeper.SetCoins(ctx, from, balanceCoins.Minus(sdk.Coins{bondAmt})); err != nil {
-		return err
-	}
-	if err := k.BankKeeper.SetCoins(ctx, to, delegationAccBalance.Plus(sdk.Coins{bondAmt})); err != nil {
-		return err
-	}
-
-	return nil
+	// the locked coins of vesting accounts can be delegated, the delegation is tracked by the bank keeper
+	return k.BankKeeper.DelegateCoins(ctx, from, to, sdk.Coins{bondAmt})
 }
 
 // unbond the the delegation return
@@ -711,7 +704,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		return ubd, sdk.Events{}, types.ErrNoUnbondingDelegation(k.Codespace())
 	}
 
-	_, err := k.BankKeeper.SendCoins(ctx, DelegationAccAddr, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
+	// the undelegated coins go back to the locked balance of vesting accounts
+	err := k.BankKeeper.UndelegateCoins(ctx, DelegationAccAddr, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
 	if err != nil {
 		return ubd, sdk.Events{}, err
 	}
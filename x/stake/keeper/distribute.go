@@ -24,6 +24,7 @@ const (
 )
 
 func (k Keeper) Distribute(ctx sdk.Context, sideChainId string) {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward)
 
 	// The rewards collected yesterday is decided by the validators the day before yesterday.
 	// So this distribution is for the validators bonded 2 days ago
@@ -38,6 +39,7 @@ func (k Keeper) Distribute(ctx sdk.Context, sideChainId string) {
 	for _, validator := range validators {
 		distAccCoins := k.BankKeeper.GetCoins(ctx, validator.DistributionAddr)
 		totalReward := distAccCoins.AmountOf(bondDenom)
+		totalReward -= k.payFeeInsurance(ctx, validator, totalReward)
 		totalRewardDec := sdk.ZeroDec()
 		commission := sdk.ZeroDec()
 		rewards := make([]types.PreReward, 0)
@@ -49,12 +51,14 @@ func (k Keeper) Distribute(ctx sdk.Context, sideChainId string) {
 			totalRewardDec = sdk.NewDec(totalReward)
 			commission = totalRewardDec.Mul(validator.Commission.Rate)
 			remainReward := totalRewardDec.Sub(commission)
+			// the carried over rewards are settled with the Distribution account before its balance is removed
+			rewards = k.allocateRewards(ctx, validator, simDelsToSharers(delegations), remainReward)
 			// remove all balance of bondDenom from Distribution account
-			distAccCoins = distAccCoins.Minus(sdk.Coins{sdk.NewCoin(bondDenom, totalReward)})
+			distAccCoins = k.BankKeeper.GetCoins(ctx, validator.DistributionAddr)
+			distAccCoins = distAccCoins.Minus(sdk.Coins{sdk.NewCoin(bondDenom, distAccCoins.AmountOf(bondDenom))})
 			if err := k.BankKeeper.SetCoins(ctx, validator.DistributionAddr, distAccCoins); err != nil {
 				panic(err)
 			}
-			rewards = allocate(simDelsToSharers(delegations), remainReward)
 			if commission.RawInt() > 0 { // assign rewards to self-delegator
 				if _, _, err := k.BankKeeper.AddCoins(ctx, validator.GetFeeAddr(), sdk.Coins{sdk.NewCoin(bondDenom, commission.RawInt())}); err != nil {
 					panic(err)
@@ -119,6 +123,7 @@ func (k Keeper) Distribute(ctx sdk.Context, sideChainId string) {
 // DistributeInBreathBlock will 1) calculate rewards as Distribute does, 2) transfer commissions to all validators, and
 // 3) save delegator's rewards to reward store for later distribution.
 func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) sdk.Events {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward)
 	ctx.Logger().Info("FeeCalculation", "currentHeight", ctx.BlockHeight(), "sideChainId", sideChainId)
 	// if there are left reward distribution batches in the previous day, will distribute all of them here
 	// this is only a safe guard to make sure that all the previous day's rewards are distributed
@@ -187,6 +192,11 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) sdk
 				}
 			}
 		}
+		// a share of the fees is paid into the slash insurance pool
+		if insurance := k.payFeeInsurance(ctx, validator, totalReward); insurance > 0 {
+			totalRewardDec = totalRewardDec.Sub(sdk.NewDec(insurance))
+			totalReward = totalRewardDec.RawInt()
+		}
 		commission := sdk.ZeroDec()
 		rewards := make([]types.PreReward, 0)
 		crossStake := make(map[string]bool)
@@ -216,8 +226,9 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) sdk
 			// calculate rewards for delegators
 			remainReward := totalRewardDec.Sub(commission)
 			ctx.Logger().Info("FeeCalculation commission", "rate", validator.Commission.Rate, "commission", commission, "remainReward", remainReward, "delegations", delegations)
-			rewards = allocate(simDelsToSharers(delegations), remainReward)
+			rewards = k.allocateRewards(ctx, validator, simDelsToSharers(delegations), remainReward)
 			for i := range rewards {
+				rewardSum += rewards[i].Amount
 				// previous tokens calculation is in `node` repo, move it to here
 				tokens, err := sdk.MulQuoDec(validator.GetTokens(), rewards[i].Shares, validator.GetDelegatorShares())
 				if err != nil {
@@ -243,7 +254,6 @@ func (k Keeper) DistributeInBreathBlock(ctx sdk.Context, sideChainId string) sdk
 			if k.AddrPool != nil {
 				k.AddrPool.AddAddrs(changedAddrs[:])
 			}
-			rewardSum += remainReward.RawInt()
 		}
 
 		if ctx.IsDeliverTx() && k.PbsbServer != nil {
@@ -311,6 +321,7 @@ func (k Keeper) DistributeInBlock(ctx sdk.Context, sideChainId string) sdk.Event
 
 // distributeSingleBatch will distribute an single batch of rewards if there is any
 func (k Keeper) distributeSingleBatch(ctx sdk.Context, sideChainId string) sdk.Events {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeReward)
 	// get batch rewards and validator <-> distribution address mapping
 	rewards, key := k.getNextBatchRewards(ctx)
 	valDistAddrs, found := k.getRewardValDistAddrs(ctx)
@@ -366,10 +377,10 @@ func (k Keeper) distributeSingleBatch(ctx sdk.Context, sideChainId string) sdk.E
 	}
 
 	// cross distribute reward
+	threshold := k.crossDistributeRewardThreshold(ctx)
 	for _, addr := range crossStakeAddrSet {
 		balance := k.BankKeeper.GetCoins(ctx, addr).AmountOf(bondDenom)
-		if balance >= types.MinRewardThreshold ||
-			(sdk.IsUpgrade(sdk.SecondSunsetFork) && balance >= types.MinRewardThresholdAfterSecondSunsetFork) {
+		if balance >= threshold {
 			event, err := crossDistributeReward(k, ctx, addr, balance)
 			if err != nil {
 				panic(err)
@@ -437,6 +448,50 @@ func removeValidatorsAndDelegationsAtHeight(height int64, k Keeper, ctx sdk.Cont
 	k.RemoveValidatorsByHeight(ctx, height)
 }
 
+// payFeeInsurance pays the insurance share of the fees collected by the validator into the slash insurance pool,
+// and returns the amount paid
+func (k Keeper) payFeeInsurance(ctx sdk.Context, validator types.Validator, totalReward int64) int64 {
+	if k.insurance == nil || !sdk.IsUpgrade(sdk.SlashInsurance) || totalReward <= 0 {
+		return 0
+	}
+	share := k.insurance.InsuranceShare(ctx)
+	if share.LTE(sdk.ZeroDec()) {
+		return 0
+	}
+	insurance := sdk.MinInt64(sdk.NewDec(totalReward).Mul(share).RawInt(), totalReward)
+	if insurance <= 0 {
+		return 0
+	}
+	if _, err := k.BankKeeper.SendCoins(ctx, validator.DistributionAddr, k.insurance.InsurancePoolAddr(),
+		sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), insurance)}); err != nil {
+		panic(err)
+	}
+	return insurance
+}
+
+// crossDistributeRewardThreshold returns the min balance of a reward CAoB to be transferred to BSC. The min payout
+// of the reward strategy is used once the rewards are allocated by the min payout strategy, as long as it covers
+// the relay fee.
+func (k Keeper) crossDistributeRewardThreshold(ctx sdk.Context) int64 {
+	threshold := types.MinRewardThreshold
+	if sdk.IsUpgrade(sdk.SecondSunsetFork) {
+		threshold = types.MinRewardThresholdAfterSecondSunsetFork
+	}
+	if !sdk.IsUpgrade(sdk.ConfigurableRewardStrategy) || k.RewardStrategy(ctx) != types.RewardStrategyMinPayout {
+		return threshold
+	}
+	minPayout := k.RewardMinPayout(ctx)
+	if relayFeeCalc := fees.GetCalculator(types.CrossDistributeRewardRelayFee); relayFeeCalc != nil {
+		if relayFee := relayFeeCalc(nil).Tokens.AmountOf(k.BondDenom(ctx)); minPayout <= relayFee {
+			minPayout = relayFee + 1
+		}
+	}
+	if minPayout < threshold {
+		return minPayout
+	}
+	return threshold
+}
+
 func crossDistributeReward(k Keeper, ctx sdk.Context, rewardCAoB sdk.AccAddress, amount int64) (sdk.Events, error) {
 	denom := k.BondDenom(ctx)
 	relayFeeCalc := fees.GetCalculator(types.CrossDistributeRewardRelayFee)
@@ -191,6 +191,38 @@ func TestDistributeInBreathBlock(t *testing.T) {
 	require.EqualValues(t, len(k.AddrPool.TxRelatedAddrs()), 21+21) // validator fee address + distribution address
 }
 
+type testFeeInsurance struct {
+	share sdk.Dec
+	pool  sdk.AccAddress
+}
+
+func (i testFeeInsurance) InsuranceShare(sdk.Context) sdk.Dec { return i.share }
+func (i testFeeInsurance) InsurancePoolAddr() sdk.AccAddress  { return i.pool }
+
+func TestDistributeFeeInsurance(t *testing.T) {
+	ctx, am, k, _, validators, _, rewards, _ := prepare(t)
+	bondDenom := k.BondDenom(ctx)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.SlashInsurance, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.SlashInsurance, 0)
+	pool := CreateTestAddr()
+	k.SetFeeInsurance(testFeeInsurance{share: sdk.NewDecWithPrec(1, 1), pool: pool})
+
+	k.DistributeInBreathBlock(ctx, "")
+
+	// a tenth of the fees is paid into the pool, the commissions are taken from the rest
+	var insurance int64
+	for i, validator := range validators {
+		paid := sdk.NewDec(rewards[i]).Mul(sdk.NewDecWithPrec(1, 1)).RawInt()
+		insurance += paid
+		remaining := sdk.NewDec(rewards[i] - paid)
+		valBalance := am.GetAccount(ctx, validator.FeeAddr).GetCoins().AmountOf(bondDenom)
+		require.Equal(t, remaining.Mul(validator.Commission.Rate).RawInt(), valBalance)
+		distBalance := am.GetAccount(ctx, validator.DistributionAddr).GetCoins().AmountOf(bondDenom)
+		require.Equal(t, rewards[i]-paid, valBalance+distBalance)
+	}
+	require.Equal(t, insurance, am.GetAccount(ctx, pool).GetCoins().AmountOf(bondDenom))
+}
+
 func TestDistributeInBlock(t *testing.T) {
 	ctx, am, k, _, validators, _, _, _ := prepare(t)
 	bondDenom := k.BondDenom(ctx)
@@ -40,6 +40,15 @@ type Keeper struct {
 	DestChainName string
 
 	PbsbServer *pubsub.Server
+
+	// optional, a share of the fees is paid into the insurance pool if set
+	insurance FeeInsurance
+}
+
+// FeeInsurance is the slash insurance pool taking a share of the fees distributed to the validators
+type FeeInsurance interface {
+	InsuranceShare(ctx sdk.Context) sdk.Dec
+	InsurancePoolAddr() sdk.AccAddress
 }
 
 func NewKeeper(cdc *codec.Codec, key, rewardKey, tkey sdk.StoreKey, ck bank.Keeper, addrPool *sdk.Pool,
@@ -77,6 +86,10 @@ func (k *Keeper) SetupForSideChain(scKeeper *sidechain.Keeper, ibcKeeper *ibc.Ke
 	k.initIbc()
 }
 
+func (k *Keeper) SetFeeInsurance(insurance FeeInsurance) {
+	k.insurance = insurance
+}
+
 func (k *Keeper) SetPbsbServer(server *pubsub.Server) {
 	k.PbsbServer = server
 }
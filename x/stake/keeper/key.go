@@ -52,6 +52,7 @@ var (
 	RewardValDistAddrKey = []byte{0x02} // key for rewards' validator <-> distribution address mapping
 
 	AutoUndelegateIndexKey = []byte{0x61} // prefix for each key for an auto undelegate, by validator operator
+	RewardCarryOverKey     = []byte{0x62} // prefix for each key for a carried over reward, by validator operator and delegator
 )
 
 const (
@@ -353,3 +354,8 @@ func GetAutoUnDelegateByValIndexKey(valAddr sdk.ValAddress) []byte {
 func GetAutoUnDelegateIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
 	return append(GetAutoUnDelegateByValIndexKey(valAddr), delAddr.Bytes()...)
 }
+
+// gets the key for the carried over reward of a delegator, stored by validator-index
+func GetRewardCarryOverKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(append(RewardCarryOverKey, valAddr.Bytes()...), delAddr.Bytes()...)
+}
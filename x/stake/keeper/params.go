@@ -19,6 +19,7 @@ var (
 	FeeCollectorAddr       = sdk.AccAddress(crypto.AddressHash([]byte("FeeCollector")))
 	DelegationAccAddr      = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainStakeDelegation")))
 	FeeForAllBcValsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainStakeFeeForAllBcVals")))
+	RewardCarryOverAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("BinanceChainStakeRewardCarryOver")))
 )
 
 // ParamTable for stake module
@@ -79,6 +80,21 @@ func (k Keeper) FeeFromBscToBcRatio(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+func (k Keeper) RewardStrategy(ctx sdk.Context) (res string) {
+	k.paramstore.GetIfExists(ctx, types.KeyRewardStrategy, &res)
+	return
+}
+
+func (k Keeper) RewardMinPayout(ctx sdk.Context) (res int64) {
+	k.paramstore.GetIfExists(ctx, types.KeyRewardMinPayout, &res)
+	return
+}
+
+func (k Keeper) RewardStakeCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.GetIfExists(ctx, types.KeyRewardStakeCap, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.UnbondingTime = k.UnbondingTime(ctx)
@@ -91,6 +107,9 @@ func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.BonusProposerRewardRatio = k.BonusProposerRewardRatio(ctx)
 	res.MaxStakeSnapshots = k.MaxStakeSnapshots(ctx)
 	res.FeeFromBscToBcRatio = k.FeeFromBscToBcRatio(ctx)
+	res.RewardStrategy = k.RewardStrategy(ctx)
+	res.RewardMinPayout = k.RewardMinPayout(ctx)
+	res.RewardStakeCap = k.RewardStakeCap(ctx)
 	return
 }
 
@@ -185,4 +204,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 		k.paramstore.Set(ctx, types.KeyBonusProposerRewardRatio, params.BonusProposerRewardRatio)
 		k.paramstore.Set(ctx, types.KeyFeeFromBscToBcRatio, params.FeeFromBscToBcRatio)
 	}
+	if sdk.IsUpgrade(sdk.ConfigurableRewardStrategy) {
+		k.paramstore.Set(ctx, types.KeyRewardStrategy, params.RewardStrategy)
+		k.paramstore.Set(ctx, types.KeyRewardMinPayout, params.RewardMinPayout)
+		k.paramstore.Set(ctx, types.KeyRewardStakeCap, params.RewardStakeCap)
+	}
 }
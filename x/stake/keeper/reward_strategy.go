@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// RewardStrategy decides how the rewards of a validator are allocated to its delegators. The sum of the allocated
+// rewards may differ from the total rewards if the strategy carries over rewards between distributions, the
+// difference is settled with RewardCarryOverAccAddr.
+type RewardStrategy interface {
+	Allocate(ctx sdk.Context, k Keeper, valAddr sdk.ValAddress, sharers []types.Sharer, totalRewards sdk.Dec) []types.PreReward
+	// CheckAllocation verifies the rewards allocated by Allocate, ctx is the context before the allocation and
+	// allocatedCtx is the context after the allocation. It is used as the invariant of the strategy.
+	CheckAllocation(ctx, allocatedCtx sdk.Context, k Keeper, valAddr sdk.ValAddress, sharers []types.Sharer,
+		totalRewards sdk.Dec, rewards []types.PreReward) error
+}
+
+var rewardStrategies = map[string]RewardStrategy{
+	types.RewardStrategyProRata:   proRataStrategy{},
+	types.RewardStrategyMinPayout: minPayoutStrategy{},
+	types.RewardStrategyStakeCap:  stakeCapStrategy{},
+}
+
+// GetRewardStrategy returns the reward strategy by name, the pro rata strategy is returned for an unknown name
+func GetRewardStrategy(name string) RewardStrategy {
+	if strategy, ok := rewardStrategies[name]; ok {
+		return strategy
+	}
+	return proRataStrategy{}
+}
+
+// RewardStrategyNames returns the names of all the reward strategies in order
+func RewardStrategyNames() []string {
+	names := make([]string, 0, len(rewardStrategies))
+	for name := range rewardStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// allocateRewards allocates the rewards of a validator with the reward strategy of the chain, pays out the carried
+// over rewards that would never be allocated again, and settles the carried over rewards between the distribution
+// address of the validator and RewardCarryOverAccAddr.
+func (k Keeper) allocateRewards(ctx sdk.Context, validator types.Validator, sharers []types.Sharer, totalRewards sdk.Dec) []types.PreReward {
+	if !sdk.IsUpgrade(sdk.ConfigurableRewardStrategy) {
+		return allocate(sharers, totalRewards)
+	}
+
+	strategy := GetRewardStrategy(k.RewardStrategy(ctx))
+	rewards := strategy.Allocate(ctx, k, validator.OperatorAddr, sharers, totalRewards)
+	_, carryOver := strategy.(minPayoutStrategy)
+	rewards = k.payoutRewardCarryOvers(ctx, validator.OperatorAddr, sharers, rewards, carryOver)
+	var allocated int64
+	for _, reward := range rewards {
+		allocated += reward.Amount
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if carried := totalRewards.RawInt() - allocated; carried > 0 {
+		if _, err := k.BankKeeper.SendCoins(ctx, validator.DistributionAddr, RewardCarryOverAccAddr, sdk.Coins{sdk.NewCoin(bondDenom, carried)}); err != nil {
+			panic(err)
+		}
+	} else if carried < 0 {
+		if _, err := k.BankKeeper.SendCoins(ctx, RewardCarryOverAccAddr, validator.DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, -carried)}); err != nil {
+			panic(err)
+		}
+	}
+	return rewards
+}
+
+func checkRewardsSum(rewards []types.PreReward, expected int64) error {
+	var sum int64
+	for _, reward := range rewards {
+		if reward.Amount < 0 {
+			return fmt.Errorf("negative reward %d of delegator %s", reward.Amount, reward.AccAddr)
+		}
+		sum += reward.Amount
+	}
+	if sum != expected {
+		return fmt.Errorf("the sum of the rewards %d is not equal to %d", sum, expected)
+	}
+	return nil
+}
+
+//___________________________________________________________________________
+
+// proRataStrategy allocates the rewards in proportion to the shares
+type proRataStrategy struct{}
+
+func (proRataStrategy) Allocate(_ sdk.Context, _ Keeper, _ sdk.ValAddress, sharers []types.Sharer, totalRewards sdk.Dec) []types.PreReward {
+	return allocate(sharers, totalRewards)
+}
+
+func (proRataStrategy) CheckAllocation(_, _ sdk.Context, _ Keeper, _ sdk.ValAddress, sharers []types.Sharer,
+	totalRewards sdk.Dec, rewards []types.PreReward) error {
+	if len(rewards) != len(sharers) {
+		return fmt.Errorf("expected %d rewards, got %d", len(sharers), len(rewards))
+	}
+	if err := checkRewardsSum(rewards, totalRewards.RawInt()); err != nil {
+		return err
+	}
+	return checkProRata(rewards, totalRewards)
+}
+
+// every reward should be the rounding of its pro rata amount
+func checkProRata(rewards []types.PreReward, totalRewards sdk.Dec) error {
+	totalShares := sdk.ZeroDec()
+	for _, reward := range rewards {
+		totalShares = totalShares.Add(reward.Shares)
+	}
+	for _, reward := range rewards {
+		expected, _ := mulQuoDecWithExtraDecimal(reward.Shares, totalRewards, totalShares, 1)
+		if reward.Amount != expected && reward.Amount != expected+1 {
+			return fmt.Errorf("reward %d of delegator %s is not pro rata, expected %d", reward.Amount, reward.AccAddr, expected)
+		}
+	}
+	return nil
+}
+
+//___________________________________________________________________________
+
+// minPayoutStrategy allocates the rewards in proportion to the shares, but the rewards are only paid once they
+// reach the min payout. Rewards below the min payout are carried over to the next distribution of the validator.
+type minPayoutStrategy struct{}
+
+func (minPayoutStrategy) Allocate(ctx sdk.Context, k Keeper, valAddr sdk.ValAddress, sharers []types.Sharer, totalRewards sdk.Dec) []types.PreReward {
+	minPayout := k.RewardMinPayout(ctx)
+	allocated := allocate(sharers, totalRewards)
+	rewards := make([]types.PreReward, 0, len(allocated))
+	for _, reward := range allocated {
+		reward.Amount += k.getRewardCarryOver(ctx, valAddr, reward.AccAddr)
+		if reward.Amount < minPayout {
+			k.setRewardCarryOver(ctx, valAddr, reward.AccAddr, reward.Amount)
+			continue
+		}
+		k.setRewardCarryOver(ctx, valAddr, reward.AccAddr, 0)
+		rewards = append(rewards, reward)
+	}
+	return rewards
+}
+
+func (minPayoutStrategy) CheckAllocation(ctx, allocatedCtx sdk.Context, k Keeper, valAddr sdk.ValAddress, sharers []types.Sharer,
+	totalRewards sdk.Dec, rewards []types.PreReward) error {
+	minPayout := k.RewardMinPayout(ctx)
+	paid := make(map[string]bool, len(rewards))
+	for _, reward := range rewards {
+		if reward.Amount < minPayout {
+			return fmt.Errorf("reward %d of delegator %s is below the min payout %d", reward.Amount, reward.AccAddr, minPayout)
+		}
+		paid[reward.AccAddr.String()] = true
+	}
+
+	// rewards are either paid or carried over
+	var carriedBefore, carriedAfter int64
+	for _, sharer := range sharers {
+		carriedBefore += k.getRewardCarryOver(ctx, valAddr, sharer.AccAddr)
+		carried := k.getRewardCarryOver(allocatedCtx, valAddr, sharer.AccAddr)
+		if paid[sharer.AccAddr.String()] && carried != 0 {
+			return fmt.Errorf("the reward of delegator %s is both paid and carried over", sharer.AccAddr)
+		}
+		if carried >= minPayout && carried > 0 {
+			return fmt.Errorf("carried over reward %d of delegator %s reaches the min payout %d", carried, sharer.AccAddr, minPayout)
+		}
+		carriedAfter += carried
+	}
+	return checkRewardsSum(rewards, totalRewards.RawInt()+carriedBefore-carriedAfter)
+}
+
+//___________________________________________________________________________
+
+// stakeCapStrategy allocates the rewards in proportion to the shares, the shares of a delegator exceeding the
+// stake cap of the total shares are not counted.
+type stakeCapStrategy struct{}
+
+func (stakeCapStrategy) Allocate(ctx sdk.Context, k Keeper, _ sdk.ValAddress, sharers []types.Sharer, totalRewards sdk.Dec) []types.PreReward {
+	capped := capSharers(sharers, k.RewardStakeCap(ctx))
+	rewards := allocate(capped, totalRewards)
+	// the original shares are kept in the rewards for calculating the tokens
+	shares := make(map[string]sdk.Dec, len(sharers))
+	for _, sharer := range sharers {
+		shares[sharer.AccAddr.String()] = sharer.Shares
+	}
+	for i := range rewards {
+		rewards[i].Shares = shares[rewards[i].AccAddr.String()]
+	}
+	return rewards
+}
+
+func (stakeCapStrategy) CheckAllocation(ctx, _ sdk.Context, k Keeper, _ sdk.ValAddress, sharers []types.Sharer,
+	totalRewards sdk.Dec, rewards []types.PreReward) error {
+	if len(rewards) != len(sharers) {
+		return fmt.Errorf("expected %d rewards, got %d", len(sharers), len(rewards))
+	}
+	if err := checkRewardsSum(rewards, totalRewards.RawInt()); err != nil {
+		return err
+	}
+	capped := capSharers(sharers, k.RewardStakeCap(ctx))
+	cappedShares := make(map[string]sdk.Dec, len(capped))
+	for _, sharer := range capped {
+		cappedShares[sharer.AccAddr.String()] = sharer.Shares
+	}
+	cappedRewards := make([]types.PreReward, len(rewards))
+	for i, reward := range rewards {
+		cappedRewards[i] = types.PreReward{AccAddr: reward.AccAddr, Shares: cappedShares[reward.AccAddr.String()], Amount: reward.Amount}
+	}
+	return checkProRata(cappedRewards, totalRewards)
+}
+
+// capSharers caps the shares of every sharer by the share cap of the total shares, zero or negative cap means no cap
+func capSharers(sharers []types.Sharer, shareCap sdk.Dec) []types.Sharer {
+	if shareCap.LTE(sdk.ZeroDec()) || shareCap.GTE(sdk.OneDec()) {
+		return sharers
+	}
+	totalShares := sdk.ZeroDec()
+	for _, sharer := range sharers {
+		totalShares = totalShares.Add(sharer.Shares)
+	}
+	maxShares := totalShares.Mul(shareCap)
+	capped := make([]types.Sharer, len(sharers))
+	for i, sharer := range sharers {
+		capped[i] = types.Sharer{AccAddr: sharer.AccAddr, Shares: sdk.MinDec(sharer.Shares, maxShares)}
+	}
+	return capped
+}
+
+//___________________________________________________________________________
+
+func (k Keeper) getRewardCarryOver(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) (amount int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetRewardCarryOverKey(valAddr, delAddr))
+	if bz == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+func (k Keeper) setRewardCarryOver(ctx sdk.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress, amount int64) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetRewardCarryOverKey(valAddr, delAddr)
+	if amount == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
+
+// payoutRewardCarryOvers appends the carried over rewards of the validator to the rewards. The carried over rewards
+// of the sharers are kept if the strategy carries over rewards, the delegators no longer delegating to the validator
+// are always paid as they would never be allocated again.
+func (k Keeper) payoutRewardCarryOvers(ctx sdk.Context, valAddr sdk.ValAddress, sharers []types.Sharer,
+	rewards []types.PreReward, keepSharers bool) []types.PreReward {
+	delegating := make(map[string]bool, len(sharers))
+	if keepSharers {
+		for _, sharer := range sharers {
+			delegating[sharer.AccAddr.String()] = true
+		}
+	}
+	allocated := make(map[string]int, len(rewards))
+	for i, reward := range rewards {
+		allocated[reward.AccAddr.String()] = i
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(RewardCarryOverKey, valAddr.Bytes()...)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var paid [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(prefix):])
+		if delegating[delAddr.String()] {
+			continue
+		}
+		var amount int64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		if i, ok := allocated[delAddr.String()]; ok {
+			rewards[i].Amount += amount
+		} else {
+			rewards = append(rewards, types.PreReward{AccAddr: delAddr, Shares: sdk.ZeroDec(), Amount: amount})
+		}
+		paid = append(paid, iterator.Key())
+	}
+	for _, key := range paid {
+		store.Delete(key)
+	}
+	return rewards
+}
+
+// IterateRewardCarryOvers iterates through all the carried over rewards
+func (k Keeper) IterateRewardCarryOvers(ctx sdk.Context, fn func(key []byte, amount int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RewardCarryOverKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount int64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		if fn(iterator.Key(), amount) {
+			break
+		}
+	}
+}
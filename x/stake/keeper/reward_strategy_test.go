@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestRewardStrategies(t *testing.T) {
+	ctx, am, k := CreateTestInput(t, false, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ConfigurableRewardStrategy, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ConfigurableRewardStrategy, 0)
+	bondDenom := k.BondDenom(ctx)
+
+	valAddr := sdk.ValAddress(CreateTestAddr())
+	sharers := []types.Sharer{
+		{AccAddr: CreateTestAddr(), Shares: sdk.NewDecWithoutFra(10)},
+		{AccAddr: CreateTestAddr(), Shares: sdk.NewDecWithoutFra(30)},
+		{AccAddr: CreateTestAddr(), Shares: sdk.NewDecWithoutFra(60)},
+	}
+	totalRewards := sdk.NewDec(100)
+	checkAllocation := func(strategy RewardStrategy) []types.PreReward {
+		allocatedCtx, write := ctx.CacheContext()
+		rewards := strategy.Allocate(allocatedCtx, k, valAddr, sharers, totalRewards)
+		require.NoError(t, strategy.CheckAllocation(ctx, allocatedCtx, k, valAddr, sharers, totalRewards, rewards))
+		write()
+		return rewards
+	}
+
+	// pro rata
+	rewards := checkAllocation(GetRewardStrategy(types.RewardStrategyProRata))
+	require.Len(t, rewards, 3)
+	for i, amount := range []int64{10, 30, 60} {
+		require.EqualValues(t, amount, rewards[i].Amount)
+	}
+
+	// the shares of the last delegator are capped to 40% of the total shares
+	k.paramstore.Set(ctx, types.KeyRewardStakeCap, sdk.NewDecWithPrec(4, 1))
+	rewards = checkAllocation(GetRewardStrategy(types.RewardStrategyStakeCap))
+	require.Len(t, rewards, 3)
+	require.EqualValues(t, 50, rewards[2].Amount)
+	require.EqualValues(t, sharers[2].Shares, rewards[2].Shares)
+
+	// the reward of the first delegator is carried over until it reaches the min payout
+	k.paramstore.Set(ctx, types.KeyRewardMinPayout, int64(20))
+	strategy := GetRewardStrategy(types.RewardStrategyMinPayout)
+	rewards = checkAllocation(strategy)
+	require.Len(t, rewards, 2)
+	require.EqualValues(t, 10, k.getRewardCarryOver(ctx, valAddr, sharers[0].AccAddr))
+	rewards = checkAllocation(strategy)
+	require.Len(t, rewards, 3)
+	require.EqualValues(t, 20, rewards[0].Amount)
+	require.EqualValues(t, 0, k.getRewardCarryOver(ctx, valAddr, sharers[0].AccAddr))
+
+	// the carried over rewards are moved to the carry over account
+	k.paramstore.Set(ctx, types.KeyRewardStrategy, types.RewardStrategyMinPayout)
+	validator := types.Validator{OperatorAddr: valAddr, DistributionAddr: CreateTestAddr()}
+	_, _, err := k.BankKeeper.AddCoins(ctx, validator.DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 100)})
+	require.NoError(t, err)
+	rewards = k.allocateRewards(ctx, validator, sharers, totalRewards)
+	require.Len(t, rewards, 2)
+	require.EqualValues(t, 10, am.GetAccount(ctx, RewardCarryOverAccAddr).GetCoins().AmountOf(bondDenom))
+	require.EqualValues(t, 90, am.GetAccount(ctx, validator.DistributionAddr).GetCoins().AmountOf(bondDenom))
+
+	_, _, err = k.BankKeeper.AddCoins(ctx, validator.DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 100)})
+	require.NoError(t, err)
+	rewards = k.allocateRewards(ctx, validator, sharers, totalRewards)
+	require.Len(t, rewards, 3)
+	require.EqualValues(t, 20, rewards[0].Amount)
+	require.EqualValues(t, 0, am.GetAccount(ctx, RewardCarryOverAccAddr).GetCoins().AmountOf(bondDenom))
+	require.EqualValues(t, 200, am.GetAccount(ctx, validator.DistributionAddr).GetCoins().AmountOf(bondDenom))
+}
+
+func TestRewardCarryOverPayout(t *testing.T) {
+	ctx, am, k := CreateTestInput(t, false, 0)
+	sdk.UpgradeMgr.AddUpgradeHeight(sdk.ConfigurableRewardStrategy, 1)
+	defer sdk.UpgradeMgr.AddUpgradeHeight(sdk.ConfigurableRewardStrategy, 0)
+	bondDenom := k.BondDenom(ctx)
+
+	validator := types.Validator{OperatorAddr: sdk.ValAddress(CreateTestAddr()), DistributionAddr: CreateTestAddr()}
+	sharers := []types.Sharer{
+		{AccAddr: CreateTestAddr(), Shares: sdk.NewDecWithoutFra(10)},
+		{AccAddr: CreateTestAddr(), Shares: sdk.NewDecWithoutFra(90)},
+	}
+	totalRewards := sdk.NewDec(100)
+	allocate := func(sharers []types.Sharer) []types.PreReward {
+		_, _, err := k.BankKeeper.AddCoins(ctx, validator.DistributionAddr, sdk.Coins{sdk.NewCoin(bondDenom, 100)})
+		require.NoError(t, err)
+		return k.allocateRewards(ctx, validator, sharers, totalRewards)
+	}
+	carried := func() int64 {
+		return am.GetAccount(ctx, RewardCarryOverAccAddr).GetCoins().AmountOf(bondDenom)
+	}
+
+	k.paramstore.Set(ctx, types.KeyRewardStrategy, types.RewardStrategyMinPayout)
+	k.paramstore.Set(ctx, types.KeyRewardMinPayout, int64(20))
+	rewards := allocate(sharers)
+	require.Len(t, rewards, 1)
+	require.EqualValues(t, 10, carried())
+
+	// the carried over reward is paid once the delegator no longer delegates to the validator
+	rewards = allocate(sharers[1:])
+	require.Len(t, rewards, 2)
+	require.Equal(t, sharers[0].AccAddr, rewards[1].AccAddr)
+	require.EqualValues(t, 10, rewards[1].Amount)
+	require.EqualValues(t, 0, carried())
+	require.EqualValues(t, 0, k.getRewardCarryOver(ctx, validator.OperatorAddr, sharers[0].AccAddr))
+
+	// the carried over rewards are paid with the first allocation after switching to a strategy without carry over
+	rewards = allocate(sharers)
+	require.Len(t, rewards, 1)
+	require.EqualValues(t, 10, carried())
+	k.paramstore.Set(ctx, types.KeyRewardStrategy, types.RewardStrategyProRata)
+	rewards = allocate(sharers)
+	require.Len(t, rewards, 2)
+	require.EqualValues(t, 20, rewards[0].Amount)
+	require.EqualValues(t, 0, carried())
+
+	var sum int64
+	for _, reward := range rewards {
+		sum += reward.Amount
+	}
+	require.EqualValues(t, 110, sum)
+}
@@ -11,6 +11,7 @@ import (
 )
 
 func (k Keeper) SlashSideChain(ctx sdk.Context, sideChainId string, sideConsAddr []byte, slashAmount sdk.Dec) (sdk.Validator, sdk.Dec, error) {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeSlash)
 	logger := ctx.Logger().With("module", "stake")
 
 	sideCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainId)
@@ -143,6 +144,7 @@ func convertValidators2Shares(validators []types.Validator) (sharers []types.Sha
 
 // return this map for storing data of validators amount receiving detail. the receiving address as map key, and amount as map value
 func (k Keeper) AllocateSlashAmtToValidators(ctx sdk.Context, slashedConsAddr []byte, amount sdk.Dec) (bool, map[string]int64, error) {
+	ctx = sdk.WithBalanceChangeReason(ctx, sdk.BalanceChangeSlash)
 	// allocate remaining rewards to validators who are going to be distributed next time.
 	validators, found := k.GetEarliestValidatorsWithHeight(ctx)
 	if !found {
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// get the stake snapshot of the validators stored at height in a breathe block
+func (k Keeper) GetStakeSnapshot(ctx sdk.Context, height int64) (snapshot types.StakeSnapshot, found bool) {
+	validators, found := k.GetValidatorsByHeight(ctx, height)
+	if !found {
+		return snapshot, false
+	}
+	return k.newStakeSnapshot(ctx, height, validators), true
+}
+
+// iterate through the validators stored by height from the newest to the oldest, stop when fn returns true.
+// The snapshots are kept as long as the validators by height, i.e. until their rewards are distributed.
+func (k Keeper) IterateStakeSnapshots(ctx sdk.Context, fn func(snapshot types.StakeSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, ValidatorsByHeightKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[len(ValidatorsByHeightKey):]))
+		validators := types.MustUnmarshalValidators(k.cdc, iterator.Value())
+		if fn(k.newStakeSnapshot(ctx, height, validators)) {
+			break
+		}
+	}
+}
+
+// get all the stake snapshots, the newest comes first
+func (k Keeper) GetStakeSnapshots(ctx sdk.Context) (snapshots []types.StakeSnapshot) {
+	k.IterateStakeSnapshots(ctx, func(snapshot types.StakeSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// the delegator counts are taken from the simplified delegations stored along with the validators
+func (k Keeper) newStakeSnapshot(ctx sdk.Context, height int64, validators []types.Validator) types.StakeSnapshot {
+	snapshot := types.StakeSnapshot{
+		Height:     height,
+		Validators: make([]types.SnapshotValidator, len(validators)),
+	}
+	for i, validator := range validators {
+		simDels, _ := k.GetSimplifiedDelegations(ctx, height, validator.OperatorAddr)
+		snapshot.Validators[i] = types.NewSnapshotValidator(validator, int64(len(simDels)))
+	}
+	return snapshot
+}
+
+// get the history of a validator over all the stake snapshots, the newest comes first.
+// Snapshots in which the validator was not elected are skipped.
+func (k Keeper) GetValidatorSnapshotHistory(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.ValidatorSnapshotRecord) {
+	k.IterateStakeSnapshots(ctx, func(snapshot types.StakeSnapshot) bool {
+		for i, val := range snapshot.Validators {
+			if !val.OperatorAddr.Equals(valAddr) {
+				continue
+			}
+			records = append(records, types.ValidatorSnapshotRecord{
+				Height:           snapshot.Height,
+				Rank:             i + 1,
+				Tokens:           val.Tokens,
+				AccumulatedStake: val.AccumulatedStake,
+				CommissionRate:   val.CommissionRate,
+				DelegatorCount:   val.DelegatorCount,
+			})
+			break
+		}
+		return false
+	})
+	return records
+}
+
+// get the elected validator sets of all the stake snapshots, the newest comes first
+func (k Keeper) GetElectedValidatorSets(ctx sdk.Context) (sets []types.ElectedValidatorSet) {
+	k.IterateStakeSnapshots(ctx, func(snapshot types.StakeSnapshot) bool {
+		sets = append(sets, types.ElectedValidatorSet{
+			Height:      snapshot.Height,
+			Validators:  snapshot.OperatorAddrs(),
+			TotalTokens: snapshot.TotalTokens(),
+		})
+		return false
+	})
+	return sets
+}
+
+// get the churn between every two consecutive stake snapshots, the newest comes first.
+// The oldest snapshot has no predecessor, so there is one churn less than snapshots.
+func (k Keeper) GetStakeChurns(ctx sdk.Context) (churns []types.StakeChurn) {
+	snapshots := k.GetStakeSnapshots(ctx)
+	for i := 0; i+1 < len(snapshots); i++ {
+		churns = append(churns, types.NewStakeChurn(snapshots[i+1], snapshots[i]))
+	}
+	return churns
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func TestStakeSnapshots(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 10)
+
+	validators := make([]types.Validator, 4)
+	for i := range validators {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validators[i] = types.NewValidator(valAddr, PKs[i], types.Description{Moniker: "val"})
+		validators[i].Tokens = sdk.NewDecWithoutFra(int64(10 * (i + 1)))
+	}
+
+	// the elected set moves by one validator in every breathe block
+	for i := 0; i < 4; i++ {
+		height := int64(1000 * (i + 1))
+		elected := []types.Validator{validators[i]}
+		if i+1 < len(validators) {
+			elected = append(elected, validators[i+1])
+		}
+		simDels := make([]types.SimplifiedDelegation, i+1)
+		for j := range simDels {
+			simDels[j] = types.SimplifiedDelegation{DelegatorAddr: Addrs[j], Shares: sdk.OneDec()}
+		}
+		keeper.SetSimplifiedDelegations(ctx, height, validators[i].OperatorAddr, simDels)
+		keeper.SetValidatorsByHeight(ctx, height, elected)
+	}
+
+	// the snapshots are gone once the validators by height are removed
+	keeper.RemoveValidatorsByHeight(ctx, 1000)
+	snapshots := keeper.GetStakeSnapshots(ctx)
+	require.Len(t, snapshots, 3)
+	require.EqualValues(t, 4000, snapshots[0].Height)
+	require.EqualValues(t, 2000, snapshots[2].Height)
+	_, found := keeper.GetStakeSnapshot(ctx, 1000)
+	require.False(t, found)
+
+	records := keeper.GetValidatorSnapshotHistory(ctx, validators[2].OperatorAddr)
+	require.Len(t, records, 2)
+	require.EqualValues(t, 3000, records[0].Height)
+	require.Equal(t, 1, records[0].Rank)
+	require.EqualValues(t, 3, records[0].DelegatorCount)
+	require.EqualValues(t, 2000, records[1].Height)
+	require.Equal(t, 2, records[1].Rank)
+	require.EqualValues(t, 0, records[1].DelegatorCount)
+	require.True(t, sdk.NewDecWithoutFra(30).Equal(records[1].Tokens))
+
+	sets := keeper.GetElectedValidatorSets(ctx)
+	require.Len(t, sets, 3)
+	require.Len(t, sets[0].Validators, 1)
+	require.True(t, sdk.NewDecWithoutFra(50).Equal(sets[2].TotalTokens))
+
+	churns := keeper.GetStakeChurns(ctx)
+	require.Len(t, churns, 2)
+	require.EqualValues(t, 4000, churns[0].Height)
+	require.EqualValues(t, 3000, churns[0].PrevHeight)
+	require.Empty(t, churns[0].Joined)
+	require.Equal(t, []sdk.ValAddress{validators[2].OperatorAddr}, churns[0].Left)
+	require.True(t, sdk.NewDecWithPrec(5, 1).Equal(churns[0].ChurnRate))
+	require.Equal(t, []sdk.ValAddress{validators[3].OperatorAddr}, churns[1].Joined)
+}
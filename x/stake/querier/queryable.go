@@ -2,6 +2,7 @@ package querier
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,15 @@ const (
 	QueryAllValidatorsCount            = "allValidatorsCount"
 	QueryAllUnJailValidatorsCount      = "allUnJailValidatorsCount"
 	QueryCrossStakeInfoByBscAddress    = "crossStakeInfoByBscAddress"
+	QueryStakeSnapshots                = "stakeSnapshots"
+	QueryValidatorSnapshotHistory      = "validatorSnapshotHistory"
+	QueryElectedValidatorSets          = "electedValidatorSets"
+	QueryStakeChurns                   = "stakeChurns"
+)
+
+const (
+	defaultSnapshotQueryLimit = 30
+	maxSnapshotQueryLimit     = 100
 )
 
 // creates a querier for staking REST endpoints
@@ -163,6 +173,34 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 				return res, err
 			}
 			return queryCrossStakeInfoByBscAddress(ctx, cdc, p, k)
+		case QueryStakeSnapshots:
+			p := new(QuerySnapshotsParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryStakeSnapshots(ctx, cdc, p, k)
+		case QueryValidatorSnapshotHistory:
+			p := new(QueryValidatorSnapshotsParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryValidatorSnapshotHistory(ctx, cdc, p, k)
+		case QueryElectedValidatorSets:
+			p := new(QuerySnapshotsParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryElectedValidatorSets(ctx, cdc, p, k)
+		case QueryStakeChurns:
+			p := new(QuerySnapshotsParams)
+			ctx, err = RequestPrepare(ctx, k, req, p)
+			if err != nil {
+				return res, err
+			}
+			return queryStakeChurns(ctx, cdc, p, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown stake query endpoint")
 		}
@@ -251,6 +289,54 @@ type QueryCrossStakeInfoParams struct {
 	BscAddress sdk.SmartChainAddress
 }
 
+// defines the pagination of the stake snapshot queries, Page starts from 1
+type PageParams struct {
+	Page  int
+	Limit int
+}
+
+// returns the bounds of the requested page in a list of total elements
+func (p PageParams) bounds(total int) (start, end int, err sdk.Error) {
+	page, limit := p.Page, p.Limit
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultSnapshotQueryLimit
+	}
+	if page < 0 {
+		return 0, 0, sdk.ErrInternal("page must be positive")
+	}
+	if limit < 0 || limit > maxSnapshotQueryLimit {
+		return 0, 0, sdk.ErrInternal(fmt.Sprintf("limit must be between 1 and %d", maxSnapshotQueryLimit))
+	}
+	start = (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end = start + limit
+	if end > total {
+		end = total
+	}
+	return start, end, nil
+}
+
+// defines the params for the following queries:
+// - 'custom/stake/stakeSnapshots'
+// - 'custom/stake/electedValidatorSets'
+// - 'custom/stake/stakeChurns'
+type QuerySnapshotsParams struct {
+	BaseParams
+	PageParams
+}
+
+// defines the params for 'custom/stake/validatorSnapshotHistory'
+type QueryValidatorSnapshotsParams struct {
+	BaseParams
+	PageParams
+	ValidatorAddr sdk.ValAddress
+}
+
 func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
 	stakeParams := k.GetParams(ctx)
 	validators := k.GetValidators(ctx, stakeParams.MaxValidators)
@@ -477,6 +563,65 @@ func queryCrossStakeInfoByBscAddress(ctx sdk.Context, cdc *codec.Codec, params *
 	return res, nil
 }
 
+func queryStakeSnapshots(ctx sdk.Context, cdc *codec.Codec, params *QuerySnapshotsParams, k keep.Keeper) ([]byte, sdk.Error) {
+	snapshots := k.GetStakeSnapshots(ctx)
+	start, end, err := params.bounds(len(snapshots))
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, snapshots[start:end])
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryValidatorSnapshotHistory(ctx sdk.Context, cdc *codec.Codec, params *QueryValidatorSnapshotsParams, k keep.Keeper) ([]byte, sdk.Error) {
+	if params.ValidatorAddr.Empty() {
+		return nil, types.ErrNilValidatorAddr(k.Codespace())
+	}
+	records := k.GetValidatorSnapshotHistory(ctx, params.ValidatorAddr)
+	start, end, err := params.bounds(len(records))
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, records[start:end])
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryElectedValidatorSets(ctx sdk.Context, cdc *codec.Codec, params *QuerySnapshotsParams, k keep.Keeper) ([]byte, sdk.Error) {
+	sets := k.GetElectedValidatorSets(ctx)
+	start, end, err := params.bounds(len(sets))
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, sets[start:end])
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryStakeChurns(ctx sdk.Context, cdc *codec.Codec, params *QuerySnapshotsParams, k keep.Keeper) ([]byte, sdk.Error) {
+	churns := k.GetStakeChurns(ctx)
+	start, end, err := params.bounds(len(churns))
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, churns[start:end])
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
 func prepareSideChainCtx(ctx sdk.Context, k keep.Keeper, sideChainId string) (sdk.Context, sdk.Error) {
 	scCtx, err := k.ScKeeper.PrepareCtxForSideChain(ctx, sideChainId)
 	if err != nil {
@@ -415,3 +415,50 @@ func TestQueryRedelegations(t *testing.T) {
 
 	require.Equal(t, redelegation, redsRes[0])
 }
+
+func TestQueryStakeSnapshots(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
+
+	for i := int64(1); i <= 5; i++ {
+		val := types.NewValidator(addrVal1, pk1, types.Description{})
+		keeper.SetValidatorsByHeight(ctx, i*100, []types.Validator{val})
+	}
+
+	queryParams := QueryValidatorSnapshotsParams{
+		PageParams:    PageParams{Page: 2, Limit: 2},
+		ValidatorAddr: addrVal1,
+	}
+	bz, errRes := json.Marshal(queryParams)
+	require.Nil(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/stake/validatorSnapshotHistory",
+		Data: bz,
+	}
+	res, err := NewQuerier(keeper, cdc)(ctx, []string{QueryValidatorSnapshotHistory}, query)
+	require.Nil(t, err)
+
+	var records []types.ValidatorSnapshotRecord
+	errRes = cdc.UnmarshalJSON(res, &records)
+	require.Nil(t, errRes)
+	require.Len(t, records, 2)
+	require.EqualValues(t, 300, records[0].Height)
+	require.EqualValues(t, 200, records[1].Height)
+
+	// page out of range
+	queryParams.Page = 4
+	query.Data, errRes = json.Marshal(queryParams)
+	require.Nil(t, errRes)
+	res, err = NewQuerier(keeper, cdc)(ctx, []string{QueryValidatorSnapshotHistory}, query)
+	require.Nil(t, err)
+	errRes = cdc.UnmarshalJSON(res, &records)
+	require.Nil(t, errRes)
+	require.Len(t, records, 0)
+
+	// limit is bounded
+	queryParams.Limit = maxSnapshotQueryLimit + 1
+	query.Data, errRes = json.Marshal(queryParams)
+	require.Nil(t, errRes)
+	_, err = NewQuerier(keeper, cdc)(ctx, []string{QueryValidatorSnapshotHistory}, query)
+	require.NotNil(t, err)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	"github.com/cosmos/cosmos-sdk/x/stake"
+	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -26,6 +28,14 @@ func AllInvariants(ck bank.Keeper, k stake.Keeper, d distribution.Keeper, am aut
 			return err
 		}
 		err = ValidatorSetInvariant(k)(app)
+		if err != nil {
+			return err
+		}
+		err = RewardStrategyInvariant(k)(app)
+		if err != nil {
+			return err
+		}
+		err = RewardCarryOverInvariant(ck, k)(app)
 		return err
 	}
 }
@@ -111,3 +121,52 @@ func ValidatorSetInvariant(k stake.Keeper) simulation.Invariant {
 		return nil
 	}
 }
+
+// RewardStrategyInvariant allocates a reward of every bonded validator to its delegators with each of the reward
+// strategies, and checks the allocations with the invariants of the strategies
+func RewardStrategyInvariant(k stake.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+		totalRewards := sdk.NewDecWithoutFra(1)
+		var err error
+		k.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) bool {
+			simDels := k.GetSimplifiedDelegationsByValidator(ctx, validator.GetOperator())
+			if len(simDels) == 0 {
+				return false
+			}
+			sharers := make([]types.Sharer, len(simDels))
+			for i, del := range simDels {
+				sharers[i] = types.Sharer{AccAddr: del.DelegatorAddr, Shares: del.Shares}
+			}
+			for _, name := range keeper.RewardStrategyNames() {
+				strategy := keeper.GetRewardStrategy(name)
+				// the allocation is discarded
+				allocatedCtx, _ := ctx.CacheContext()
+				rewards := strategy.Allocate(allocatedCtx, k, validator.GetOperator(), sharers, totalRewards)
+				if err = strategy.CheckAllocation(ctx, allocatedCtx, k, validator.GetOperator(), sharers, totalRewards, rewards); err != nil {
+					err = fmt.Errorf("reward strategy %s of validator %s: %v", name, validator.GetOperator(), err)
+					return true
+				}
+			}
+			return false
+		})
+		return err
+	}
+}
+
+// RewardCarryOverInvariant checks that the carried over rewards are held by the carry over account
+func RewardCarryOverInvariant(ck bank.Keeper, k stake.Keeper) simulation.Invariant {
+	return func(app *baseapp.BaseApp) error {
+		ctx := app.NewContext(sdk.RunTxModeDeliver, abci.Header{})
+		var carried int64
+		k.IterateRewardCarryOvers(ctx, func(_ []byte, amount int64) bool {
+			carried += amount
+			return false
+		})
+		balance := ck.GetCoins(ctx, keeper.RewardCarryOverAccAddr).AmountOf(k.BondDenom(ctx))
+		if carried != balance {
+			return fmt.Errorf("expected carried over rewards %d to equal the balance of the carry over account %d", carried, balance)
+		}
+		return nil
+	}
+}
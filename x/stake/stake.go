@@ -34,6 +34,15 @@ type (
 	CreateValidatorJsonMsg     = types.CreateValidatorJsonMsg
 	QueryTopValidatorsParams   = querier.QueryTopValidatorsParams
 	BaseParams                 = querier.BaseParams
+	PageParams                 = querier.PageParams
+	QuerySnapshotsParams       = querier.QuerySnapshotsParams
+
+	QueryValidatorSnapshotsParams = querier.QueryValidatorSnapshotsParams
+	StakeSnapshot                 = types.StakeSnapshot
+	SnapshotValidator             = types.SnapshotValidator
+	ValidatorSnapshotRecord       = types.ValidatorSnapshotRecord
+	ElectedValidatorSet           = types.ElectedValidatorSet
+	StakeChurn                    = types.StakeChurn
 
 	MsgCreateSideChainValidator             = types.MsgCreateSideChainValidator
 	MsgEditSideChainValidator               = types.MsgEditSideChainValidator
@@ -159,6 +168,10 @@ const (
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
 	QueryCrossStakeInfo                = querier.QueryCrossStakeInfoByBscAddress
+	QueryStakeSnapshots                = querier.QueryStakeSnapshots
+	QueryValidatorSnapshotHistory      = querier.QueryValidatorSnapshotHistory
+	QueryElectedValidatorSets          = querier.QueryElectedValidatorSets
+	QueryStakeChurns                   = querier.QueryStakeChurns
 
 	Topic = types.Topic
 )
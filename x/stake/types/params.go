@@ -46,6 +46,9 @@ var (
 	KeyBaseProposerRewardRatio     = []byte("BaseProposerRewardRatio")
 	KeyBonusProposerRewardRatio    = []byte("BonusProposerRewardRatio")
 	KeyFeeFromBscToBcRatio         = []byte("FeeFromBscToBcRatio")
+	KeyRewardStrategy              = []byte("RewardStrategy")
+	KeyRewardMinPayout             = []byte("RewardMinPayout")
+	KeyRewardStakeCap              = []byte("RewardStakeCap")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -64,6 +67,10 @@ type Params struct {
 	BaseProposerRewardRatio  types.Dec `json:"base_proposer_reward_ratio"`  // the base proposer reward ratio
 	BonusProposerRewardRatio types.Dec `json:"bonus_proposer_reward_ratio"` // the bonus proposer reward ratio
 	FeeFromBscToBcRatio      types.Dec `json:"fee_from_bsc_to_bc_ratio"`    // the fee from bsc to bc ratio
+	// added in ConfigurableRewardStrategy
+	RewardStrategy  string    `json:"reward_strategy"`   // the strategy to allocate rewards to delegators, pro rata if empty
+	RewardMinPayout int64     `json:"reward_min_payout"` // the min payout of the min_payout strategy
+	RewardStakeCap  types.Dec `json:"reward_stake_cap"`  // the max share of a delegator in the stake_cap strategy
 }
 
 func (p *Params) GetBCParamAttribute() string {
@@ -110,6 +117,23 @@ func (p *Params) UpdateCheck() error {
 	if p.FeeFromBscToBcRatio.LT(types.ZeroDec()) {
 		return fmt.Errorf("the fee_from_bsc_to_bc_ratio should be no less than 0")
 	}
+	if types.IsUpgrade(types.ConfigurableRewardStrategy) {
+		if !IsValidRewardStrategy(p.RewardStrategy) {
+			return fmt.Errorf("unknown reward_strategy %s", p.RewardStrategy)
+		}
+		if p.RewardMinPayout < 0 || p.RewardMinPayout > 100e8 {
+			return fmt.Errorf("the reward_min_payout should be in range 0 to 100e8")
+		}
+		if p.RewardStrategy == RewardStrategyMinPayout && p.RewardMinPayout == 0 {
+			return fmt.Errorf("the reward_min_payout should be positive for the %s strategy", RewardStrategyMinPayout)
+		}
+		if p.RewardStakeCap.LT(types.ZeroDec()) || p.RewardStakeCap.GT(types.OneDec()) {
+			return fmt.Errorf("the reward_stake_cap should be in range 0 to 1")
+		}
+		if p.RewardStrategy == RewardStrategyStakeCap && p.RewardStakeCap.IsZero() {
+			return fmt.Errorf("the reward_stake_cap should be positive for the %s strategy", RewardStrategyStakeCap)
+		}
+	}
 
 	return nil
 }
@@ -127,6 +151,9 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyBaseProposerRewardRatio, &p.BaseProposerRewardRatio},
 		{KeyBonusProposerRewardRatio, &p.BonusProposerRewardRatio},
 		{KeyFeeFromBscToBcRatio, &p.FeeFromBscToBcRatio},
+		{KeyRewardStrategy, &p.RewardStrategy},
+		{KeyRewardMinPayout, &p.RewardMinPayout},
+		{KeyRewardStakeCap, &p.RewardStakeCap},
 	}
 }
 
@@ -168,6 +195,9 @@ func (p Params) HumanReadableString() string {
 	resp += fmt.Sprintf("Base proposer reward ratio: %s\n", p.BaseProposerRewardRatio)
 	resp += fmt.Sprintf("Bonus proposer reward ratio: %s\n", p.BonusProposerRewardRatio)
 	resp += fmt.Sprintf("Fee from BSC to BC ratio: %s\n", p.FeeFromBscToBcRatio)
+	resp += fmt.Sprintf("Reward strategy: %s\n", p.RewardStrategy)
+	resp += fmt.Sprintf("Reward min payout: %d\n", p.RewardMinPayout)
+	resp += fmt.Sprintf("Reward stake cap: %s\n", p.RewardStakeCap)
 	return resp
 }
 
@@ -5,6 +5,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// strategies to allocate the rewards of a validator to its delegators
+const (
+	RewardStrategyProRata   = "pro_rata"   // in proportion to the shares
+	RewardStrategyMinPayout = "min_payout" // rewards below the min payout are carried over to the next distribution
+	RewardStrategyStakeCap  = "stake_cap"  // the shares of a delegator are capped when calculating the rewards
+)
+
+// IsValidRewardStrategy returns whether the strategy is known, empty means pro rata
+func IsValidRewardStrategy(strategy string) bool {
+	switch strategy {
+	case "", RewardStrategyProRata, RewardStrategyMinPayout, RewardStrategyStakeCap:
+		return true
+	default:
+		return false
+	}
+}
+
 type Sharer struct {
 	AccAddr sdk.AccAddress
 	Shares  sdk.Dec
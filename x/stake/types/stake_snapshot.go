@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakeSnapshot is a compact view of the validators elected in a breathe block.
+// It is built from the validators stored by height, so it is available as long as they are kept.
+type StakeSnapshot struct {
+	Height     int64               `json:"height"`
+	Validators []SnapshotValidator `json:"validators"` // elected validators, ordered by election rank
+}
+
+// SnapshotValidator is the state of an elected validator when the snapshot is taken
+type SnapshotValidator struct {
+	OperatorAddr     sdk.ValAddress `json:"operator_address"`
+	Moniker          string         `json:"moniker"`
+	Tokens           sdk.Dec        `json:"tokens"`            // voting power of the validator
+	AccumulatedStake sdk.Dec        `json:"accumulated_stake"` // accumulated stake used in the election
+	CommissionRate   sdk.Dec        `json:"commission_rate"`
+	DelegatorCount   int64          `json:"delegator_count"`
+}
+
+func NewSnapshotValidator(validator Validator, delegatorCount int64) SnapshotValidator {
+	return SnapshotValidator{
+		OperatorAddr:     validator.OperatorAddr,
+		Moniker:          validator.Description.Moniker,
+		Tokens:           validator.Tokens,
+		AccumulatedStake: validator.AccumulatedStake,
+		CommissionRate:   validator.Commission.Rate,
+		DelegatorCount:   delegatorCount,
+	}
+}
+
+// TotalTokens returns the sum of tokens of all the validators in the snapshot
+func (s StakeSnapshot) TotalTokens() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, val := range s.Validators {
+		total = total.Add(val.Tokens)
+	}
+	return total
+}
+
+// OperatorAddrs returns the operator addresses of the validators in the snapshot
+func (s StakeSnapshot) OperatorAddrs() []sdk.ValAddress {
+	addrs := make([]sdk.ValAddress, len(s.Validators))
+	for i, val := range s.Validators {
+		addrs[i] = val.OperatorAddr
+	}
+	return addrs
+}
+
+//______________________________________________________________________
+
+// ValidatorSnapshotRecord is the state of a validator in one stake snapshot
+type ValidatorSnapshotRecord struct {
+	Height           int64   `json:"height"`
+	Rank             int     `json:"rank"` // 1-based position in the elected validator set
+	Tokens           sdk.Dec `json:"tokens"`
+	AccumulatedStake sdk.Dec `json:"accumulated_stake"`
+	CommissionRate   sdk.Dec `json:"commission_rate"`
+	DelegatorCount   int64   `json:"delegator_count"`
+}
+
+func (r ValidatorSnapshotRecord) HumanReadableString() string {
+	return fmt.Sprintf("Height: %d, Rank: %d, Tokens: %s, Accumulated Stake: %s, Commission Rate: %s, Delegator Count: %d",
+		r.Height, r.Rank, r.Tokens, r.AccumulatedStake, r.CommissionRate, r.DelegatorCount)
+}
+
+// ElectedValidatorSet is the set of validators elected in one stake snapshot
+type ElectedValidatorSet struct {
+	Height      int64            `json:"height"`
+	Validators  []sdk.ValAddress `json:"validators"`
+	TotalTokens sdk.Dec          `json:"total_tokens"`
+}
+
+func (s ElectedValidatorSet) HumanReadableString() string {
+	vals := make([]string, len(s.Validators))
+	for i, val := range s.Validators {
+		vals[i] = val.String()
+	}
+	return fmt.Sprintf("Height: %d, Total Tokens: %s\nValidators: %s",
+		s.Height, s.TotalTokens, strings.Join(vals, ", "))
+}
+
+// StakeChurn describes how the elected validator set changes between two consecutive snapshots
+type StakeChurn struct {
+	Height     int64            `json:"height"`
+	PrevHeight int64            `json:"prev_height"`
+	Joined     []sdk.ValAddress `json:"joined"`     // validators elected in this snapshot but not in the previous one
+	Left       []sdk.ValAddress `json:"left"`       // validators elected in the previous snapshot but not in this one
+	ChurnRate  sdk.Dec          `json:"churn_rate"` // fraction of the previous elected set that has been replaced
+}
+
+func NewStakeChurn(prev, cur StakeSnapshot) StakeChurn {
+	prevAddrs := make(map[string]bool, len(prev.Validators))
+	for _, val := range prev.Validators {
+		prevAddrs[string(val.OperatorAddr)] = true
+	}
+	curAddrs := make(map[string]bool, len(cur.Validators))
+	for _, val := range cur.Validators {
+		curAddrs[string(val.OperatorAddr)] = true
+	}
+
+	churn := StakeChurn{
+		Height:     cur.Height,
+		PrevHeight: prev.Height,
+		Joined:     make([]sdk.ValAddress, 0),
+		Left:       make([]sdk.ValAddress, 0),
+		ChurnRate:  sdk.ZeroDec(),
+	}
+	for _, val := range cur.Validators {
+		if !prevAddrs[string(val.OperatorAddr)] {
+			churn.Joined = append(churn.Joined, val.OperatorAddr)
+		}
+	}
+	for _, val := range prev.Validators {
+		if !curAddrs[string(val.OperatorAddr)] {
+			churn.Left = append(churn.Left, val.OperatorAddr)
+		}
+	}
+	if len(prev.Validators) > 0 {
+		churn.ChurnRate = sdk.NewDecWithoutFra(int64(len(churn.Left))).Quo(sdk.NewDecWithoutFra(int64(len(prev.Validators))))
+	}
+	return churn
+}
+
+func (c StakeChurn) HumanReadableString() string {
+	joined := make([]string, len(c.Joined))
+	for i, val := range c.Joined {
+		joined[i] = val.String()
+	}
+	left := make([]string, len(c.Left))
+	for i, val := range c.Left {
+		left[i] = val.String()
+	}
+	return fmt.Sprintf("Height: %d, Previous Height: %d, Churn Rate: %s\nJoined: %s\nLeft: %s",
+		c.Height, c.PrevHeight, c.ChurnRate, strings.Join(joined, ", "), strings.Join(left, ", "))
+}